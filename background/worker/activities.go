@@ -1,11 +1,13 @@
 package worker
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -221,14 +223,74 @@ func (w *Worker) MarkAccountTokenChanged(ctx context.Context, indexIDs []string)
 	return w.indexerStore.MarkAccountTokenChanged(ctx, indexIDs)
 }
 
-func (w *Worker) GetExchangeRateLastTime(ctx context.Context) (time.Time, error) {
-	return w.indexerStore.GetExchangeRateLastTime(ctx)
+// EnqueueThumbnailJob requests the image indexer to generate the thumbnail of an asset in high
+// priority. It is skipped if the image indexer endpoint or the api token is not set.
+func (w *Worker) EnqueueThumbnailJob(ctx context.Context, indexID string) error {
+	if w.imageIndexerEndpoint == "" || w.imageIndexerAPIToken == "" {
+		return nil
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"indexID":  indexID,
+		"priority": "high",
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		strings.TrimSuffix(w.imageIndexerEndpoint, "/")+"/v1/thumbnail-jobs", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("API-TOKEN", w.imageIndexerAPIToken)
+
+	resp, err := w.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fail to queue thumbnail job: %s", resp.Status)
+	}
+
+	return nil
+}
+
+// GetCurrencyPairs returns the configured currency pairs of the exchange rates. The workflows
+// read them by this activity since the configuration may change between their replays.
+func (w *Worker) GetCurrencyPairs(_ context.Context) ([]string, error) {
+	return indexer.CurrencyPairs(), nil
+}
+
+func (w *Worker) GetExchangeRateLastTime(ctx context.Context, currencyPair string) (time.Time, error) {
+	return w.indexerStore.GetExchangeRateLastTime(ctx, currencyPair)
 }
 
 func (w *Worker) WriteHistoricalExchangeRate(ctx context.Context, exchangeRate []coinbase.HistoricalExchangeRate) error {
 	return w.indexerStore.WriteHistoricalExchangeRate(ctx, exchangeRate)
 }
 
+// GetExchangeRateGaps returns the time ranges without the exchange rates of a currency pair
+func (w *Worker) GetExchangeRateGaps(ctx context.Context, currencyPair string, start, end int64) ([]indexer.ExchangeRateGap, error) {
+	return w.indexerStore.GetExchangeRateGaps(ctx, currencyPair, time.Unix(start, 0), time.Unix(end, 0), granularity*time.Second)
+}
+
+// CrawlExchangeRateFromProviders gets the candles of a currency pair from the exchange rate
+// providers in the failover order. The granularity is in seconds.
+func (w *Worker) CrawlExchangeRateFromProviders(
+	ctx context.Context,
+	currencyPair string,
+	granularity int64,
+	start int64,
+	end int64,
+) ([]coinbase.HistoricalExchangeRate, error) {
+	return w.exchangeRateProviders.GetCandles(ctx, currencyPair, granularity, start, end)
+}
+
+// CrawlExchangeRateFromCoinbase is kept for the running workflows. Use CrawlExchangeRateFromProviders instead.
 func (w *Worker) CrawlExchangeRateFromCoinbase(
 	ctx context.Context,
 	currencyPair string,
@@ -741,6 +803,14 @@ func (w *Worker) ParseTokenSaleToGenericSalesTimeSeries(
 	values["netRevenue"] = tokenSale.NetRevenue.String()
 	values["paymentAmount"] = tokenSale.PaymentAmount.String()
 	values["exchangeRate"] = "1"
+	if nil != tokenSale.Royalty {
+		values["royalty"] = tokenSale.Royalty.String()
+	}
+
+	saleType := tokenSale.SaleType
+	if saleType == "" {
+		saleType = "secondary"
+	}
 
 	bundleTokenInfo := []map[string]interface{}{}
 	for _, info := range tokenSale.BundleTokenInfo {
@@ -759,7 +829,7 @@ func (w *Worker) ParseTokenSaleToGenericSalesTimeSeries(
 		"paymentMethod":   "crypto",
 		"pricingCurrency": tokenSale.Currency,
 		"revenueCurrency": tokenSale.Currency,
-		"saleType":        "secondary",
+		"saleType":        saleType,
 		"transactionIDs":  []string{tokenSale.TxID},
 		"bundleTokenInfo": bundleTokenInfo,
 	}
@@ -783,17 +853,25 @@ func (w *Worker) GetTezosTxHashFromTzktTransactionID(_ context.Context, id uint6
 }
 
 // GetObjktSaleTransactionHashes get objkt sale transaction hashes by time with paging
-func (w *Worker) GetObjktSaleTransactionHashes(_ context.Context, lastTime *time.Time, offset, limit int) ([]string, error) {
-	var contracts []string
-	if viper.GetString("network") == "testnet" {
-		contracts = []string{indexer.TezosOBJKTMarketplaceAddressTestnet}
-	} else {
-		contracts = []string{indexer.TezosOBJKTMarketplaceAddress, indexer.TezosOBJKTMarketplaceAddressV2}
+func (w *Worker) GetObjktSaleTransactionHashes(ctx context.Context, lastTime *time.Time, offset, limit int) ([]string, error) {
+	return w.GetTezosSaleTransactionHashes(ctx, TezosMarketplaceObjkt, lastTime, offset, limit)
+}
+
+// GetTezosSaleTransactionHashes get the sale transaction hashes of a tezos marketplace by time with paging
+func (w *Worker) GetTezosSaleTransactionHashes(_ context.Context, marketplaceID string, lastTime *time.Time, offset, limit int) ([]string, error) {
+	marketplace, ok := TezosMarketplaces[marketplaceID]
+	if !ok {
+		return nil, ErrUnsupportedTezosMarketplace
+	}
+
+	contracts := marketplace.NetworkContracts()
+	if len(contracts) == 0 {
+		return []string{}, nil
 	}
 
 	txs, err := w.indexerEngine.GetTzktTransactionByContractsAndEntrypoint(
 		contracts,
-		indexer.OBJKTSaleEntrypoints,
+		marketplace.Entrypoints,
 		lastTime,
 		offset,
 		limit)
@@ -809,111 +887,33 @@ func (w *Worker) GetObjktSaleTransactionHashes(_ context.Context, lastTime *time
 	return hashes, nil
 }
 
-// GetTzktTransactionByID get tezos transaction hash by tzkt transaction id
+// ParseTezosObjktTokenSale parses the objkt sale of a tezos transaction hash
 func (w *Worker) ParseTezosObjktTokenSale(ctx context.Context, hash string) (*TokenSale, error) {
+	return w.ParseTezosTokenSale(ctx, TezosMarketplaceObjkt, hash)
+}
+
+// ParseTezosTokenSale parses the marketplace sale of a tezos transaction hash.
+// The marketplace is detected from the sale operation if the marketplace ID is empty.
+func (w *Worker) ParseTezosTokenSale(_ context.Context, marketplaceID, hash string) (*TokenSale, error) {
 	txs, err := w.indexerEngine.GetTzktTransactionsByHash(hash)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(txs) < 2 {
-		return nil, errors.New("invalid objkt tx")
-	}
-
-	saleEntrypointMap := make(map[string]bool)
-	for _, entrypoint := range indexer.OBJKTSaleEntrypoints {
-		saleEntrypointMap[entrypoint] = true
-	}
-
-	isValidObjktSaleOperation := false
-	bundleTokenInfo := []TokenSaleInfo{}
-	price := big.NewInt(0)
-	platformFeeWallets := viper.GetStringMapString("marketplace.fee_wallets") // key is lower case
-	platformFee := big.NewInt(0)
-	shares := make(map[string]*big.Int)
-	for _, tx := range txs {
-		if tx.Status != "applied" {
+	if marketplaceID == "" {
+		marketplace, ok := detectTezosMarketplace(txs)
+		if !ok {
 			return nil, nil
 		}
-
-		if tx.Parameter != nil {
-			// check for fulfill entrypoints
-			if saleEntrypointMap[tx.Parameter.EntryPoint] {
-				isValidObjktSaleOperation = true
-				continue
-			}
-
-			// process token transfers
-			if tx.Parameter.EntryPoint == "transfer" {
-				paramValues, err := decodeParametersValue(tx.Parameter.Value)
-				if err != nil {
-					// We don't support sale operations contain coin transfer operations
-					// Any sale operations contain invalid "transfer" will be ignored
-					return nil, errors.New("invalid transfer transaction - not supported buying using token")
-				}
-
-				for _, paramValue := range paramValues {
-					for _, ptx := range paramValue.Txs {
-						bundleTokenInfo = append(bundleTokenInfo, TokenSaleInfo{
-							SellerAddress:   paramValue.From,
-							BuyerAddress:    ptx.To,
-							TokenID:         ptx.TokenID,
-							ContractAddress: tx.Target.Address,
-						})
-					}
-				}
-			}
-		} else {
-			// process revenue shares transfers
-			amount := new(big.Int).SetUint64(tx.Amount)
-
-			// ignore proxy transfer to ProxyAddress for objktV1 contract
-			if tx.Target.Address == indexer.TezosOBJKTTreasuryProxyAddress {
-				continue
-			}
-
-			// Accumulate shares
-			if s, ok := shares[tx.Target.Address]; ok {
-				shares[tx.Target.Address] = big.NewInt(0).Add(s, amount)
-			} else {
-				shares[tx.Target.Address] = amount
-			}
-			price = big.NewInt(0).Add(price, amount)
-
-			// Deduct share
-			if s, ok := shares[tx.Sender.Address]; ok {
-				shares[tx.Sender.Address] = big.NewInt(0).Sub(s, amount)
-				price.Sub(price, amount)
-			}
-
-			// Accumulate platform fee
-			if platformFeeWallets[strings.ToLower(tx.Target.Address)] == "Objkt" {
-				platformFee = big.NewInt(0).Add(platformFee, amount)
-			}
-		}
-	}
-
-	if !isValidObjktSaleOperation {
-		return nil, errors.New("invalid objkt tx")
+		return parseTezosTokenSale(marketplace, hash, txs)
 	}
 
-	if len(bundleTokenInfo) == 0 {
-		return nil, errors.New("invalid sale transaction - no tokens transfer")
+	marketplace, ok := TezosMarketplaces[marketplaceID]
+	if !ok {
+		return nil, ErrUnsupportedTezosMarketplace
 	}
 
-	return &TokenSale{
-		Timestamp:       txs[0].Timestamp,
-		Price:           price,
-		Marketplace:     "Objkt",
-		Blockchain:      "tezos",
-		Currency:        "XTZ",
-		TxID:            hash,
-		PlatformFee:     platformFee,
-		NetRevenue:      big.NewInt(0).Sub(price, platformFee),
-		BundleTokenInfo: bundleTokenInfo,
-		PaymentAmount:   price,
-		Shares:          shares,
-	}, nil
+	return parseTezosTokenSale(marketplace, hash, txs)
 }
 
 func parseArraryMapInterface(input interface{}) ([]map[string]interface{}, error) {
@@ -1007,3 +1007,71 @@ func decodeParametersValue(input interface{}) (paramValues []tzkt.ParametersValu
 
 	return paramValues, nil
 }
+
+// IndexCollection saves collection data into indexer's storage
+func (w *Worker) IndexCollection(ctx context.Context, collection indexer.Collection) error {
+	return w.indexerStore.IndexCollection(ctx, collection)
+}
+
+// GetCollectionTokens returns a batch of the tokens of a collection. The token registry is read
+// and cached by the first batch of an indexing run, and the later batches of the run are served
+// from the cache of the worker.
+func (w *Worker) GetCollectionTokens(ctx context.Context, tokenDataURI, runID string, offset, size int) (CollectionTokens, error) {
+	key := runID + "|" + tokenDataURI
+	tokens, ok := w.collectionTokens.Get(key)
+	if !ok {
+		data, err := w.indexerEngine.ReadDataURI(ctx, tokenDataURI)
+		if err != nil {
+			return CollectionTokens{}, err
+		}
+
+		var tokenData indexer.TokenRegistry
+		if err := json.Unmarshal(data, &tokenData); err != nil {
+			return CollectionTokens{}, err
+		}
+
+		tokens = collectionTokens(tokenData)
+		w.collectionTokens.Add(key, tokens)
+	}
+
+	start := min(offset, len(tokens))
+	end := min(start+size, len(tokens))
+
+	return CollectionTokens{
+		Tokens: tokens[start:end],
+		Total:  len(tokens),
+	}, nil
+}
+
+// IndexCollectionTokens saves the collection assets of the tokens which are not burned
+// and returns the number of them
+func (w *Worker) IndexCollectionTokens(ctx context.Context, collectionID, runID string, indexIDs []string) (int, error) {
+	liveIndexIDs, err := w.indexerStore.FilterBurnedIndexIDs(ctx, indexIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(liveIndexIDs) == 0 {
+		return 0, nil
+	}
+
+	collectionAssets := make([]indexer.CollectionAsset, 0, len(liveIndexIDs))
+	for _, indexID := range liveIndexIDs {
+		collectionAssets = append(collectionAssets, indexer.CollectionAsset{
+			CollectionID: collectionID,
+			TokenIndexID: indexID,
+			RunID:        runID,
+		})
+	}
+
+	if err := w.indexerStore.IndexCollectionAsset(ctx, collectionID, collectionAssets); err != nil {
+		return 0, err
+	}
+
+	return len(liveIndexIDs), nil
+}
+
+// DeleteDeprecatedCollectionAsset deletes the collection assets which are not indexed by the given run
+func (w *Worker) DeleteDeprecatedCollectionAsset(ctx context.Context, collectionID, runID string) error {
+	return w.indexerStore.DeleteDeprecatedCollectionAsset(ctx, collectionID, runID)
+}
@@ -0,0 +1,326 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	"go.uber.org/zap"
+
+	"github.com/feral-file/ff-indexer/externals/coinbase"
+	"github.com/feral-file/ff-indexer/externals/coingecko"
+	"github.com/feral-file/ff-indexer/externals/kraken"
+)
+
+const (
+	ExchangeRateProviderCoinbase  = "coinbase"
+	ExchangeRateProviderKraken    = "kraken"
+	ExchangeRateProviderCoinGecko = "coingecko"
+)
+
+var ErrUnsupportedCurrencyPair = errors.New("unsupported currency pair")
+
+// DefaultExchangeRateProviders is the failover order of the providers if
+// `exchange_rate.providers` is not configured
+var DefaultExchangeRateProviders = []string{
+	ExchangeRateProviderCoinbase,
+	ExchangeRateProviderKraken,
+	ExchangeRateProviderCoinGecko,
+}
+
+// FiatCurrencies are the currencies which Coinbase quotes but has no products of
+var FiatCurrencies = []string{"USD", "EUR"}
+
+// KrakenOHLCIntervals are the OHLC intervals of Kraken in minutes
+var KrakenOHLCIntervals = []int64{1, 5, 15, 30, 60, 240, 1440, 10080, 21600}
+
+// KrakenOHLCEntries is the number of the latest OHLC entries which Kraken returns of an interval
+const KrakenOHLCEntries = 720
+
+// CoinGeckoPriceIntervals are the intervals of the prices of CoinGecko, which depend on the range
+var CoinGeckoPriceIntervals = []time.Duration{5 * time.Minute, time.Hour, 24 * time.Hour}
+
+// CoinGeckoCoinIDs maps the currencies to the coin ids of CoinGecko
+var CoinGeckoCoinIDs = map[string]string{
+	"ETH": "ethereum",
+	"XTZ": "tezos",
+}
+
+// ExchangeRateProvider returns the historical exchange rates of currency pairs
+type ExchangeRateProvider interface {
+	// Name returns the name of the provider
+	Name() string
+	// SupportsCurrencyPair returns whether the provider has the rates of a currency pair
+	SupportsCurrencyPair(currencyPair string) bool
+	// GetCandles returns the candles of a currency pair (e.g. ETH-USD) from start to end in
+	// unix seconds. The granularity is in seconds.
+	GetCandles(ctx context.Context, currencyPair string, granularity, start, end int64) ([]coinbase.HistoricalExchangeRate, error)
+}
+
+// ExchangeRateProviders is a list of providers in the failover order
+type ExchangeRateProviders []ExchangeRateProvider
+
+// NewExchangeRateProviders returns the providers of the names in order
+func NewExchangeRateProviders(names []string, coinGeckoAPIKey string) (ExchangeRateProviders, error) {
+	if len(names) == 0 {
+		names = DefaultExchangeRateProviders
+	}
+
+	providers := make(ExchangeRateProviders, 0, len(names))
+	for _, name := range names {
+		switch strings.ToLower(name) {
+		case ExchangeRateProviderCoinbase:
+			providers = append(providers, CoinbaseExchangeRateProvider{client: coinbase.NewClient()})
+		case ExchangeRateProviderKraken:
+			providers = append(providers, KrakenExchangeRateProvider{client: kraken.NewClient()})
+		case ExchangeRateProviderCoinGecko:
+			providers = append(providers, CoinGeckoExchangeRateProvider{client: coingecko.NewClient(coinGeckoAPIKey)})
+		default:
+			return nil, fmt.Errorf("unsupported exchange rate provider: %s", name)
+		}
+	}
+
+	return providers, nil
+}
+
+// GetCandles returns the candles of the first provider which has the rates of the currency pair.
+// The providers without the currency pair are not requested, and a provider which fails or
+// returns no candles is skipped. It returns an error only if all the providers of the currency
+// pair fail, or none of them has it.
+func (p ExchangeRateProviders) GetCandles(ctx context.Context, currencyPair string, granularity, start, end int64) ([]coinbase.HistoricalExchangeRate, error) {
+	var providers ExchangeRateProviders
+	for _, provider := range p {
+		if provider.SupportsCurrencyPair(currencyPair) {
+			providers = append(providers, provider)
+		}
+	}
+
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedCurrencyPair, currencyPair)
+	}
+
+	var errs []error
+	for _, provider := range providers {
+		rates, err := provider.GetCandles(ctx, currencyPair, granularity, start, end)
+		if err != nil {
+			log.WarnWithContext(ctx, "fail to get candles from exchange rate provider",
+				zap.String("provider", provider.Name()),
+				zap.String("currencyPair", currencyPair),
+				zap.Error(err))
+			errs = append(errs, fmt.Errorf("%s: %w", provider.Name(), err))
+			continue
+		}
+
+		if len(rates) > 0 {
+			return rates, nil
+		}
+	}
+
+	if len(errs) == len(providers) {
+		return nil, fmt.Errorf("no exchange rate provider succeeded: %w", errors.Join(errs...))
+	}
+
+	return nil, nil
+}
+
+// splitCurrencyPair returns the base and the quote currencies of a currency pair
+func splitCurrencyPair(currencyPair string) (string, string, error) {
+	v := strings.Split(currencyPair, "-")
+	if len(v) != 2 {
+		return "", "", fmt.Errorf("invalid currency pair: %s", currencyPair)
+	}
+	return v[0], v[1], nil
+}
+
+type CoinbaseExchangeRateProvider struct {
+	client *coinbase.Client
+}
+
+func (CoinbaseExchangeRateProvider) Name() string {
+	return ExchangeRateProviderCoinbase
+}
+
+// SupportsCurrencyPair returns false for the pairs of fiat currencies, e.g. EUR-USD, which
+// Coinbase has no products of
+func (CoinbaseExchangeRateProvider) SupportsCurrencyPair(currencyPair string) bool {
+	base, _, err := splitCurrencyPair(currencyPair)
+	return err == nil && !slices.Contains(FiatCurrencies, base)
+}
+
+func (p CoinbaseExchangeRateProvider) GetCandles(ctx context.Context, currencyPair string, granularity, start, end int64) ([]coinbase.HistoricalExchangeRate, error) {
+	rates, err := p.client.GetCandles(ctx, currencyPair, strconv.FormatInt(granularity, 10), start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rates {
+		rates[i].Granularity = granularity
+	}
+	return rates, nil
+}
+
+type KrakenExchangeRateProvider struct {
+	client *kraken.Client
+}
+
+func (KrakenExchangeRateProvider) Name() string {
+	return ExchangeRateProviderKraken
+}
+
+func (KrakenExchangeRateProvider) SupportsCurrencyPair(currencyPair string) bool {
+	_, _, err := splitCurrencyPair(currencyPair)
+	return err == nil
+}
+
+// GetCandles returns the OHLC data of Kraken. Kraken only returns the latest 720 entries of an
+// interval, so the candles of an older range are of the finest interval which still reaches it.
+// The candles whose windows start before the range are skipped, so that a window is not mixed
+// with the candles of another provider.
+func (p KrakenExchangeRateProvider) GetCandles(ctx context.Context, currencyPair string, granularity, start, end int64) ([]coinbase.HistoricalExchangeRate, error) {
+	base, quote, err := splitCurrencyPair(currencyPair)
+	if err != nil {
+		return nil, err
+	}
+
+	interval, ok := krakenInterval(granularity, time.Now().Unix()-start)
+	if !ok {
+		return nil, nil
+	}
+
+	data, err := p.client.GetOHLC(ctx, base+quote, interval/60, start)
+	if err != nil {
+		return nil, err
+	}
+
+	var rates []coinbase.HistoricalExchangeRate
+	for _, o := range data {
+		if o.Time.Unix() < start || o.Time.Unix() >= end {
+			continue
+		}
+
+		rates = append(rates, coinbase.HistoricalExchangeRate{
+			Time:         o.Time,
+			Low:          o.Low,
+			High:         o.High,
+			Open:         o.Open,
+			Close:        o.Close,
+			CurrencyPair: currencyPair,
+			Granularity:  interval,
+		})
+	}
+
+	return rates, nil
+}
+
+// krakenInterval returns the finest OHLC interval of Kraken in seconds which is not finer than
+// the granularity and whose latest entries reach back the age. It returns false if the age is
+// older than all the intervals reach.
+func krakenInterval(granularity, age int64) (int64, bool) {
+	for _, minutes := range KrakenOHLCIntervals {
+		interval := minutes * 60
+		if interval >= granularity && age <= interval*KrakenOHLCEntries {
+			return interval, true
+		}
+	}
+	return 0, false
+}
+
+type CoinGeckoExchangeRateProvider struct {
+	client *coingecko.Client
+}
+
+func (CoinGeckoExchangeRateProvider) Name() string {
+	return ExchangeRateProviderCoinGecko
+}
+
+func (CoinGeckoExchangeRateProvider) SupportsCurrencyPair(currencyPair string) bool {
+	base, _, err := splitCurrencyPair(currencyPair)
+	if err != nil {
+		return false
+	}
+
+	_, ok := CoinGeckoCoinIDs[base]
+	return ok
+}
+
+// GetCandles returns the prices of CoinGecko as candles. The prices are coarser than the
+// granularity for the old ranges, so each candle has the first price of its window, and the
+// window is the granularity of the prices if it is coarser.
+func (p CoinGeckoExchangeRateProvider) GetCandles(ctx context.Context, currencyPair string, granularity, start, end int64) ([]coinbase.HistoricalExchangeRate, error) {
+	base, quote, err := splitCurrencyPair(currencyPair)
+	if err != nil {
+		return nil, err
+	}
+
+	coinID, ok := CoinGeckoCoinIDs[base]
+	if !ok {
+		return nil, ErrUnsupportedCurrencyPair
+	}
+
+	prices, err := p.client.GetMarketChartRange(ctx, coinID, quote, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return pricesToCandles(prices, currencyPair, time.Duration(granularity)*time.Second, time.Unix(start, 0)), nil
+}
+
+// pricesToCandles returns a candle of the first price in each window. The window is the
+// granularity, or the price interval of CoinGecko which the prices have if it is coarser. The
+// windows which start before the start are skipped, so that a window is not mixed with the
+// candles of another provider.
+func pricesToCandles(prices []coingecko.Price, currencyPair string, granularity time.Duration, start time.Time) []coinbase.HistoricalExchangeRate {
+	window := granularity
+	if interval := pricesInterval(prices); interval > granularity {
+		window = max(CoinGeckoPriceIntervals[len(CoinGeckoPriceIntervals)-1], granularity)
+		for _, d := range CoinGeckoPriceIntervals {
+			// the prices are not evenly spaced, so an interval is matched with a margin
+			if interval <= d*3/2 {
+				window = max(d, granularity)
+				break
+			}
+		}
+	}
+
+	var rates []coinbase.HistoricalExchangeRate
+	seen := make(map[time.Time]bool)
+	for _, p := range prices {
+		t := p.Time.Truncate(window)
+		if seen[t] || t.Before(start) {
+			continue
+		}
+		seen[t] = true
+
+		rates = append(rates, coinbase.HistoricalExchangeRate{
+			Time:         t,
+			Low:          p.Price,
+			High:         p.Price,
+			Open:         p.Price,
+			Close:        p.Price,
+			CurrencyPair: currencyPair,
+			Granularity:  int64(window.Seconds()),
+		})
+	}
+	return rates
+}
+
+// pricesInterval returns the median interval of the consecutive prices, which is zero if there
+// are less than two prices
+func pricesInterval(prices []coingecko.Price) time.Duration {
+	intervals := make([]time.Duration, 0, len(prices))
+	for i := 1; i < len(prices); i++ {
+		intervals = append(intervals, prices[i].Time.Sub(prices[i-1].Time))
+	}
+
+	if len(intervals) == 0 {
+		return 0
+	}
+
+	slices.Sort(intervals)
+	return intervals[len(intervals)/2]
+}
@@ -0,0 +1,225 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/cadence/testsuite"
+
+	indexer "github.com/feral-file/ff-indexer"
+	"github.com/feral-file/ff-indexer/externals/coinbase"
+	"github.com/feral-file/ff-indexer/externals/coingecko"
+)
+
+type testExchangeRateProvider struct {
+	name  string
+	rates []coinbase.HistoricalExchangeRate
+	err   error
+	// unsupported is a currency pair which the provider has no rates of
+	unsupported string
+}
+
+func (p testExchangeRateProvider) Name() string {
+	return p.name
+}
+
+func (p testExchangeRateProvider) SupportsCurrencyPair(currencyPair string) bool {
+	return currencyPair != p.unsupported
+}
+
+func (p testExchangeRateProvider) GetCandles(_ context.Context, _ string, _, _, _ int64) ([]coinbase.HistoricalExchangeRate, error) {
+	return p.rates, p.err
+}
+
+func TestExchangeRateProvidersFailover(t *testing.T) {
+	if err := log.Initialize(false, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	rates := []coinbase.HistoricalExchangeRate{{Open: 1, CurrencyPair: "ETH-USD"}}
+	limited := testExchangeRateProvider{name: "limited", err: errors.New("too many requests")}
+	empty := testExchangeRateProvider{name: "empty"}
+
+	result, err := ExchangeRateProviders{limited, empty, testExchangeRateProvider{name: "ok", rates: rates}}.
+		GetCandles(context.Background(), "ETH-USD", 60, 0, 60)
+	assert.NoError(t, err)
+	assert.Equal(t, rates, result)
+
+	result, err = ExchangeRateProviders{limited, empty}.GetCandles(context.Background(), "ETH-USD", 60, 0, 60)
+	assert.NoError(t, err)
+	assert.Empty(t, result)
+
+	_, err = ExchangeRateProviders{limited}.GetCandles(context.Background(), "ETH-USD", 60, 0, 60)
+	assert.ErrorContains(t, err, "limited: too many requests")
+
+	_, err = NewExchangeRateProviders([]string{"coinbase", "binance"}, "")
+	assert.Error(t, err)
+}
+
+func TestExchangeRateProvidersOfCurrencyPair(t *testing.T) {
+	if err := log.Initialize(false, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	rates := []coinbase.HistoricalExchangeRate{{Open: 1, CurrencyPair: "EUR-USD"}}
+	providers := ExchangeRateProviders{
+		testExchangeRateProvider{name: "crypto", err: errors.New("not found"), unsupported: "EUR-USD"},
+		testExchangeRateProvider{name: "forex", rates: rates},
+	}
+
+	// the providers without the currency pair are not requested, so their errors are not counted
+	result, err := providers.GetCandles(context.Background(), "EUR-USD", 60, 0, 60)
+	assert.NoError(t, err)
+	assert.Equal(t, rates, result)
+
+	_, err = providers[:1].GetCandles(context.Background(), "EUR-USD", 60, 0, 60)
+	assert.ErrorIs(t, err, ErrUnsupportedCurrencyPair)
+
+	defaults, err := NewExchangeRateProviders(nil, "")
+	assert.NoError(t, err)
+	for _, pair := range indexer.DefaultCurrencyPairs {
+		supported := false
+		for _, provider := range defaults {
+			supported = supported || provider.SupportsCurrencyPair(pair)
+		}
+		assert.True(t, supported, pair)
+	}
+	assert.False(t, defaults[0].SupportsCurrencyPair("EUR-USD"))
+	assert.True(t, defaults[1].SupportsCurrencyPair("EUR-USD"))
+	assert.False(t, defaults[2].SupportsCurrencyPair("EUR-USD"))
+}
+
+func TestPricesToCandles(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	rates := pricesToCandles([]coingecko.Price{
+		{Time: t0.Add(10 * time.Second), Price: 1},
+		{Time: t0.Add(50 * time.Second), Price: 2},
+		{Time: t0.Add(70 * time.Second), Price: 3},
+	}, "ETH-USD", time.Minute, t0)
+
+	assert.Equal(t, []coinbase.HistoricalExchangeRate{
+		{Time: t0, Low: 1, High: 1, Open: 1, Close: 1, CurrencyPair: "ETH-USD", Granularity: 60},
+		{Time: t0.Add(time.Minute), Low: 3, High: 3, Open: 3, Close: 3, CurrencyPair: "ETH-USD", Granularity: 60},
+	}, rates)
+}
+
+func TestPricesToCandlesOfCoarsePrices(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// the hourly prices of an old range are candles of an hour, and the window which starts
+	// before the range is left to the other providers
+	rates := pricesToCandles([]coingecko.Price{
+		{Time: t0.Add(3 * time.Minute), Price: 1},
+		{Time: t0.Add(time.Hour + 2*time.Minute), Price: 2},
+		{Time: t0.Add(2*time.Hour + 4*time.Minute), Price: 3},
+	}, "ETH-USD", time.Minute, t0.Add(30*time.Minute))
+
+	assert.Equal(t, []coinbase.HistoricalExchangeRate{
+		{Time: t0.Add(time.Hour), Low: 2, High: 2, Open: 2, Close: 2, CurrencyPair: "ETH-USD", Granularity: 3600},
+		{Time: t0.Add(2 * time.Hour), Low: 3, High: 3, Open: 3, Close: 3, CurrencyPair: "ETH-USD", Granularity: 3600},
+	}, rates)
+}
+
+func TestKrakenInterval(t *testing.T) {
+	interval, ok := krakenInterval(60, 3600)
+	assert.True(t, ok)
+	assert.Equal(t, int64(60), interval)
+
+	// 720 minutes are the oldest range of the one-minute candles
+	interval, ok = krakenInterval(60, 720*60+1)
+	assert.True(t, ok)
+	assert.Equal(t, int64(300), interval)
+
+	interval, ok = krakenInterval(60, 30*24*3600)
+	assert.True(t, ok)
+	assert.Equal(t, int64(3600), interval)
+
+	_, ok = krakenInterval(60, 21600*60*KrakenOHLCEntries+1)
+	assert.False(t, ok)
+}
+
+func TestExchangeRateGapRequests(t *testing.T) {
+	t0 := time.Unix(0, 0).UTC()
+	gap := func(pair string, from, to int64) indexer.ExchangeRateGap {
+		return indexer.ExchangeRateGap{CurrencyPair: pair, From: t0.Add(time.Duration(from) * time.Second), To: t0.Add(time.Duration(to) * time.Second)}
+	}
+
+	requests := exchangeRateGapRequests([]indexer.ExchangeRateGap{
+		gap("ETH-USD", 0, 60),
+		gap("ETH-USD", 120, 180),
+		gap("ETH-USD", 300, 600),
+		gap("XTZ-USD", 0, 60),
+	}, 240)
+
+	assert.Equal(t, []RequestChunk{
+		{"ETH-USD", 0, 180},
+		{"ETH-USD", 300, 540},
+		{"ETH-USD", 540, 600},
+		{"XTZ-USD", 0, 60},
+	}, requests)
+}
+
+func TestCrawlHistoricalExchangeRateConfiguredPairs(t *testing.T) {
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestWorkflowEnvironment()
+
+	w := &Worker{}
+	env.RegisterWorkflow(w.CrawlHistoricalExchangeRate)
+	env.RegisterWorkflow(w.CrawlExchangeRateByCurrencyPair)
+	env.RegisterActivity(w.GetCurrencyPairs)
+
+	env.OnActivity(w.GetCurrencyPairs, mock.Anything).Return([]string{"ETH-USD"}, nil).Once()
+	env.OnWorkflow(w.CrawlExchangeRateByCurrencyPair, mock.Anything, "ETH-USD", int64(0), int64(600)).Return(nil).Once()
+
+	env.ExecuteWorkflow(w.CrawlHistoricalExchangeRate, []string{}, int64(0), int64(600))
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func TestCrawlHistoricalExchangeRateUnsupportedPairs(t *testing.T) {
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestWorkflowEnvironment()
+
+	w := &Worker{}
+	env.RegisterWorkflow(w.CrawlHistoricalExchangeRate)
+	env.RegisterWorkflow(w.CrawlExchangeRateByCurrencyPair)
+	env.RegisterActivity(w.GetCurrencyPairs)
+
+	env.OnActivity(w.GetCurrencyPairs, mock.Anything).Return([]string{"ETH-USD"}, nil).Once()
+
+	env.ExecuteWorkflow(w.CrawlHistoricalExchangeRate, []string{"DOGE-USD"}, int64(0), int64(600))
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func TestBackfillHistoricalExchangeRateConfiguredPairs(t *testing.T) {
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestWorkflowEnvironment()
+
+	w := &Worker{}
+	env.RegisterWorkflow(w.BackfillHistoricalExchangeRate)
+	env.RegisterWorkflow(w.CrawlExchangeRateByCurrencyPair)
+	env.RegisterActivity(w.GetCurrencyPairs)
+	env.RegisterActivity(w.GetExchangeRateGaps)
+
+	env.OnActivity(w.GetCurrencyPairs, mock.Anything).Return([]string{"ETH-USD"}, nil).Once()
+	env.OnActivity(w.GetExchangeRateGaps, mock.Anything, "ETH-USD", int64(0), int64(600)).Return([]indexer.ExchangeRateGap{
+		{CurrencyPair: "ETH-USD", From: time.Unix(60, 0), To: time.Unix(120, 0)},
+	}, nil).Once()
+	env.OnWorkflow(w.CrawlExchangeRateByCurrencyPair, mock.Anything, "ETH-USD", int64(60), int64(120)).Return(nil).Once()
+
+	env.ExecuteWorkflow(w.BackfillHistoricalExchangeRate, []string{}, int64(0), int64(600))
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
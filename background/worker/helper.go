@@ -244,9 +244,9 @@ func StartIndexExchangeRateCronWorkflow(c context.Context, client *cadence.Worke
 
 	var w Worker
 
-	pairs := []string{"ETH-USD", "XTZ-USD"}
+	// the workflow crawls the configured currency pairs if none is given
 	if _, err := client.StartWorkflow(c, ClientName,
-		workflowContext, w.CrawlHistoricalExchangeRate, pairs, int64(0), int64(0)); err != nil {
+		workflowContext, w.CrawlHistoricalExchangeRate, []string{}, int64(0), int64(0)); err != nil {
 		var isAlreadyStartedError *shared.WorkflowExecutionAlreadyStartedError
 		if !errors.As(err, &isAlreadyStartedError) {
 			log.ErrorWithContext(c, errors.New("fail to start index exchange rate workflow"), zap.Error(err))
@@ -258,3 +258,77 @@ func StartIndexExchangeRateCronWorkflow(c context.Context, client *cadence.Worke
 
 	return nil
 }
+
+// StartIndexCollectionWorkflow starts a workflow to index a collection and its tokens. A running
+// workflow of the same collection is terminated since the input supersedes it.
+func StartIndexCollectionWorkflow(c context.Context, client *cadence.WorkerClient, caller string, input IndexCollectionInput) (string, error) {
+	workflowContext := cadenceClient.StartWorkflowOptions{
+		ID:                           WorkflowIDIndexCollection(input.Collection.ID),
+		TaskList:                     TaskListName,
+		ExecutionStartToCloseTimeout: 2 * time.Hour,
+		WorkflowIDReusePolicy:        cadenceClient.WorkflowIDReusePolicyTerminateIfRunning,
+		RetryPolicy: &uberCadence.RetryPolicy{
+			InitialInterval:    30 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    10 * time.Minute,
+			MaximumAttempts:    10,
+		},
+	}
+
+	var w Worker
+
+	workflow, err := client.StartWorkflow(c, ClientName, workflowContext, w.IndexCollectionWorkflow, input)
+	if err != nil {
+		log.WarnWithContext(c, "fail to start indexing collection workflow", zap.Error(err),
+			zap.String("caller", caller), zap.String("collectionID", input.Collection.ID))
+		return "", err
+	}
+
+	log.Debug("start workflow to index a collection",
+		zap.String("caller", caller),
+		zap.String("collectionID", input.Collection.ID),
+		zap.String("workflow_id", workflow.ID),
+		zap.String("tokenDataURI", input.TokenDataURI))
+
+	return workflow.ID, nil
+}
+
+// GetIndexCollectionStatus queries the progress of a collection indexing workflow
+func GetIndexCollectionStatus(c context.Context, client *cadence.WorkerClient, workflowID string) (*IndexCollectionStatus, error) {
+	value, err := client.QueryWorkflow(c, ClientName, workflowID, "", IndexCollectionStatusQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	var status IndexCollectionStatus
+	if err := value.Get(&status); err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+func StartBackfillExchangeRateCronWorkflow(c context.Context, client *cadence.WorkerClient) error {
+	workflowContext := cadenceClient.StartWorkflowOptions{
+		ID:                           "backfill-exchange-rate-cron",
+		TaskList:                     TaskListName,
+		ExecutionStartToCloseTimeout: time.Hour,
+		CronSchedule:                 "30 * * * *", //every hour
+	}
+
+	var w Worker
+
+	// the workflow backfills the gaps of the last day of the configured currency pairs
+	if _, err := client.StartWorkflow(c, ClientName,
+		workflowContext, w.BackfillHistoricalExchangeRate, []string{}, int64(0), int64(0)); err != nil {
+		var isAlreadyStartedError *shared.WorkflowExecutionAlreadyStartedError
+		if !errors.As(err, &isAlreadyStartedError) {
+			log.ErrorWithContext(c, errors.New("fail to start backfill exchange rate workflow"), zap.Error(err))
+			return err
+		}
+	}
+
+	log.Debug("workflow backfill exchange rate started")
+
+	return nil
+}
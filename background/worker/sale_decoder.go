@@ -0,0 +1,230 @@
+package worker
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	utils "github.com/bitmark-inc/autonomy-utils"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/viper"
+
+	indexer "github.com/feral-file/ff-indexer"
+)
+
+// SaleDecoder decodes the token sale of a marketplace protocol from a transaction
+type SaleDecoder interface {
+	// Name returns the name of the decoder
+	Name() string
+	// Decode returns the token sale of a transaction, or nil if the transaction
+	// has no sale of the protocol
+	Decode(tx *types.Transaction, receipt *types.Receipt) (*TokenSale, error)
+}
+
+// SaleDecoderRegistry is an ordered list of sale decoders
+type SaleDecoderRegistry struct {
+	decoders []SaleDecoder
+}
+
+func NewSaleDecoderRegistry(decoders ...SaleDecoder) *SaleDecoderRegistry {
+	return &SaleDecoderRegistry{decoders: decoders}
+}
+
+// Register adds a decoder to the registry. It should be called before the worker starts.
+func (r *SaleDecoderRegistry) Register(decoder SaleDecoder) {
+	r.decoders = append(r.decoders, decoder)
+}
+
+// Decode returns the sale of the first decoder which recognises the transaction
+func (r *SaleDecoderRegistry) Decode(tx *types.Transaction, receipt *types.Receipt) (*TokenSale, error) {
+	for _, d := range r.decoders {
+		sale, err := d.Decode(tx, receipt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", d.Name(), err)
+		}
+
+		if sale != nil {
+			return sale, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// EthereumSaleDecoders are the decoders which ParseEthereumTokenSale tries before
+// falling back to the payment heuristic
+var EthereumSaleDecoders = NewSaleDecoderRegistry(
+	SeaportSaleDecoder{},
+	BlurSaleDecoder{},
+	X2Y2SaleDecoder{},
+	LooksRareSaleDecoder{},
+	FeralFileSaleDecoder{},
+)
+
+// saleBuilder accumulates the tokens and the payments of the sale events of a transaction
+type saleBuilder struct {
+	currency string
+	tokens   []TokenSaleInfo
+	tokenMap map[string]int
+	shares   map[string]*big.Int
+
+	// fee is the platform fee which is not paid to a known address
+	fee *big.Int
+}
+
+func newSaleBuilder() *saleBuilder {
+	return &saleBuilder{
+		tokenMap: make(map[string]int),
+		shares:   make(map[string]*big.Int),
+		fee:      big.NewInt(0),
+	}
+}
+
+// setCurrency returns false if the sale is already paid in another currency
+func (b *saleBuilder) setCurrency(currency string) bool {
+	if b.currency != "" && b.currency != currency {
+		return false
+	}
+
+	b.currency = currency
+	return true
+}
+
+// addToken adds a sold token. The seller and the buyer are kept from the first known value.
+func (b *saleBuilder) addToken(contract common.Address, tokenID *big.Int, seller, buyer common.Address) {
+	key := fmt.Sprintf("%s-%s", contract.Hex(), tokenID.Text(10))
+	i, ok := b.tokenMap[key]
+	if !ok {
+		i = len(b.tokens)
+		b.tokenMap[key] = i
+		b.tokens = append(b.tokens, TokenSaleInfo{
+			ContractAddress: contract.Hex(),
+			TokenID:         tokenID.Text(10),
+		})
+	}
+
+	if b.tokens[i].SellerAddress == "" && seller != (common.Address{}) {
+		b.tokens[i].SellerAddress = seller.Hex()
+	}
+
+	if b.tokens[i].BuyerAddress == "" && buyer != (common.Address{}) {
+		b.tokens[i].BuyerAddress = buyer.Hex()
+	}
+}
+
+// addShare adds a payment to an address
+func (b *saleBuilder) addShare(to common.Address, amount *big.Int) {
+	if amount.Sign() <= 0 {
+		return
+	}
+
+	if s, ok := b.shares[to.Hex()]; ok {
+		b.shares[to.Hex()] = new(big.Int).Add(s, amount)
+	} else {
+		b.shares[to.Hex()] = new(big.Int).Set(amount)
+	}
+}
+
+// addFee adds a platform fee which is paid to an address not known from the events
+func (b *saleBuilder) addFee(amount *big.Int) {
+	if amount.Sign() > 0 {
+		b.fee.Add(b.fee, amount)
+	}
+}
+
+// build returns the token sale. The price is the sum of all payments. Payments to the
+// marketplace fee wallets are platform fees and payments to anyone but the sellers
+// are royalties.
+func (b *saleBuilder) build(marketplace string) *TokenSale {
+	if len(b.tokens) == 0 || b.currency == "" {
+		return nil
+	}
+
+	feeWallets := viper.GetStringMapString("marketplace.fee_wallets") // key is lower case
+	sellers := make(map[string]bool)
+	for _, t := range b.tokens {
+		sellers[t.SellerAddress] = true
+	}
+
+	price := new(big.Int).Set(b.fee)
+	platformFee := new(big.Int).Set(b.fee)
+	royalty := big.NewInt(0)
+	for address, amount := range b.shares {
+		price.Add(price, amount)
+		if _, ok := feeWallets[strings.ToLower(address)]; ok {
+			platformFee.Add(platformFee, amount)
+		} else if !sellers[address] {
+			royalty.Add(royalty, amount)
+		}
+	}
+
+	if price.Sign() == 0 {
+		return nil
+	}
+
+	return &TokenSale{
+		BundleTokenInfo: b.tokens,
+		Price:           price,
+		Marketplace:     marketplace,
+		Blockchain:      utils.EthereumBlockchain,
+		Currency:        b.currency,
+		PlatformFee:     platformFee,
+		Royalty:         royalty,
+		NetRevenue:      new(big.Int).Sub(price, platformFee),
+		PaymentAmount:   price,
+		Shares:          b.shares,
+	}
+}
+
+// saleCurrency returns the currency of a payment token. The zero address is ETH.
+func saleCurrency(token common.Address) (string, bool) {
+	if token == (common.Address{}) || token == common.HexToAddress(indexer.BlurPoolAddress) {
+		return "ETH", true
+	}
+
+	currency, ok := viper.GetStringMapString("ethereum.erc20")[strings.ToLower(token.Hex())] // key is lower case
+	return currency, ok
+}
+
+// logsBySignature returns the logs of a receipt with the event signature
+func logsBySignature(receipt *types.Receipt, signature string) []types.Log {
+	var logs []types.Log
+	for _, l := range receipt.Logs {
+		if nil == l || len(l.Topics) == 0 {
+			continue
+		}
+
+		if l.Topics[0].Hex() == signature {
+			logs = append(logs, *l)
+		}
+	}
+	return logs
+}
+
+// exchangeLogs keeps the logs emitted by the configured marketplace contracts, so the same
+// events emitted by any other contract are not taken as sales
+func exchangeLogs(logs []types.Log) []types.Log {
+	contracts := viper.GetStringMapString("marketplace.contracts") // key is lower case
+	var result []types.Log
+	for _, l := range logs {
+		if _, ok := contracts[strings.ToLower(l.Address.Hex())]; ok {
+			result = append(result, l)
+		}
+	}
+	return result
+}
+
+// exchangeName returns the marketplace name of a configured exchange contract
+func exchangeName(address common.Address) string {
+	return viper.GetStringMapString("marketplace.contracts")[strings.ToLower(address.Hex())] // key is lower case
+}
+
+func mustNewType(t string, components ...abi.ArgumentMarshaling) abi.Type {
+	typ, err := abi.NewType(t, "", components)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
@@ -0,0 +1,639 @@
+package worker
+
+import (
+	"bytes"
+	"math/big"
+
+	feralfilev4 "github.com/bitmark-inc/feralfile-exhibition-smart-contract/go-binding/feralfile-exhibition-v4"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	indexer "github.com/feral-file/ff-indexer"
+)
+
+var (
+	seaportOrderFulfilledData abi.Arguments
+	blurOrdersMatchedData     abi.Arguments
+	x2y2EvInventoryData       abi.Arguments
+	x2y2ERC721PairsData       abi.Arguments
+	x2y2ERC1155PairsData      abi.Arguments
+	looksRareTakerBidData     abi.Arguments
+	looksRareTakerAskData     abi.Arguments
+)
+
+func init() {
+	seaportOrderFulfilledData = abi.Arguments{
+		{Name: "orderHash", Type: mustNewType("bytes32")},
+		{Name: "recipient", Type: mustNewType("address")},
+		{Name: "offer", Type: mustNewType("tuple[]",
+			abi.ArgumentMarshaling{Name: "itemType", Type: "uint8"},
+			abi.ArgumentMarshaling{Name: "token", Type: "address"},
+			abi.ArgumentMarshaling{Name: "identifier", Type: "uint256"},
+			abi.ArgumentMarshaling{Name: "amount", Type: "uint256"},
+		)},
+		{Name: "consideration", Type: mustNewType("tuple[]",
+			abi.ArgumentMarshaling{Name: "itemType", Type: "uint8"},
+			abi.ArgumentMarshaling{Name: "token", Type: "address"},
+			abi.ArgumentMarshaling{Name: "identifier", Type: "uint256"},
+			abi.ArgumentMarshaling{Name: "amount", Type: "uint256"},
+			abi.ArgumentMarshaling{Name: "recipient", Type: "address"},
+		)},
+	}
+
+	blurOrder := mustNewType("tuple",
+		abi.ArgumentMarshaling{Name: "trader", Type: "address"},
+		abi.ArgumentMarshaling{Name: "side", Type: "uint8"},
+		abi.ArgumentMarshaling{Name: "matchingPolicy", Type: "address"},
+		abi.ArgumentMarshaling{Name: "collection", Type: "address"},
+		abi.ArgumentMarshaling{Name: "tokenId", Type: "uint256"},
+		abi.ArgumentMarshaling{Name: "amount", Type: "uint256"},
+		abi.ArgumentMarshaling{Name: "paymentToken", Type: "address"},
+		abi.ArgumentMarshaling{Name: "price", Type: "uint256"},
+		abi.ArgumentMarshaling{Name: "listingTime", Type: "uint256"},
+		abi.ArgumentMarshaling{Name: "expirationTime", Type: "uint256"},
+		abi.ArgumentMarshaling{Name: "fees", Type: "tuple[]", Components: []abi.ArgumentMarshaling{
+			{Name: "rate", Type: "uint16"},
+			{Name: "recipient", Type: "address"},
+		}},
+		abi.ArgumentMarshaling{Name: "salt", Type: "uint256"},
+		abi.ArgumentMarshaling{Name: "extraParams", Type: "bytes"},
+	)
+	blurOrdersMatchedData = abi.Arguments{
+		{Name: "sell", Type: blurOrder},
+		{Name: "sellHash", Type: mustNewType("bytes32")},
+		{Name: "buy", Type: blurOrder},
+		{Name: "buyHash", Type: mustNewType("bytes32")},
+	}
+
+	x2y2EvInventoryData = abi.Arguments{
+		{Name: "maker", Type: mustNewType("address")},
+		{Name: "taker", Type: mustNewType("address")},
+		{Name: "orderSalt", Type: mustNewType("uint256")},
+		{Name: "settleSalt", Type: mustNewType("uint256")},
+		{Name: "intent", Type: mustNewType("uint256")},
+		{Name: "delegateType", Type: mustNewType("uint256")},
+		{Name: "deadline", Type: mustNewType("uint256")},
+		{Name: "currency", Type: mustNewType("address")},
+		{Name: "dataMask", Type: mustNewType("bytes")},
+		{Name: "item", Type: mustNewType("tuple",
+			abi.ArgumentMarshaling{Name: "price", Type: "uint256"},
+			abi.ArgumentMarshaling{Name: "data", Type: "bytes"},
+		)},
+		{Name: "detail", Type: mustNewType("tuple",
+			abi.ArgumentMarshaling{Name: "op", Type: "uint8"},
+			abi.ArgumentMarshaling{Name: "orderIdx", Type: "uint256"},
+			abi.ArgumentMarshaling{Name: "itemIdx", Type: "uint256"},
+			abi.ArgumentMarshaling{Name: "price", Type: "uint256"},
+			abi.ArgumentMarshaling{Name: "itemHash", Type: "bytes32"},
+			abi.ArgumentMarshaling{Name: "executionDelegate", Type: "address"},
+			abi.ArgumentMarshaling{Name: "dataReplacement", Type: "bytes"},
+			abi.ArgumentMarshaling{Name: "bidIncentivePct", Type: "uint256"},
+			abi.ArgumentMarshaling{Name: "aucMinIncrementPct", Type: "uint256"},
+			abi.ArgumentMarshaling{Name: "aucIncDurationSecs", Type: "uint256"},
+			abi.ArgumentMarshaling{Name: "fees", Type: "tuple[]", Components: []abi.ArgumentMarshaling{
+				{Name: "percentage", Type: "uint256"},
+				{Name: "to", Type: "address"},
+			}},
+		)},
+	}
+	x2y2ERC721PairsData = abi.Arguments{
+		{Name: "pairs", Type: mustNewType("tuple[]",
+			abi.ArgumentMarshaling{Name: "token", Type: "address"},
+			abi.ArgumentMarshaling{Name: "tokenId", Type: "uint256"},
+		)},
+	}
+	x2y2ERC1155PairsData = abi.Arguments{
+		{Name: "pairs", Type: mustNewType("tuple[]",
+			abi.ArgumentMarshaling{Name: "token", Type: "address"},
+			abi.ArgumentMarshaling{Name: "tokenId", Type: "uint256"},
+			abi.ArgumentMarshaling{Name: "amount", Type: "uint256"},
+		)},
+	}
+
+	looksRareNonceInvalidation := mustNewType("tuple",
+		abi.ArgumentMarshaling{Name: "orderHash", Type: "bytes32"},
+		abi.ArgumentMarshaling{Name: "orderNonce", Type: "uint256"},
+		abi.ArgumentMarshaling{Name: "isNonceInvalidated", Type: "bool"},
+	)
+	looksRareTakerBidData = abi.Arguments{
+		{Name: "nonceInvalidationParameters", Type: looksRareNonceInvalidation},
+		{Name: "bidUser", Type: mustNewType("address")},
+		{Name: "bidRecipient", Type: mustNewType("address")},
+		{Name: "strategyId", Type: mustNewType("uint256")},
+		{Name: "currency", Type: mustNewType("address")},
+		{Name: "collection", Type: mustNewType("address")},
+		{Name: "itemIds", Type: mustNewType("uint256[]")},
+		{Name: "amounts", Type: mustNewType("uint256[]")},
+		{Name: "feeRecipients", Type: mustNewType("address[2]")},
+		{Name: "feeAmounts", Type: mustNewType("uint256[3]")},
+	}
+	looksRareTakerAskData = abi.Arguments{
+		{Name: "nonceInvalidationParameters", Type: looksRareNonceInvalidation},
+		{Name: "askUser", Type: mustNewType("address")},
+		{Name: "bidUser", Type: mustNewType("address")},
+		{Name: "strategyId", Type: mustNewType("uint256")},
+		{Name: "currency", Type: mustNewType("address")},
+		{Name: "collection", Type: mustNewType("address")},
+		{Name: "itemIds", Type: mustNewType("uint256[]")},
+		{Name: "amounts", Type: mustNewType("uint256[]")},
+		{Name: "feeRecipients", Type: mustNewType("address[2]")},
+		{Name: "feeAmounts", Type: mustNewType("uint256[3]")},
+	}
+}
+
+// Seaport
+
+type seaportSpentItem struct {
+	ItemType   uint8
+	Token      common.Address
+	Identifier *big.Int
+	Amount     *big.Int
+}
+
+type seaportReceivedItem struct {
+	ItemType   uint8
+	Token      common.Address
+	Identifier *big.Int
+	Amount     *big.Int
+	Recipient  common.Address
+}
+
+type seaportOrderFulfilled struct {
+	OrderHash     [32]byte
+	Recipient     common.Address
+	Offer         []seaportSpentItem
+	Consideration []seaportReceivedItem
+}
+
+// seaportNFTItem returns whether a seaport item type is ERC721 or ERC1155, with or without criteria
+func seaportNFTItem(itemType uint8) bool {
+	return itemType >= 2
+}
+
+// SeaportSaleDecoder decodes the OrderFulfilled events of Seaport
+type SeaportSaleDecoder struct{}
+
+func (SeaportSaleDecoder) Name() string {
+	return "seaport"
+}
+
+// Decode merges all fulfilled orders of a tx. The consideration payments are the shares of
+// the sale. When an order pays by its offer, like an accepted bid, the payment left after
+// the consideration goes to the fulfiller. Seaport is shared by several marketplaces, so
+// the marketplace is the configured name of the exchange contract which emits the events.
+func (SeaportSaleDecoder) Decode(_ *types.Transaction, receipt *types.Receipt) (*TokenSale, error) {
+	logs := exchangeLogs(logsBySignature(receipt, indexer.SeaportOrderFulfilledEventSignature))
+	if len(logs) == 0 {
+		return nil, nil
+	}
+
+	b := newSaleBuilder()
+	for _, l := range logs {
+		if len(l.Topics) != 3 {
+			continue
+		}
+		offerer := common.BytesToAddress(l.Topics[1].Bytes())
+
+		values, err := seaportOrderFulfilledData.Unpack(l.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		var ev seaportOrderFulfilled
+		if err := seaportOrderFulfilledData.Copy(&ev, values); err != nil {
+			return nil, err
+		}
+
+		offerPaid := big.NewInt(0)
+		for _, item := range ev.Offer {
+			if seaportNFTItem(item.ItemType) {
+				b.addToken(item.Token, item.Identifier, offerer, ev.Recipient)
+				continue
+			}
+
+			currency, ok := saleCurrency(item.Token)
+			if !ok || !b.setCurrency(currency) {
+				return nil, nil
+			}
+			offerPaid.Add(offerPaid, item.Amount)
+		}
+
+		for _, item := range ev.Consideration {
+			if seaportNFTItem(item.ItemType) {
+				b.addToken(item.Token, item.Identifier, ev.Recipient, item.Recipient)
+				continue
+			}
+
+			currency, ok := saleCurrency(item.Token)
+			if !ok || !b.setCurrency(currency) {
+				return nil, nil
+			}
+			offerPaid.Sub(offerPaid, item.Amount)
+			b.addShare(item.Recipient, item.Amount)
+		}
+
+		if ev.Recipient != (common.Address{}) {
+			b.addShare(ev.Recipient, offerPaid)
+		}
+	}
+
+	return b.build(exchangeName(logs[0].Address)), nil
+}
+
+// Blur
+
+type blurFee struct {
+	Rate      uint16
+	Recipient common.Address
+}
+
+type blurOrder struct {
+	Trader         common.Address
+	Side           uint8
+	MatchingPolicy common.Address
+	Collection     common.Address
+	TokenID        *big.Int `abi:"tokenId"`
+	Amount         *big.Int
+	PaymentToken   common.Address
+	Price          *big.Int
+	ListingTime    *big.Int
+	ExpirationTime *big.Int
+	Fees           []blurFee
+	Salt           *big.Int
+	ExtraParams    []byte
+}
+
+type blurOrdersMatched struct {
+	Sell     blurOrder
+	SellHash [32]byte
+	Buy      blurOrder
+	BuyHash  [32]byte
+}
+
+// BlurSaleDecoder decodes the OrdersMatched events of the Blur exchange
+type BlurSaleDecoder struct{}
+
+func (BlurSaleDecoder) Name() string {
+	return "blur"
+}
+
+// Decode reads the token and the price from the sell order. The fees of the sell order
+// are paid out of the price in basis points, and the rest goes to the seller.
+func (BlurSaleDecoder) Decode(_ *types.Transaction, receipt *types.Receipt) (*TokenSale, error) {
+	logs := exchangeLogs(logsBySignature(receipt, indexer.BlurOrdersMatchedEventSignature))
+	if len(logs) == 0 {
+		return nil, nil
+	}
+
+	b := newSaleBuilder()
+	for _, l := range logs {
+		values, err := blurOrdersMatchedData.Unpack(l.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		var ev blurOrdersMatched
+		if err := blurOrdersMatchedData.Copy(&ev, values); err != nil {
+			return nil, err
+		}
+
+		currency, ok := saleCurrency(ev.Sell.PaymentToken)
+		if !ok || !b.setCurrency(currency) {
+			return nil, nil
+		}
+
+		b.addToken(ev.Sell.Collection, ev.Sell.TokenID, ev.Sell.Trader, ev.Buy.Trader)
+
+		proceeds := new(big.Int).Set(ev.Sell.Price)
+		for _, fee := range ev.Sell.Fees {
+			amount := new(big.Int).Mul(ev.Sell.Price, big.NewInt(int64(fee.Rate)))
+			amount.Div(amount, big.NewInt(10000))
+			proceeds.Sub(proceeds, amount)
+			b.addShare(fee.Recipient, amount)
+		}
+		b.addShare(ev.Sell.Trader, proceeds)
+	}
+
+	return b.build("Blur"), nil
+}
+
+// X2Y2
+
+const (
+	x2y2IntentBuy          = 3
+	x2y2DelegateTypeERC721 = 1
+)
+
+type x2y2Fee struct {
+	Percentage *big.Int
+	To         common.Address
+}
+
+type x2y2EvInventory struct {
+	Maker        common.Address
+	Taker        common.Address
+	OrderSalt    *big.Int
+	SettleSalt   *big.Int
+	Intent       *big.Int
+	DelegateType *big.Int
+	Deadline     *big.Int
+	Currency     common.Address
+	DataMask     []byte
+	Item         struct {
+		Price *big.Int
+		Data  []byte
+	}
+	Detail struct {
+		Op                 uint8
+		OrderIdx           *big.Int
+		ItemIdx            *big.Int
+		Price              *big.Int
+		ItemHash           [32]byte
+		ExecutionDelegate  common.Address
+		DataReplacement    []byte
+		BidIncentivePct    *big.Int
+		AucMinIncrementPct *big.Int
+		AucIncDurationSecs *big.Int
+		Fees               []x2y2Fee
+	}
+}
+
+type x2y2ERC721Pair struct {
+	Token   common.Address
+	TokenID *big.Int `abi:"tokenId"`
+}
+
+type x2y2ERC1155Pair struct {
+	Token   common.Address
+	TokenID *big.Int `abi:"tokenId"`
+	Amount  *big.Int
+}
+
+// X2Y2SaleDecoder decodes the EvInventory events of the X2Y2 exchange
+type X2Y2SaleDecoder struct{}
+
+func (X2Y2SaleDecoder) Name() string {
+	return "x2y2"
+}
+
+// Decode reads the tokens from the item data of the delegate type. The fees are paid
+// out of the settled price in parts per million, and the rest goes to the seller.
+func (X2Y2SaleDecoder) Decode(_ *types.Transaction, receipt *types.Receipt) (*TokenSale, error) {
+	logs := exchangeLogs(logsBySignature(receipt, indexer.X2Y2EvInventoryEventSignature))
+	if len(logs) == 0 {
+		return nil, nil
+	}
+
+	b := newSaleBuilder()
+	for _, l := range logs {
+		values, err := x2y2EvInventoryData.Unpack(l.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		var ev x2y2EvInventory
+		if err := x2y2EvInventoryData.Copy(&ev, values); err != nil {
+			return nil, err
+		}
+
+		currency, ok := saleCurrency(ev.Currency)
+		if !ok || !b.setCurrency(currency) {
+			return nil, nil
+		}
+
+		seller, buyer := ev.Maker, ev.Taker
+		if ev.Intent.Int64() == x2y2IntentBuy {
+			seller, buyer = ev.Taker, ev.Maker
+		}
+
+		if ev.DelegateType.Int64() == x2y2DelegateTypeERC721 {
+			pairs, err := x2y2ERC721PairsData.Unpack(ev.Item.Data)
+			if err != nil {
+				return nil, err
+			}
+
+			var data struct{ Pairs []x2y2ERC721Pair }
+			if err := x2y2ERC721PairsData.Copy(&data, pairs); err != nil {
+				return nil, err
+			}
+			for _, p := range data.Pairs {
+				b.addToken(p.Token, p.TokenID, seller, buyer)
+			}
+		} else {
+			pairs, err := x2y2ERC1155PairsData.Unpack(ev.Item.Data)
+			if err != nil {
+				return nil, err
+			}
+
+			var data struct{ Pairs []x2y2ERC1155Pair }
+			if err := x2y2ERC1155PairsData.Copy(&data, pairs); err != nil {
+				return nil, err
+			}
+			for _, p := range data.Pairs {
+				b.addToken(p.Token, p.TokenID, seller, buyer)
+			}
+		}
+
+		proceeds := new(big.Int).Set(ev.Detail.Price)
+		for _, fee := range ev.Detail.Fees {
+			amount := new(big.Int).Mul(ev.Detail.Price, fee.Percentage)
+			amount.Div(amount, big.NewInt(1000000))
+			proceeds.Sub(proceeds, amount)
+			b.addShare(fee.To, amount)
+		}
+		b.addShare(seller, proceeds)
+	}
+
+	return b.build("X2Y2"), nil
+}
+
+// LooksRare
+
+type looksRareTakerEvent struct {
+	NonceInvalidationParameters struct {
+		OrderHash          [32]byte
+		OrderNonce         *big.Int
+		IsNonceInvalidated bool
+	}
+	AskUser       common.Address
+	BidUser       common.Address
+	BidRecipient  common.Address
+	StrategyID    *big.Int `abi:"strategyId"`
+	Currency      common.Address
+	Collection    common.Address
+	ItemIds       []*big.Int
+	Amounts       []*big.Int
+	FeeRecipients [2]common.Address
+	FeeAmounts    [3]*big.Int
+}
+
+// LooksRareSaleDecoder decodes the TakerBid and TakerAsk events of the LooksRare v2 exchange
+type LooksRareSaleDecoder struct{}
+
+func (LooksRareSaleDecoder) Name() string {
+	return "looksrare"
+}
+
+// Decode splits the price by the fee amounts of the event, which are the seller proceeds,
+// the creator fee and the protocol fee.
+func (LooksRareSaleDecoder) Decode(_ *types.Transaction, receipt *types.Receipt) (*TokenSale, error) {
+	var logs []types.Log
+	for _, l := range receipt.Logs {
+		if nil == l || len(l.Topics) == 0 {
+			continue
+		}
+
+		switch l.Topics[0].Hex() {
+		case indexer.LooksRareTakerBidEventSignature, indexer.LooksRareTakerAskEventSignature:
+			logs = append(logs, *l)
+		}
+	}
+	logs = exchangeLogs(logs)
+	if len(logs) == 0 {
+		return nil, nil
+	}
+
+	b := newSaleBuilder()
+	for _, l := range logs {
+		data := looksRareTakerBidData
+		if l.Topics[0].Hex() == indexer.LooksRareTakerAskEventSignature {
+			data = looksRareTakerAskData
+		}
+
+		values, err := data.Unpack(l.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		var ev looksRareTakerEvent
+		if err := data.Copy(&ev, values); err != nil {
+			return nil, err
+		}
+
+		currency, ok := saleCurrency(ev.Currency)
+		if !ok || !b.setCurrency(currency) {
+			return nil, nil
+		}
+
+		// A taker bid pays the proceeds to the ask recipient. A taker ask is sold by the ask user.
+		seller, buyer := ev.FeeRecipients[0], ev.BidRecipient
+		if l.Topics[0].Hex() == indexer.LooksRareTakerAskEventSignature {
+			seller, buyer = ev.AskUser, ev.BidUser
+		}
+		for _, itemID := range ev.ItemIds {
+			b.addToken(ev.Collection, itemID, seller, buyer)
+		}
+
+		b.addShare(ev.FeeRecipients[0], ev.FeeAmounts[0])
+		if ev.FeeRecipients[1] != (common.Address{}) {
+			b.addShare(ev.FeeRecipients[1], ev.FeeAmounts[1])
+		} else {
+			b.addFee(ev.FeeAmounts[1])
+		}
+		b.addFee(ev.FeeAmounts[2])
+	}
+
+	return b.build("LooksRare"), nil
+}
+
+// Feral File
+
+// FeralFileSaleDecoder decodes the BuyArtwork events of the Feral File exhibition contracts,
+// which sell their artworks by buyArtworks
+type FeralFileSaleDecoder struct{}
+
+func (FeralFileSaleDecoder) Name() string {
+	return "feralfile"
+}
+
+// Decode reads the price and the revenue shares from the sale data of the buyArtworks
+// call. The revenue is the price less the cost, split evenly by tokens and then by the
+// basis points of each share. The rest of the price is the cost of the platform.
+func (FeralFileSaleDecoder) Decode(tx *types.Transaction, receipt *types.Receipt) (*TokenSale, error) {
+	logs := logsBySignature(receipt, indexer.FeralFileBuyArtworkEventSignature)
+	if len(logs) == 0 || nil == tx.To() {
+		return nil, nil
+	}
+
+	contractABI, err := feralfilev4.FeralfileExhibitionV4MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	method, ok := contractABI.Methods["buyArtworks"]
+	if !ok || len(tx.Data()) < 4 || !bytes.Equal(tx.Data()[:4], method.ID) {
+		return nil, nil
+	}
+
+	values, err := method.Inputs.Unpack(tx.Data()[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	var args struct {
+		R        [32]byte
+		S        [32]byte
+		V        uint8
+		SaleData feralfilev4.IFeralfileSaleDataSaleData
+	}
+	if err := method.Inputs.Copy(&args, values); err != nil {
+		return nil, err
+	}
+	saleData := args.SaleData
+
+	// The tokens are sold by the contract unless they are transferred from another owner
+	transfers := make(map[string]types.Log)
+	for _, l := range receipt.Logs {
+		if nil != l && len(l.Topics) > 0 && indexer.ERC721Transfer(*l) {
+			transfers[l.Address.Hex()+"-"+l.Topics[3].Big().Text(10)] = *l
+		}
+	}
+
+	b := newSaleBuilder()
+	b.setCurrency("ETH")
+	for _, l := range logs {
+		if len(l.Topics) != 3 || l.Address != *tx.To() {
+			continue
+		}
+
+		tokenID := l.Topics[2].Big()
+		seller, buyer := l.Address, common.BytesToAddress(l.Topics[1].Bytes())
+		if t, ok := transfers[l.Address.Hex()+"-"+tokenID.Text(10)]; ok {
+			if from := common.BytesToAddress(t.Topics[1].Bytes()); from != (common.Address{}) {
+				seller = from
+			}
+			buyer = common.BytesToAddress(t.Topics[2].Bytes())
+		}
+		b.addToken(l.Address, tokenID, seller, buyer)
+	}
+
+	if len(saleData.TokenIds) == 0 {
+		return nil, nil
+	}
+
+	itemRevenue := big.NewInt(0)
+	if saleData.Price.Cmp(saleData.Cost) > 0 {
+		itemRevenue.Sub(saleData.Price, saleData.Cost)
+		itemRevenue.Div(itemRevenue, big.NewInt(int64(len(saleData.TokenIds))))
+	}
+
+	distributed := big.NewInt(0)
+	for _, shares := range saleData.RevenueShares {
+		for _, share := range shares {
+			amount := new(big.Int).Mul(itemRevenue, share.Bps)
+			amount.Div(amount, big.NewInt(10000))
+			distributed.Add(distributed, amount)
+			b.addShare(share.Recipient, amount)
+		}
+	}
+	b.addFee(new(big.Int).Sub(saleData.Price, distributed))
+
+	sale := b.build("Feral File")
+	if sale != nil {
+		// artists are paid by the revenue shares of a primary sale, not by royalties
+		sale.SaleType = "primary"
+		sale.Royalty = big.NewInt(0)
+	}
+	return sale, nil
+}
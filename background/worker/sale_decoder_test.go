@@ -0,0 +1,333 @@
+package worker
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	feralfilev4 "github.com/bitmark-inc/feralfile-exhibition-smart-contract/go-binding/feralfile-exhibition-v4"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	indexer "github.com/feral-file/ff-indexer"
+)
+
+var (
+	testSeller     = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	testBuyer      = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	testArtist     = common.HexToAddress("0x3000000000000000000000000000000000000003")
+	testFeeWallet  = common.HexToAddress("0x4000000000000000000000000000000000000004")
+	testCollection = common.HexToAddress("0x5000000000000000000000000000000000000005")
+	testWETH       = common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	testExchange   = common.HexToAddress("0x6000000000000000000000000000000000000006")
+)
+
+func setTestSaleConfig() {
+	viper.Set("marketplace.contracts", map[string]string{strings.ToLower(testExchange.Hex()): "OpenSea"})
+	viper.Set("marketplace.fee_wallets", map[string]string{strings.ToLower(testFeeWallet.Hex()): "OpenSea"})
+	viper.Set("ethereum.erc20", map[string]string{strings.ToLower(testWETH.Hex()): "WETH"})
+}
+
+func testSaleReceipt(t *testing.T, signature string, topics []common.Hash, data abi.Arguments, values ...interface{}) *types.Receipt {
+	packed, err := data.Pack(values...)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	return &types.Receipt{Logs: []*types.Log{{
+		Address: testExchange,
+		Topics:  append([]common.Hash{common.HexToHash(signature)}, topics...),
+		Data:    packed,
+	}}}
+}
+
+func TestSeaportSaleDecoderListing(t *testing.T) {
+	setTestSaleConfig()
+
+	receipt := testSaleReceipt(t, indexer.SeaportOrderFulfilledEventSignature,
+		[]common.Hash{common.BytesToHash(testSeller.Bytes()), {}},
+		seaportOrderFulfilledData,
+		[32]byte{}, testBuyer,
+		[]seaportSpentItem{{ItemType: 2, Token: testCollection, Identifier: big.NewInt(1), Amount: big.NewInt(1)}},
+		[]seaportReceivedItem{
+			{ItemType: 0, Identifier: big.NewInt(0), Amount: big.NewInt(900), Recipient: testSeller},
+			{ItemType: 0, Identifier: big.NewInt(0), Amount: big.NewInt(25), Recipient: testFeeWallet},
+			{ItemType: 0, Identifier: big.NewInt(0), Amount: big.NewInt(75), Recipient: testArtist},
+		})
+
+	sale, err := EthereumSaleDecoders.Decode(types.NewTx(&types.LegacyTx{}), receipt)
+	assert.NoError(t, err)
+	assert.Equal(t, "OpenSea", sale.Marketplace)
+	assert.Equal(t, "ETH", sale.Currency)
+	assert.Equal(t, big.NewInt(1000), sale.Price)
+	assert.Equal(t, big.NewInt(25), sale.PlatformFee)
+	assert.Equal(t, big.NewInt(75), sale.Royalty)
+	assert.Equal(t, big.NewInt(975), sale.NetRevenue)
+	assert.Equal(t, []TokenSaleInfo{{
+		ContractAddress: testCollection.Hex(),
+		TokenID:         "1",
+		SellerAddress:   testSeller.Hex(),
+		BuyerAddress:    testBuyer.Hex(),
+	}}, sale.BundleTokenInfo)
+}
+
+func TestSeaportSaleDecoderMarketplace(t *testing.T) {
+	setTestSaleConfig()
+	viper.Set("marketplace.contracts", map[string]string{strings.ToLower(testExchange.Hex()): "Magic Eden"})
+	defer setTestSaleConfig()
+
+	// another marketplace which settles its orders by Seaport
+	receipt := testSaleReceipt(t, indexer.SeaportOrderFulfilledEventSignature,
+		[]common.Hash{common.BytesToHash(testSeller.Bytes()), {}},
+		seaportOrderFulfilledData,
+		[32]byte{}, testBuyer,
+		[]seaportSpentItem{{ItemType: 2, Token: testCollection, Identifier: big.NewInt(1), Amount: big.NewInt(1)}},
+		[]seaportReceivedItem{{ItemType: 0, Identifier: big.NewInt(0), Amount: big.NewInt(1000), Recipient: testSeller}})
+
+	sale, err := EthereumSaleDecoders.Decode(types.NewTx(&types.LegacyTx{}), receipt)
+	assert.NoError(t, err)
+	assert.Equal(t, "Magic Eden", sale.Marketplace)
+}
+
+func TestSeaportSaleDecoderUnknownExchange(t *testing.T) {
+	setTestSaleConfig()
+
+	// an OrderFulfilled event which is not emitted by a configured exchange
+	receipt := testSaleReceipt(t, indexer.SeaportOrderFulfilledEventSignature,
+		[]common.Hash{common.BytesToHash(testSeller.Bytes()), {}},
+		seaportOrderFulfilledData,
+		[32]byte{}, testBuyer,
+		[]seaportSpentItem{{ItemType: 2, Token: testCollection, Identifier: big.NewInt(1), Amount: big.NewInt(1)}},
+		[]seaportReceivedItem{{ItemType: 0, Identifier: big.NewInt(0), Amount: big.NewInt(1000), Recipient: testSeller}})
+	receipt.Logs[0].Address = testCollection
+
+	sale, err := EthereumSaleDecoders.Decode(types.NewTx(&types.LegacyTx{}), receipt)
+	assert.NoError(t, err)
+	assert.Nil(t, sale)
+}
+
+func TestSeaportSaleDecoderAcceptedBid(t *testing.T) {
+	setTestSaleConfig()
+
+	// the bidder offers WETH and the fulfiller receives what is left after the fees
+	receipt := testSaleReceipt(t, indexer.SeaportOrderFulfilledEventSignature,
+		[]common.Hash{common.BytesToHash(testBuyer.Bytes()), {}},
+		seaportOrderFulfilledData,
+		[32]byte{}, testSeller,
+		[]seaportSpentItem{{ItemType: 1, Token: testWETH, Identifier: big.NewInt(0), Amount: big.NewInt(1000)}},
+		[]seaportReceivedItem{
+			{ItemType: 2, Token: testCollection, Identifier: big.NewInt(7), Amount: big.NewInt(1), Recipient: testBuyer},
+			{ItemType: 1, Token: testWETH, Identifier: big.NewInt(0), Amount: big.NewInt(25), Recipient: testFeeWallet},
+			{ItemType: 1, Token: testWETH, Identifier: big.NewInt(0), Amount: big.NewInt(75), Recipient: testArtist},
+		})
+
+	sale, err := SeaportSaleDecoder{}.Decode(nil, receipt)
+	assert.NoError(t, err)
+	assert.Equal(t, "WETH", sale.Currency)
+	assert.Equal(t, big.NewInt(1000), sale.Price)
+	assert.Equal(t, map[string]*big.Int{
+		testSeller.Hex():    big.NewInt(900),
+		testFeeWallet.Hex(): big.NewInt(25),
+		testArtist.Hex():    big.NewInt(75),
+	}, sale.Shares)
+	assert.Equal(t, testSeller.Hex(), sale.BundleTokenInfo[0].SellerAddress)
+	assert.Equal(t, testBuyer.Hex(), sale.BundleTokenInfo[0].BuyerAddress)
+}
+
+func TestBlurSaleDecoder(t *testing.T) {
+	setTestSaleConfig()
+
+	sell := blurOrder{
+		Trader:         testSeller,
+		Collection:     testCollection,
+		TokenID:        big.NewInt(2),
+		Amount:         big.NewInt(1),
+		Price:          big.NewInt(1000),
+		ListingTime:    big.NewInt(0),
+		ExpirationTime: big.NewInt(0),
+		Fees:           []blurFee{{Rate: 500, Recipient: testArtist}},
+		Salt:           big.NewInt(0),
+		ExtraParams:    []byte{},
+	}
+	buy := sell
+	buy.Trader = testBuyer
+	buy.Side = 1
+	buy.Fees = []blurFee{}
+
+	receipt := testSaleReceipt(t, indexer.BlurOrdersMatchedEventSignature,
+		[]common.Hash{common.BytesToHash(testSeller.Bytes()), common.BytesToHash(testBuyer.Bytes())},
+		blurOrdersMatchedData, sell, [32]byte{}, buy, [32]byte{})
+
+	sale, err := EthereumSaleDecoders.Decode(types.NewTx(&types.LegacyTx{}), receipt)
+	assert.NoError(t, err)
+	assert.Equal(t, "Blur", sale.Marketplace)
+	assert.Equal(t, big.NewInt(1000), sale.Price)
+	assert.Equal(t, big.NewInt(0), sale.PlatformFee)
+	assert.Equal(t, big.NewInt(50), sale.Royalty)
+	assert.Equal(t, big.NewInt(950), sale.Shares[testSeller.Hex()])
+}
+
+func TestX2Y2SaleDecoder(t *testing.T) {
+	setTestSaleConfig()
+
+	itemData, err := x2y2ERC721PairsData.Pack([]x2y2ERC721Pair{{Token: testCollection, TokenID: big.NewInt(3)}})
+	assert.NoError(t, err)
+
+	ev := x2y2EvInventory{
+		Maker:        testBuyer,
+		Taker:        testSeller,
+		OrderSalt:    big.NewInt(0),
+		SettleSalt:   big.NewInt(0),
+		Intent:       big.NewInt(x2y2IntentBuy),
+		DelegateType: big.NewInt(x2y2DelegateTypeERC721),
+		Deadline:     big.NewInt(0),
+		Currency:     testWETH,
+		DataMask:     []byte{},
+	}
+	ev.Item.Price = big.NewInt(1000)
+	ev.Item.Data = itemData
+	ev.Detail.OrderIdx, ev.Detail.ItemIdx = big.NewInt(0), big.NewInt(0)
+	ev.Detail.Price = big.NewInt(1000)
+	ev.Detail.DataReplacement = []byte{}
+	ev.Detail.BidIncentivePct, ev.Detail.AucMinIncrementPct, ev.Detail.AucIncDurationSecs = big.NewInt(0), big.NewInt(0), big.NewInt(0)
+	ev.Detail.Fees = []x2y2Fee{{Percentage: big.NewInt(5000), To: testFeeWallet}}
+
+	receipt := testSaleReceipt(t, indexer.X2Y2EvInventoryEventSignature, []common.Hash{{}}, x2y2EvInventoryData,
+		ev.Maker, ev.Taker, ev.OrderSalt, ev.SettleSalt, ev.Intent, ev.DelegateType, ev.Deadline,
+		ev.Currency, ev.DataMask, ev.Item, ev.Detail)
+
+	sale, err := EthereumSaleDecoders.Decode(types.NewTx(&types.LegacyTx{}), receipt)
+	assert.NoError(t, err)
+	assert.Equal(t, "X2Y2", sale.Marketplace)
+	assert.Equal(t, big.NewInt(5), sale.PlatformFee)
+	assert.Equal(t, big.NewInt(995), sale.Shares[testSeller.Hex()])
+	assert.Equal(t, testBuyer.Hex(), sale.BundleTokenInfo[0].BuyerAddress)
+	assert.Equal(t, "3", sale.BundleTokenInfo[0].TokenID)
+}
+
+func TestLooksRareSaleDecoderTakerBid(t *testing.T) {
+	setTestSaleConfig()
+
+	// the proceeds go to the ask recipient, and the protocol fee has no recipient in the event
+	receipt := testSaleReceipt(t, indexer.LooksRareTakerBidEventSignature, nil, looksRareTakerBidData,
+		struct {
+			OrderHash          [32]byte
+			OrderNonce         *big.Int
+			IsNonceInvalidated bool
+		}{OrderNonce: big.NewInt(0)},
+		testBuyer, testBuyer, big.NewInt(0), common.Address{}, testCollection,
+		[]*big.Int{big.NewInt(4)}, []*big.Int{big.NewInt(1)},
+		[2]common.Address{testSeller, testArtist},
+		[3]*big.Int{big.NewInt(945), big.NewInt(50), big.NewInt(5)})
+
+	sale, err := EthereumSaleDecoders.Decode(types.NewTx(&types.LegacyTx{}), receipt)
+	assert.NoError(t, err)
+	assert.Equal(t, "LooksRare", sale.Marketplace)
+	assert.Equal(t, "ETH", sale.Currency)
+	assert.Equal(t, big.NewInt(1000), sale.Price)
+	assert.Equal(t, big.NewInt(5), sale.PlatformFee)
+	assert.Equal(t, big.NewInt(50), sale.Royalty)
+	assert.Equal(t, big.NewInt(945), sale.Shares[testSeller.Hex()])
+	assert.Equal(t, []TokenSaleInfo{{
+		ContractAddress: testCollection.Hex(),
+		TokenID:         "4",
+		SellerAddress:   testSeller.Hex(),
+		BuyerAddress:    testBuyer.Hex(),
+	}}, sale.BundleTokenInfo)
+}
+
+func TestFeralFileSaleDecoder(t *testing.T) {
+	setTestSaleConfig()
+
+	contractABI, err := feralfilev4.FeralfileExhibitionV4MetaData.GetAbi()
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+
+	method := contractABI.Methods["buyArtworks"]
+	args, err := method.Inputs.Pack([32]byte{}, [32]byte{}, uint8(27), feralfilev4.IFeralfileSaleDataSaleData{
+		Price:       big.NewInt(1000),
+		Cost:        big.NewInt(100),
+		ExpiryTime:  big.NewInt(0),
+		Destination: testBuyer,
+		TokenIds:    []*big.Int{big.NewInt(1), big.NewInt(2)},
+		RevenueShares: [][]feralfilev4.IFeralfileSaleDataRevenueShare{
+			{{Recipient: testArtist, Bps: big.NewInt(8000)}},
+			{{Recipient: testArtist, Bps: big.NewInt(8000)}},
+		},
+	})
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	tx := types.NewTx(&types.LegacyTx{To: &testCollection, Data: append(method.ID, args...)})
+
+	buyArtwork := func(tokenID int64) *types.Log {
+		return &types.Log{
+			Address: testCollection,
+			Topics: []common.Hash{
+				common.HexToHash(indexer.FeralFileBuyArtworkEventSignature),
+				common.BytesToHash(testBuyer.Bytes()),
+				common.BigToHash(big.NewInt(tokenID)),
+			},
+		}
+	}
+	transfer := func(from common.Address, tokenID int64) *types.Log {
+		return &types.Log{
+			Address: testCollection,
+			Topics: []common.Hash{
+				common.HexToHash(indexer.TransferEventSignature),
+				common.BytesToHash(from.Bytes()),
+				common.BytesToHash(testBuyer.Bytes()),
+				common.BigToHash(big.NewInt(tokenID)),
+			},
+		}
+	}
+
+	// the first token is minted to the buyer and the second is transferred from its owner
+	receipt := &types.Receipt{Logs: []*types.Log{
+		transfer(common.Address{}, 1), buyArtwork(1),
+		transfer(testSeller, 2), buyArtwork(2),
+	}}
+
+	sale, err := EthereumSaleDecoders.Decode(tx, receipt)
+	assert.NoError(t, err)
+	assert.Equal(t, "Feral File", sale.Marketplace)
+	assert.Equal(t, "primary", sale.SaleType)
+	assert.Equal(t, "ETH", sale.Currency)
+	assert.Equal(t, big.NewInt(1000), sale.Price)
+	assert.Equal(t, big.NewInt(0), sale.Royalty)
+	assert.Equal(t, big.NewInt(280), sale.PlatformFee)
+	assert.Equal(t, map[string]*big.Int{testArtist.Hex(): big.NewInt(720)}, sale.Shares)
+	assert.Equal(t, []TokenSaleInfo{
+		{
+			ContractAddress: testCollection.Hex(),
+			TokenID:         "1",
+			SellerAddress:   testCollection.Hex(),
+			BuyerAddress:    testBuyer.Hex(),
+		},
+		{
+			ContractAddress: testCollection.Hex(),
+			TokenID:         "2",
+			SellerAddress:   testSeller.Hex(),
+			BuyerAddress:    testBuyer.Hex(),
+		},
+	}, sale.BundleTokenInfo)
+}
+
+func TestSaleDecoderRegistryWithoutSale(t *testing.T) {
+	transfer := &types.Log{
+		Topics: []common.Hash{
+			common.HexToHash(indexer.TransferEventSignature),
+			common.BytesToHash(testSeller.Bytes()),
+			common.BytesToHash(testBuyer.Bytes()),
+			common.BigToHash(big.NewInt(1)),
+		},
+	}
+
+	sale, err := EthereumSaleDecoders.Decode(types.NewTx(&types.LegacyTx{}), &types.Receipt{Logs: []*types.Log{transfer}})
+	assert.NoError(t, err)
+	assert.Nil(t, sale)
+}
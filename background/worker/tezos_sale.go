@@ -0,0 +1,274 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	utils "github.com/bitmark-inc/autonomy-utils"
+	tzkt "github.com/bitmark-inc/tzkt-go"
+	"github.com/spf13/viper"
+
+	indexer "github.com/feral-file/ff-indexer"
+)
+
+const (
+	TezosMarketplaceObjkt  = "objkt"
+	TezosMarketplaceHEN    = "hen"
+	TezosMarketplaceTeia   = "teia"
+	TezosMarketplaceFxhash = "fxhash"
+	TezosMarketplaceVersum = "versum"
+)
+
+var ErrUnsupportedTezosMarketplace = errors.New("unsupported tezos marketplace")
+
+// TezosMarketplace describes the sale operations of a Tezos marketplace
+type TezosMarketplace struct {
+	ID               string
+	Name             string
+	Contracts        []string
+	TestnetContracts []string
+	Entrypoints      []string
+	// ProxyAccounts forward the payments of a sale and are not shares of it
+	ProxyAccounts []string
+}
+
+// TezosMarketplaces are the Tezos marketplaces which the sales are indexed from
+var TezosMarketplaces = map[string]TezosMarketplace{
+	TezosMarketplaceObjkt: {
+		ID:   TezosMarketplaceObjkt,
+		Name: "Objkt",
+		Contracts: []string{
+			indexer.TezosOBJKTMarketplaceAddress,
+			indexer.TezosOBJKTMarketplaceAddressV2,
+		},
+		TestnetContracts: []string{indexer.TezosOBJKTMarketplaceAddressTestnet},
+		Entrypoints:      indexer.OBJKTSaleEntrypoints,
+		ProxyAccounts:    []string{indexer.TezosOBJKTTreasuryProxyAddress},
+	},
+	TezosMarketplaceHEN: {
+		ID:          TezosMarketplaceHEN,
+		Name:        "Hic et Nunc",
+		Contracts:   []string{indexer.TezosHicEtNuncMarketplaceAddress},
+		Entrypoints: indexer.HicEtNuncSaleEntrypoints,
+	},
+	TezosMarketplaceTeia: {
+		ID:          TezosMarketplaceTeia,
+		Name:        "Teia",
+		Contracts:   []string{indexer.TezosTeiaMarketplaceAddress},
+		Entrypoints: indexer.HicEtNuncSaleEntrypoints,
+	},
+	TezosMarketplaceFxhash: {
+		ID:   TezosMarketplaceFxhash,
+		Name: "fxhash",
+		Contracts: []string{
+			indexer.TezosFxhashMarketplaceAddressV1,
+			indexer.TezosFxhashMarketplaceAddressV2,
+			indexer.TezosFxhashMarketplaceAddressV3,
+		},
+		Entrypoints: indexer.FxhashSaleEntrypoints,
+	},
+	TezosMarketplaceVersum: {
+		ID:          TezosMarketplaceVersum,
+		Name:        "Versum",
+		Contracts:   []string{indexer.TezosVersumMarketplaceAddress},
+		Entrypoints: indexer.VersumSaleEntrypoints,
+	},
+}
+
+// NetworkContracts returns the marketplace contracts of the configured network
+func (m TezosMarketplace) NetworkContracts() []string {
+	if viper.GetString("network") == "testnet" {
+		return m.TestnetContracts
+	}
+	return m.Contracts
+}
+
+// saleOperation returns whether a tx calls a sale entrypoint of the marketplace
+func (m TezosMarketplace) saleOperation(tx tzkt.DetailedTransaction) bool {
+	return tx.Parameter != nil &&
+		slices.Contains(m.Entrypoints, tx.Parameter.EntryPoint) &&
+		(slices.Contains(m.Contracts, tx.Target.Address) || slices.Contains(m.TestnetContracts, tx.Target.Address))
+}
+
+// detectTezosMarketplace returns the marketplace of the sale operation in a tx group
+func detectTezosMarketplace(txs []tzkt.DetailedTransaction) (TezosMarketplace, bool) {
+	for _, tx := range txs {
+		for _, m := range TezosMarketplaces {
+			if m.saleOperation(tx) {
+				return m, true
+			}
+		}
+	}
+	return TezosMarketplace{}, false
+}
+
+// parseTezosTokenSale parses the sale of a tx group of a marketplace. The tokens are read from
+// the FA2 transfers and the shares from the XTZ transfers. The shares to the fee wallets of
+// the marketplace are platform fees and the shares to anyone but the sellers are royalties.
+// A token which is held in escrow is transferred from the marketplace contract, so its seller
+// is read from the payments of its sale operation by escrowSeller.
+func parseTezosTokenSale(m TezosMarketplace, hash string, txs []tzkt.DetailedTransaction) (*TokenSale, error) {
+	if len(txs) < 2 {
+		return nil, fmt.Errorf("invalid %s tx", m.ID)
+	}
+
+	isSaleOperation := false
+	bundleTokenInfo := []TokenSaleInfo{}
+	sellers := make(map[string]bool)
+	price := big.NewInt(0)
+	platformFeeWallets := viper.GetStringMapString("marketplace.fee_wallets") // key is lower case
+	platformFee := big.NewInt(0)
+	shares := make(map[string]*big.Int)
+
+	// the escrow tokens and the payments of the current sale operation
+	var escrowTokens []int
+	payments := make(map[string]*big.Int)
+	resolveEscrowSeller := func() {
+		if seller := escrowSeller(m, payments, platformFeeWallets); seller != "" {
+			sellers[seller] = true
+			for _, i := range escrowTokens {
+				bundleTokenInfo[i].SellerAddress = seller
+			}
+		}
+		escrowTokens = nil
+		payments = make(map[string]*big.Int)
+	}
+
+	for _, tx := range txs {
+		if tx.Status != "applied" {
+			return nil, nil
+		}
+
+		if tx.Parameter != nil {
+			// check for sale entrypoints
+			if m.saleOperation(tx) {
+				resolveEscrowSeller()
+				isSaleOperation = true
+				continue
+			}
+
+			// process token transfers
+			if tx.Parameter.EntryPoint == "transfer" {
+				paramValues, err := decodeParametersValue(tx.Parameter.Value)
+				if err != nil {
+					// We don't support sale operations contain coin transfer operations
+					// Any sale operations contain invalid "transfer" will be ignored
+					return nil, errors.New("invalid transfer transaction - not supported buying using token")
+				}
+
+				for _, paramValue := range paramValues {
+					escrow := m.escrowContract(paramValue.From)
+					if !escrow {
+						sellers[paramValue.From] = true
+					}
+					for _, ptx := range paramValue.Txs {
+						if escrow {
+							escrowTokens = append(escrowTokens, len(bundleTokenInfo))
+						}
+						bundleTokenInfo = append(bundleTokenInfo, TokenSaleInfo{
+							SellerAddress:   paramValue.From,
+							BuyerAddress:    ptx.To,
+							TokenID:         ptx.TokenID,
+							ContractAddress: tx.Target.Address,
+						})
+					}
+				}
+			}
+		} else {
+			// process revenue shares transfers
+			amount := new(big.Int).SetUint64(tx.Amount)
+
+			// ignore the transfers to the proxies which forward the payments
+			if slices.Contains(m.ProxyAccounts, tx.Target.Address) {
+				continue
+			}
+
+			// Accumulate shares
+			if s, ok := shares[tx.Target.Address]; ok {
+				shares[tx.Target.Address] = big.NewInt(0).Add(s, amount)
+			} else {
+				shares[tx.Target.Address] = amount
+			}
+			price = big.NewInt(0).Add(price, amount)
+
+			// Deduct share
+			if s, ok := shares[tx.Sender.Address]; ok {
+				shares[tx.Sender.Address] = big.NewInt(0).Sub(s, amount)
+				price.Sub(price, amount)
+			}
+
+			if p, ok := payments[tx.Target.Address]; ok {
+				payments[tx.Target.Address] = big.NewInt(0).Add(p, amount)
+			} else {
+				payments[tx.Target.Address] = amount
+			}
+			if p, ok := payments[tx.Sender.Address]; ok {
+				payments[tx.Sender.Address] = big.NewInt(0).Sub(p, amount)
+			}
+
+			// Accumulate platform fee
+			if platformFeeWallets[strings.ToLower(tx.Target.Address)] == m.Name {
+				platformFee = big.NewInt(0).Add(platformFee, amount)
+			}
+		}
+	}
+	resolveEscrowSeller()
+
+	if !isSaleOperation {
+		return nil, fmt.Errorf("invalid %s tx", m.ID)
+	}
+
+	if len(bundleTokenInfo) == 0 {
+		return nil, errors.New("invalid sale transaction - no tokens transfer")
+	}
+
+	royalty := big.NewInt(0)
+	for address, share := range shares {
+		if !sellers[address] && platformFeeWallets[strings.ToLower(address)] != m.Name {
+			royalty.Add(royalty, share)
+		}
+	}
+
+	return &TokenSale{
+		Timestamp:       txs[0].Timestamp,
+		Price:           price,
+		Marketplace:     m.Name,
+		Blockchain:      utils.TezosBlockchain,
+		Currency:        "XTZ",
+		TxID:            hash,
+		PlatformFee:     platformFee,
+		Royalty:         royalty,
+		NetRevenue:      big.NewInt(0).Sub(price, platformFee),
+		BundleTokenInfo: bundleTokenInfo,
+		PaymentAmount:   price,
+		Shares:          shares,
+	}, nil
+}
+
+// escrowContract returns whether an address is a contract of the marketplace, which holds
+// the listed tokens in escrow
+func (m TezosMarketplace) escrowContract(address string) bool {
+	return slices.Contains(m.Contracts, address) || slices.Contains(m.TestnetContracts, address)
+}
+
+// escrowSeller returns the seller of the escrow tokens of a sale operation, which is the
+// payee of the largest payment except the fee wallets of the marketplace. The escrow
+// marketplaces cap the royalties at 25% of the price, so the proceeds of the seller are
+// always larger than a royalty payment.
+func escrowSeller(m TezosMarketplace, payments map[string]*big.Int, feeWallets map[string]string) string {
+	seller := ""
+	for address, amount := range payments {
+		if feeWallets[strings.ToLower(address)] == m.Name || amount.Sign() <= 0 {
+			continue
+		}
+
+		if seller == "" || amount.Cmp(payments[seller]) > 0 ||
+			(amount.Cmp(payments[seller]) == 0 && address < seller) {
+			seller = address
+		}
+	}
+	return seller
+}
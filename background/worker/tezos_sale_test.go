@@ -0,0 +1,146 @@
+package worker
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	tzkt "github.com/bitmark-inc/tzkt-go"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+
+	indexer "github.com/feral-file/ff-indexer"
+)
+
+const (
+	testTezosSeller    = "tz1SellerSellerSellerSellerSellerSel"
+	testTezosBuyer     = "tz1BuyerBuyerBuyerBuyerBuyerBuyerBuy"
+	testTezosArtist    = "tz1ArtistArtistArtistArtistArtistArt"
+	testTezosFeeWallet = "tz1FeeWalletFeeWalletFeeWalletFeeWal"
+	testTezosToken     = "KT1TokenTokenTokenTokenTokenTokenTok"
+)
+
+func testTezosTransfer(sender, target string, amount uint64) tzkt.DetailedTransaction {
+	return tzkt.DetailedTransaction{
+		Status: "applied",
+		Sender: tzkt.Account{Address: sender},
+		Target: tzkt.Account{Address: target},
+		Amount: amount,
+	}
+}
+
+func testTezosCall(sender, target, entrypoint string, value interface{}) tzkt.DetailedTransaction {
+	tx := testTezosTransfer(sender, target, 0)
+	tx.Parameter = &tzkt.TransactionParameter{EntryPoint: entrypoint, Value: value}
+	return tx
+}
+
+func TestParseTezosTokenSaleOfTeia(t *testing.T) {
+	viper.Set("marketplace.fee_wallets", map[string]string{strings.ToLower(testTezosFeeWallet): "Teia"})
+
+	txs := []tzkt.DetailedTransaction{
+		testTezosCall(testTezosBuyer, indexer.TezosTeiaMarketplaceAddress, "collect", map[string]interface{}{}),
+		testTezosTransfer(indexer.TezosTeiaMarketplaceAddress, testTezosArtist, 100000),
+		testTezosTransfer(indexer.TezosTeiaMarketplaceAddress, testTezosFeeWallet, 25000),
+		testTezosTransfer(indexer.TezosTeiaMarketplaceAddress, testTezosSeller, 875000),
+		// the swapped token is held in escrow by the marketplace
+		testTezosCall(indexer.TezosTeiaMarketplaceAddress, testTezosToken, "transfer", []interface{}{
+			map[string]interface{}{
+				"from_": indexer.TezosTeiaMarketplaceAddress,
+				"txs": []interface{}{
+					map[string]interface{}{"to_": testTezosBuyer, "amount": "1", "token_id": "5"},
+				},
+			},
+		}),
+	}
+
+	marketplace, ok := detectTezosMarketplace(txs)
+	assert.True(t, ok)
+	assert.Equal(t, TezosMarketplaceTeia, marketplace.ID)
+
+	sale, err := parseTezosTokenSale(marketplace, "oo1", txs)
+	assert.NoError(t, err)
+	assert.Equal(t, "Teia", sale.Marketplace)
+	assert.Equal(t, big.NewInt(1000000), sale.Price)
+	assert.Equal(t, big.NewInt(25000), sale.PlatformFee)
+	assert.Equal(t, big.NewInt(100000), sale.Royalty)
+	assert.Equal(t, big.NewInt(975000), sale.NetRevenue)
+	assert.Equal(t, map[string]*big.Int{
+		testTezosArtist:    big.NewInt(100000),
+		testTezosFeeWallet: big.NewInt(25000),
+		testTezosSeller:    big.NewInt(875000),
+	}, sale.Shares)
+	assert.Equal(t, []TokenSaleInfo{{
+		ContractAddress: testTezosToken,
+		TokenID:         "5",
+		SellerAddress:   testTezosSeller,
+		BuyerAddress:    testTezosBuyer,
+	}}, sale.BundleTokenInfo)
+}
+
+func TestParseTezosTokenSaleOfBatchedEscrowSales(t *testing.T) {
+	viper.Set("marketplace.fee_wallets", map[string]string{strings.ToLower(testTezosFeeWallet): "fxhash"})
+
+	escrowTransfer := func(tokenID string) tzkt.DetailedTransaction {
+		return testTezosCall(indexer.TezosFxhashMarketplaceAddressV3, testTezosToken, "transfer", []interface{}{
+			map[string]interface{}{
+				"from_": indexer.TezosFxhashMarketplaceAddressV3,
+				"txs": []interface{}{
+					map[string]interface{}{"to_": testTezosBuyer, "amount": "1", "token_id": tokenID},
+				},
+			},
+		})
+	}
+
+	// two listings of different sellers are accepted in a batch, and both pay a royalty to the artist
+	otherSeller := "tz1OtherSellerOtherSellerOtherSeller"
+	txs := []tzkt.DetailedTransaction{
+		testTezosCall(testTezosBuyer, indexer.TezosFxhashMarketplaceAddressV3, "listing_accept", map[string]interface{}{}),
+		testTezosTransfer(indexer.TezosFxhashMarketplaceAddressV3, testTezosFeeWallet, 25000),
+		testTezosTransfer(indexer.TezosFxhashMarketplaceAddressV3, testTezosArtist, 100000),
+		testTezosTransfer(indexer.TezosFxhashMarketplaceAddressV3, otherSeller, 875000),
+		escrowTransfer("1"),
+		testTezosCall(testTezosBuyer, indexer.TezosFxhashMarketplaceAddressV3, "listing_accept", map[string]interface{}{}),
+		testTezosTransfer(indexer.TezosFxhashMarketplaceAddressV3, testTezosArtist, 200000),
+		testTezosTransfer(indexer.TezosFxhashMarketplaceAddressV3, testTezosFeeWallet, 50000),
+		testTezosTransfer(indexer.TezosFxhashMarketplaceAddressV3, testTezosSeller, 1750000),
+		escrowTransfer("2"),
+	}
+
+	marketplace, ok := detectTezosMarketplace(txs)
+	assert.True(t, ok)
+	assert.Equal(t, TezosMarketplaceFxhash, marketplace.ID)
+
+	sale, err := parseTezosTokenSale(marketplace, "oo1", txs)
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(3000000), sale.Price)
+	assert.Equal(t, big.NewInt(75000), sale.PlatformFee)
+	assert.Equal(t, big.NewInt(300000), sale.Royalty)
+	assert.Equal(t, []TokenSaleInfo{
+		{
+			ContractAddress: testTezosToken,
+			TokenID:         "1",
+			SellerAddress:   otherSeller,
+			BuyerAddress:    testTezosBuyer,
+		},
+		{
+			ContractAddress: testTezosToken,
+			TokenID:         "2",
+			SellerAddress:   testTezosSeller,
+			BuyerAddress:    testTezosBuyer,
+		},
+	}, sale.BundleTokenInfo)
+}
+
+func TestParseTezosTokenSaleWithoutSaleOperation(t *testing.T) {
+	txs := []tzkt.DetailedTransaction{
+		testTezosCall(testTezosBuyer, indexer.TezosTeiaMarketplaceAddress, "swap", map[string]interface{}{}),
+		testTezosTransfer(testTezosBuyer, testTezosSeller, 1000),
+	}
+
+	_, ok := detectTezosMarketplace(txs)
+	assert.False(t, ok)
+
+	_, err := parseTezosTokenSale(TezosMarketplaces[TezosMarketplaceTeia], "oo1", txs)
+	assert.Error(t, err)
+}
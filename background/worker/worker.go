@@ -6,6 +6,7 @@ import (
 
 	bitmarkd "github.com/bitmark-inc/bitmarkdClient"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/hashicorp/golang-lru/v2/expirable"
 	"github.com/spf13/viper"
 
 	indexer "github.com/feral-file/ff-indexer"
@@ -29,6 +30,16 @@ type Worker struct {
 	bitmarkZeroAddress string
 	bitmarkAPIEndpoint string
 
+	// imageIndexerEndpoint is the server of the image indexer which queues the thumbnail jobs
+	imageIndexerEndpoint string
+	imageIndexerAPIToken string
+
+	exchangeRateProviders ExchangeRateProviders
+
+	// collectionTokens keeps the tokens of the collections which are being indexed, so the token
+	// registry of a collection is read once for all the batches of an indexing run
+	collectionTokens *expirable.LRU[string, []CollectionToken]
+
 	Environment            string
 	TaskListName           string
 	ProvenanceTaskListName string
@@ -46,6 +57,13 @@ func New(environment string,
 		panic(err)
 	}
 
+	exchangeRateProviders, err := NewExchangeRateProviders(
+		viper.GetStringSlice("exchange_rate.providers"),
+		viper.GetString("exchange_rate.coingecko_api_key"))
+	if err != nil {
+		panic(err)
+	}
+
 	bitmarkZeroAddress := indexer.LivenetZeroAddress
 	bitmarkAPIEndpoint := "https://api.bitmark.com"
 
@@ -71,6 +89,13 @@ func New(environment string,
 		bitmarkZeroAddress: bitmarkZeroAddress,
 		bitmarkAPIEndpoint: bitmarkAPIEndpoint,
 
+		imageIndexerEndpoint: viper.GetString("image_indexer.endpoint"),
+		imageIndexerAPIToken: viper.GetString("image_indexer.api_token"),
+
+		exchangeRateProviders: exchangeRateProviders,
+
+		collectionTokens: expirable.NewLRU[string, []CollectionToken](collectionTokensCacheSize, nil, collectionTokensCacheTTL),
+
 		Environment:            environment,
 		TaskListName:           TaskListName,
 		ProvenanceTaskListName: ProvenanceTaskListName,
@@ -21,3 +21,7 @@ func WorkflowIDRefreshTokenProvenanceByOwner(caller, owner string) string {
 func WorkflowIDIndexCollectionsByOwner(caller, owner string) string {
 	return fmt.Sprintf("index-tokens-collections-by-owner-%s-%s", caller, owner)
 }
+
+func WorkflowIDIndexCollection(collectionID string) string {
+	return fmt.Sprintf("index-collection-%s", collectionID)
+}
@@ -0,0 +1,180 @@
+package worker
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	utils "github.com/bitmark-inc/autonomy-utils"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+
+	indexer "github.com/feral-file/ff-indexer"
+)
+
+const (
+	// IndexCollectionStatusQuery is the query type to read the progress of a collection indexing
+	IndexCollectionStatusQuery = "status"
+
+	DefaultIndexCollectionBatchSize = 25
+
+	// collectionTokensCacheSize is the number of the collections which tokens are cached by a worker
+	collectionTokensCacheSize = 16
+	// collectionTokensCacheTTL is how long the tokens of a collection are cached
+	collectionTokensCacheTTL = time.Hour
+)
+
+// CollectionToken is a token which belongs to a collection
+type CollectionToken struct {
+	Blockchain string `json:"blockchain"`
+	Contract   string `json:"contract"`
+	TokenID    string `json:"tokenID"`
+}
+
+// IndexID returns the index id of the token
+func (t CollectionToken) IndexID() string {
+	return indexer.TokenIndexID(t.Blockchain, t.Contract, t.TokenID)
+}
+
+// CollectionTokens is a batch of the tokens of a collection
+type CollectionTokens struct {
+	Tokens []CollectionToken `json:"tokens"`
+	Total  int               `json:"total"`
+}
+
+// IndexCollectionInput is the input of IndexCollectionWorkflow. The tokens are read from the
+// token data URI by batches. Offset and Items carry the progress from a run to the next one.
+type IndexCollectionInput struct {
+	Collection   indexer.Collection `json:"collection"`
+	TokenDataURI string             `json:"tokenDataURI"`
+	RunID        string             `json:"runID"`
+	BatchSize    int                `json:"batchSize"`
+	Offset       int                `json:"offset"`
+	Items        int                `json:"items"`
+}
+
+// IndexCollectionStatus is the progress of a collection indexing
+type IndexCollectionStatus struct {
+	Total     int  `json:"total"`
+	Processed int  `json:"processed"`
+	Items     int  `json:"items"`
+	Done      bool `json:"done"`
+}
+
+// IndexCollectionWorkflow indexes a collection and its tokens in batches. Each run reads and
+// indexes a batch of tokens and continues as a new run with the next offset, so a failure only
+// retries the current batch and the history of a run stays small. The collection assets of the previous runs are removed once
+// all the tokens are indexed.
+func (w *Worker) IndexCollectionWorkflow(ctx workflow.Context, input IndexCollectionInput) error {
+	logger := log.CadenceWorkflowLogger(ctx)
+
+	if input.BatchSize <= 0 {
+		input.BatchSize = DefaultIndexCollectionBatchSize
+	}
+
+	status := IndexCollectionStatus{
+		Processed: input.Offset,
+		Items:     input.Items,
+	}
+	if err := workflow.SetQueryHandler(ctx, IndexCollectionStatusQuery, func() (IndexCollectionStatus, error) {
+		return status, nil
+	}); err != nil {
+		logger.Error(errors.New("fail to set query handler"), zap.Error(err))
+		return err
+	}
+
+	actx := ContextRegularActivity(ctx, w.TaskListName)
+	collectionID := input.Collection.ID
+
+	if input.Offset == 0 {
+		if err := workflow.ExecuteActivity(actx, w.IndexCollection, input.Collection).Get(ctx, nil); err != nil {
+			logger.Error(errors.New("fail to index collection"), zap.Error(err), zap.String("collectionID", collectionID))
+			return err
+		}
+	}
+
+	var tokens CollectionTokens
+	if err := workflow.ExecuteActivity(actx, w.GetCollectionTokens, input.TokenDataURI, input.RunID, input.Offset, input.BatchSize).Get(ctx, &tokens); err != nil {
+		logger.Error(errors.New("fail to get collection tokens"), zap.Error(err), zap.String("collectionID", collectionID))
+		return err
+	}
+	status.Total = tokens.Total
+
+	batch := tokens.Tokens
+	end := input.Offset + len(batch)
+	if len(batch) > 0 {
+		futures := make([]workflow.Future, 0, len(batch))
+		indexIDs := make([]string, 0, len(batch))
+		for _, token := range batch {
+			futures = append(futures, workflow.ExecuteChildWorkflow(
+				ContextRetriableChildWorkflow(ctx, w.TaskListName),
+				w.IndexTokenWorkflow, "", token.Contract, token.TokenID, false, false))
+			indexIDs = append(indexIDs, token.IndexID())
+		}
+
+		for _, future := range futures {
+			if err := future.Get(ctx, nil); err != nil {
+				logger.Error(errors.New("fail to index collection token"), zap.Error(err), zap.String("collectionID", collectionID))
+				return err
+			}
+		}
+
+		var items int
+		if err := workflow.ExecuteActivity(actx, w.IndexCollectionTokens, collectionID, input.RunID, indexIDs).Get(ctx, &items); err != nil {
+			logger.Error(errors.New("fail to index collection assets"), zap.Error(err), zap.String("collectionID", collectionID))
+			return err
+		}
+
+		status.Processed = end
+		status.Items += items
+	}
+
+	if len(batch) > 0 && end < tokens.Total {
+		input.Offset = end
+		input.Items = status.Items
+		return workflow.NewContinueAsNewError(ctx, w.IndexCollectionWorkflow, input)
+	}
+
+	if err := workflow.ExecuteActivity(actx, w.DeleteDeprecatedCollectionAsset, collectionID, input.RunID).Get(ctx, nil); err != nil {
+		logger.Error(errors.New("fail to delete deprecated collection assets"), zap.Error(err), zap.String("collectionID", collectionID))
+		return err
+	}
+
+	// Update the total supply of collection
+	collection := input.Collection
+	collection.Items = status.Items
+	if err := workflow.ExecuteActivity(actx, w.IndexCollection, collection).Get(ctx, nil); err != nil {
+		logger.Error(errors.New("fail to update collection items"), zap.Error(err), zap.String("collectionID", collectionID))
+		return err
+	}
+
+	status.Done = true
+	logger.Info("collection indexed", zap.String("collectionID", collectionID), zap.Int("items", status.Items))
+
+	return nil
+}
+
+// collectionTokens lists the tokens of a token registry in a stable order
+func collectionTokens(tokenData indexer.TokenRegistry) []CollectionToken {
+	var tokens []CollectionToken
+	appendTokens := func(blockchain string, contractTokens indexer.ContractTokens) {
+		contracts := contractTokens.ContractAddresses()
+		sort.Strings(contracts)
+		for _, contract := range contracts {
+			for _, tokenID := range contractTokens[contract] {
+				tokens = append(tokens, CollectionToken{
+					Blockchain: blockchain,
+					Contract:   contract,
+					TokenID:    tokenID,
+				})
+			}
+		}
+	}
+
+	appendTokens(utils.EthereumBlockchain, tokenData.Ethereum.ERC721)
+	appendTokens(utils.EthereumBlockchain, tokenData.Ethereum.ERC1155)
+	appendTokens(utils.TezosBlockchain, tokenData.Tezos.FA2)
+
+	return tokens
+}
@@ -0,0 +1,138 @@
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/golang-lru/v2/expirable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/workflow"
+
+	indexer "github.com/feral-file/ff-indexer"
+)
+
+func testIndexCollectionInput(offset int) IndexCollectionInput {
+	return IndexCollectionInput{
+		Collection:   indexer.Collection{ID: "series-registry-1"},
+		TokenDataURI: "ipfs://tokens",
+		RunID:        "run",
+		BatchSize:    2,
+		Offset:       offset,
+		Items:        offset,
+	}
+}
+
+func TestIndexCollectionWorkflowContinuesWithNextBatch(t *testing.T) {
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestWorkflowEnvironment()
+
+	w := &Worker{}
+	env.RegisterWorkflow(w.IndexCollectionWorkflow)
+	env.RegisterWorkflow(w.IndexTokenWorkflow)
+	env.RegisterActivity(w.IndexCollection)
+	env.RegisterActivity(w.GetCollectionTokens)
+	env.RegisterActivity(w.IndexCollectionTokens)
+
+	env.OnActivity(w.IndexCollection, mock.Anything, mock.Anything).Return(nil).Once()
+	env.OnActivity(w.GetCollectionTokens, mock.Anything, "ipfs://tokens", "run", 0, 2).Return(CollectionTokens{
+		Tokens: []CollectionToken{
+			{Blockchain: "ethereum", Contract: "0x1", TokenID: "1"},
+			{Blockchain: "ethereum", Contract: "0x1", TokenID: "2"},
+		},
+		Total: 3,
+	}, nil).Once()
+	env.OnWorkflow(w.IndexTokenWorkflow, mock.Anything, "", "0x1", mock.Anything, false, false).Return(nil).Twice()
+	env.OnActivity(w.IndexCollectionTokens, mock.Anything, "series-registry-1", "run",
+		[]string{indexer.TokenIndexID("ethereum", "0x1", "1"), indexer.TokenIndexID("ethereum", "0x1", "2")}).Return(2, nil).Once()
+
+	env.ExecuteWorkflow(w.IndexCollectionWorkflow, testIndexCollectionInput(0))
+
+	assert.True(t, env.IsWorkflowCompleted())
+	var continueAsNew *workflow.ContinueAsNewError
+	assert.ErrorAs(t, env.GetWorkflowError(), &continueAsNew)
+	env.AssertExpectations(t)
+}
+
+func TestIndexCollectionWorkflowFinishesLastBatch(t *testing.T) {
+	var s testsuite.WorkflowTestSuite
+	env := s.NewTestWorkflowEnvironment()
+
+	w := &Worker{}
+	env.RegisterWorkflow(w.IndexCollectionWorkflow)
+	env.RegisterWorkflow(w.IndexTokenWorkflow)
+	env.RegisterActivity(w.IndexCollection)
+	env.RegisterActivity(w.GetCollectionTokens)
+	env.RegisterActivity(w.IndexCollectionTokens)
+	env.RegisterActivity(w.DeleteDeprecatedCollectionAsset)
+
+	env.OnActivity(w.GetCollectionTokens, mock.Anything, "ipfs://tokens", "run", 2, 2).Return(CollectionTokens{
+		Tokens: []CollectionToken{{Blockchain: "tezos", Contract: "KT1", TokenID: "3"}},
+		Total:  3,
+	}, nil).Once()
+	env.OnWorkflow(w.IndexTokenWorkflow, mock.Anything, "", "KT1", "3", false, false).Return(nil).Once()
+	env.OnActivity(w.IndexCollectionTokens, mock.Anything, "series-registry-1", "run",
+		[]string{indexer.TokenIndexID("tezos", "KT1", "3")}).Return(0, nil).Once()
+	env.OnActivity(w.DeleteDeprecatedCollectionAsset, mock.Anything, "series-registry-1", "run").Return(nil).Once()
+	env.OnActivity(w.IndexCollection, mock.Anything, mock.MatchedBy(func(c indexer.Collection) bool {
+		return c.Items == 2
+	})).Return(nil).Once()
+
+	env.ExecuteWorkflow(w.IndexCollectionWorkflow, testIndexCollectionInput(2))
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	value, err := env.QueryWorkflow(IndexCollectionStatusQuery)
+	assert.NoError(t, err)
+	var status IndexCollectionStatus
+	assert.NoError(t, value.Get(&status))
+	assert.Equal(t, IndexCollectionStatus{Total: 3, Processed: 3, Items: 2, Done: true}, status)
+	env.AssertExpectations(t)
+}
+
+func TestGetCollectionTokensOfRun(t *testing.T) {
+	w := &Worker{
+		collectionTokens: expirable.NewLRU[string, []CollectionToken](collectionTokensCacheSize, nil, collectionTokensCacheTTL),
+	}
+	w.collectionTokens.Add("run|ipfs://tokens", []CollectionToken{
+		{Blockchain: "ethereum", Contract: "0x1", TokenID: "1"},
+		{Blockchain: "ethereum", Contract: "0x1", TokenID: "2"},
+		{Blockchain: "tezos", Contract: "KT1", TokenID: "3"},
+	})
+
+	// the later batches of a run are served without reading the token registry again
+	tokens, err := w.GetCollectionTokens(context.Background(), "ipfs://tokens", "run", 2, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, CollectionTokens{
+		Tokens: []CollectionToken{{Blockchain: "tezos", Contract: "KT1", TokenID: "3"}},
+		Total:  3,
+	}, tokens)
+
+	tokens, err = w.GetCollectionTokens(context.Background(), "ipfs://tokens", "run", 4, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, tokens.Tokens)
+	assert.Equal(t, 3, tokens.Total)
+}
+
+func TestCollectionTokens(t *testing.T) {
+	tokens := collectionTokens(indexer.TokenRegistry{
+		Ethereum: indexer.EthereumContracts{
+			ERC721: indexer.ContractTokens{
+				"0x2": {"1"},
+				"0x1": {"2", "1"},
+			},
+		},
+		Tezos: indexer.TezosContracts{
+			FA2: indexer.ContractTokens{"KT1": {"3"}},
+		},
+	})
+
+	assert.Equal(t, []CollectionToken{
+		{Blockchain: "ethereum", Contract: "0x1", TokenID: "2"},
+		{Blockchain: "ethereum", Contract: "0x1", TokenID: "1"},
+		{Blockchain: "ethereum", Contract: "0x2", TokenID: "1"},
+		{Blockchain: "tezos", Contract: "KT1", TokenID: "3"},
+	}, tokens)
+}
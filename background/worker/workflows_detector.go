@@ -37,6 +37,8 @@ type TokenSale struct {
 	Currency        string              `json:"currency"`
 	TxID            string              `json:"txID"`
 	PlatformFee     *big.Int            `json:"platformFee"`
+	Royalty         *big.Int            `json:"royalty,omitempty"`
+	SaleType        string              `json:"saleType,omitempty"`
 	NetRevenue      *big.Int            `json:"netRevenue"`
 	PaymentAmount   *big.Int            `json:"paymentAmount"`
 	Shares          map[string]*big.Int `json:"shares"`
@@ -150,6 +152,24 @@ func (w *Worker) ParseEthereumTokenSale(ctx workflow.Context, txID string) (*Tok
 	}
 	txToHex := txTo.Hex()
 
+	// Decode the sale by the marketplace protocols
+	tokenSale, err := EthereumSaleDecoders.Decode(tx, txReceipt)
+	if err != nil {
+		logger.Warn("fail to decode ethereum token sale, fall back to the payment transfers",
+			zap.Error(err), zap.String("txID", txID))
+	}
+	if nil != tokenSale {
+		timestamp, err := w.ethereumBlockTime(ctx, txReceipt.BlockHash.Hex())
+		if err != nil {
+			logger.Error(errors.New("fail to get ethereum block time"), zap.Error(err), zap.String("txID", txID))
+			return nil, err
+		}
+
+		tokenSale.Timestamp = timestamp
+		tokenSale.TxID = txID
+		return tokenSale, nil
+	}
+
 	// Classify transfers from the event logs
 	erc20Transfers, tokenTransfers := classifyTxLogs(txReceipt.Logs)
 
@@ -217,22 +237,6 @@ func (w *Worker) ParseEthereumTokenSale(ctx workflow.Context, txID string) (*Tok
 		tokenID := indexer.HexToDec(tokenIDHex)
 		tokenContract := l.Address.Hex()
 
-		// Check if the token is published by Feral File
-		// TODO remove after supporting all tokens not only Feral File one
-		indexID := indexer.TokenIndexID(utils.EthereumBlockchain, tokenContract, tokenID)
-		var token *indexer.Token
-		if err := workflow.ExecuteActivity(
-			ctx,
-			w.GetTokenByIndexID,
-			indexID).
-			Get(ctx, &token); err != nil {
-			return nil, err
-		}
-		if nil == token || token.Source != "feralfile" {
-			logger.Warn("token is not found or not published by Feral File", zap.String("txID", txID))
-			return nil, nil
-		}
-
 		// If there are multiple transfers for the same token,
 		// the sender is the first transfer sender,
 		// the recipient is the last transfer recipient
@@ -409,18 +413,9 @@ func (w *Worker) ParseEthereumTokenSale(ctx workflow.Context, txID string) (*Tok
 	}
 
 	// Sale timestamp
-	var blkHeader *types.Header
-	if err := workflow.ExecuteActivity(
-		ctx,
-		w.GetEthereumBlockHeaderHash,
-		txReceipt.BlockHash.Hex()).
-		Get(ctx, &blkHeader); nil != err {
-		logger.Error(errors.New("fail to get ethereum block header hash"), zap.Error(err), zap.String("txID", txID))
-		return nil, err
-	}
-	if nil == blkHeader {
-		err := errors.New("block not found")
-		logger.Error(err, zap.String("txID", txID))
+	timestamp, err := w.ethereumBlockTime(ctx, txReceipt.BlockHash.Hex())
+	if err != nil {
+		logger.Error(errors.New("fail to get ethereum block time"), zap.Error(err), zap.String("txID", txID))
 		return nil, err
 	}
 
@@ -435,7 +430,7 @@ func (w *Worker) ParseEthereumTokenSale(ctx workflow.Context, txID string) (*Tok
 	}
 
 	return &TokenSale{
-		Timestamp:       time.Unix(int64(blkHeader.Time), 0), // #nosec G115 -- Ethereum block timestamps are safe to convert
+		Timestamp:       timestamp,
 		Price:           price,
 		Marketplace:     marketplace,
 		Blockchain:      "ethereum",
@@ -449,6 +444,23 @@ func (w *Worker) ParseEthereumTokenSale(ctx workflow.Context, txID string) (*Tok
 	}, nil
 }
 
+// ethereumBlockTime returns the time of a block by its hash
+func (w *Worker) ethereumBlockTime(ctx workflow.Context, blkHash string) (time.Time, error) {
+	var blkHeader *types.Header
+	if err := workflow.ExecuteActivity(
+		ctx,
+		w.GetEthereumBlockHeaderHash,
+		blkHash).
+		Get(ctx, &blkHeader); nil != err {
+		return time.Time{}, err
+	}
+	if nil == blkHeader {
+		return time.Time{}, errors.New("block not found")
+	}
+
+	return time.Unix(int64(blkHeader.Time), 0), nil // #nosec G115 -- Ethereum block timestamps are safe to convert
+}
+
 func classifyTxLogs(logs []*types.Log) (map[string][]types.Log, []types.Log) {
 	erc20Transfers := make(map[string][]types.Log) // address => []types.Log
 	tokenTransfers := []types.Log{}                // address => []types.Log
@@ -492,14 +504,16 @@ func (w *Worker) IndexTezosTokenSaleFromTzktTxID(
 		return err
 	}
 
-	workflowID := fmt.Sprintf("IndexTezosObjktTokenSale-%s", *txHash)
+	// The marketplace is detected from the sale operation of the tx
+	workflowID := fmt.Sprintf("IndexTezosTokenSale-%s", *txHash)
 	cwctx := ContextNamedRegularChildWorkflow(ctx, workflowID, TaskListName)
 	if err := workflow.ExecuteChildWorkflow(
 		cwctx,
-		w.IndexTezosObjktTokenSale,
+		w.IndexTezosMarketplaceTokenSale,
+		"",
 		txHash,
 		true).Get(ctx, nil); err != nil {
-		logger.Error(errors.New("fail to execute tezos objkt token sale"), zap.Error(err), zap.String("txHash", *txHash))
+		logger.Error(errors.New("fail to execute tezos token sale"), zap.Error(err), zap.String("txHash", *txHash))
 		return err
 	}
 
@@ -508,6 +522,12 @@ func (w *Worker) IndexTezosTokenSaleFromTzktTxID(
 
 // IndexTezosObjktTokenSale is a workflow to index the sale of a Tezos objkt token
 func (w *Worker) IndexTezosObjktTokenSale(ctx workflow.Context, txHash string, skipIndexed bool) error {
+	return w.IndexTezosMarketplaceTokenSale(ctx, TezosMarketplaceObjkt, txHash, skipIndexed)
+}
+
+// IndexTezosMarketplaceTokenSale is a workflow to index the sale of a Tezos token on a marketplace.
+// The marketplace is detected from the sale operation if the marketplace ID is empty.
+func (w *Worker) IndexTezosMarketplaceTokenSale(ctx workflow.Context, marketplaceID, txHash string, skipIndexed bool) error {
 	ctx = ContextRegularActivity(ctx, TaskListName)
 	logger := log.CadenceWorkflowLogger(ctx)
 
@@ -533,10 +553,12 @@ func (w *Worker) IndexTezosObjktTokenSale(ctx workflow.Context, txHash string, s
 	var tokenSale *TokenSale
 	if err := workflow.ExecuteActivity(
 		ctx,
-		w.ParseTezosObjktTokenSale,
+		w.ParseTezosTokenSale,
+		marketplaceID,
 		txHash).
 		Get(ctx, &tokenSale); err != nil {
-		logger.Error(errors.New("fail to parse tezos objkt token sale"), zap.Error(err), zap.String("txHash", txHash))
+		logger.Error(errors.New("fail to parse tezos token sale"), zap.Error(err),
+			zap.String("marketplaceID", marketplaceID), zap.String("txHash", txHash))
 		return err
 	}
 
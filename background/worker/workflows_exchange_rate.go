@@ -3,8 +3,7 @@ package worker
 import (
 	"errors"
 	"fmt"
-	"strconv"
-	"strings"
+	"slices"
 	"time"
 
 	log "github.com/bitmark-inc/autonomy-logger"
@@ -21,6 +20,7 @@ const (
 	granularity          = 60
 	maxCandlesPerRequest = 300
 	chunkSize            = 25
+	backfillLookback     = 24 * time.Hour
 )
 
 type RequestChunk struct {
@@ -37,61 +37,163 @@ func (w *Worker) CrawlHistoricalExchangeRate(
 ) error {
 	logger := log.CadenceWorkflowLogger(ctx)
 
+	ao := workflow.ActivityOptions{
+		TaskList:               w.TaskListName,
+		ScheduleToStartTimeout: 10 * time.Minute,
+		StartToCloseTimeout:    time.Hour,
+	}
+	ctxac := workflow.WithActivityOptions(ctx, ao)
+
+	supportedPairs, err := w.currencyPairs(ctxac)
+	if err != nil {
+		return err
+	}
+
+	// Crawl the configured currency pairs if none is given
+	if len(currencyPairs) == 0 {
+		currencyPairs = supportedPairs
+	}
+
 	// Check if all currencyPairs is supported
 	for _, currencyPair := range currencyPairs {
-		if !indexer.SupportedCurrencyPairs[currencyPair] {
+		if !slices.Contains(supportedPairs, currencyPair) {
 			return nil
 		}
 	}
 
-	if start == 0 && end == 0 {
-		ao := workflow.ActivityOptions{
-			TaskList:               w.TaskListName,
-			ScheduleToStartTimeout: 10 * time.Minute,
-			StartToCloseTimeout:    time.Hour,
+	// Crawl each currency pair from its last exchange rate if no time range is given. A currency
+	// pair without exchange rates is crawled from the backfill lookback.
+	requests := make([]RequestChunk, 0)
+	for _, currencyPair := range currencyPairs {
+		from, to := start, end
+		if start == 0 && end == 0 {
+			var lastTime time.Time
+			if err := workflow.ExecuteActivity(
+				ctxac,
+				w.GetExchangeRateLastTime,
+				currencyPair,
+			).Get(ctx, &lastTime); err != nil {
+				logger.Error(errors.New("fail to get exchange rate last time"), zap.Error(err), zap.String("currencyPair", currencyPair))
+				return err
+			}
+
+			now := workflow.Now(ctx)
+			if lastTime.IsZero() {
+				lastTime = now.Add(-backfillLookback)
+			}
+			from, to = lastTime.Unix(), now.Unix()
 		}
-		ctxac := workflow.WithActivityOptions(ctx, ao)
 
-		var lastTime time.Time
-		if err := workflow.ExecuteActivity(
-			ctxac,
-			w.GetExchangeRateLastTime,
-		).Get(ctx, &lastTime); err != nil {
-			logger.Error(errors.New("fail to get exchange rate last time"), zap.Error(err), zap.String("currencyPairs", strings.Join(currencyPairs, ":")))
+		for i := from; i < to; i += int64(maxCandlesPerRequest * granularity) {
+			requests = append(requests, RequestChunk{currencyPair, i, min(i+int64(maxCandlesPerRequest*granularity), to)})
+		}
+	}
+
+	return w.crawlExchangeRateRequests(ctx, requests)
+}
+
+// currencyPairs loads the configured currency pairs by an activity, so that the workflows do not
+// read the configuration which may differ between their replays
+func (w *Worker) currencyPairs(ctx workflow.Context) ([]string, error) {
+	var currencyPairs []string
+	if err := workflow.ExecuteActivity(ctx, w.GetCurrencyPairs).Get(ctx, &currencyPairs); err != nil {
+		log.CadenceWorkflowLogger(ctx).Error(errors.New("fail to get currency pairs"), zap.Error(err))
+		return nil, err
+	}
+
+	return currencyPairs, nil
+}
+
+// BackfillHistoricalExchangeRate crawls the exchange rates of the missing candle windows of the
+// currency pairs from start to end. The last day is backfilled if start and end are zero.
+func (w *Worker) BackfillHistoricalExchangeRate(
+	ctx workflow.Context,
+	currencyPairs []string,
+	start int64,
+	end int64,
+) error {
+	logger := log.CadenceWorkflowLogger(ctx)
+
+	ao := workflow.ActivityOptions{
+		TaskList:               w.TaskListName,
+		ScheduleToStartTimeout: 10 * time.Minute,
+		StartToCloseTimeout:    10 * time.Minute,
+	}
+	ctxac := workflow.WithActivityOptions(ctx, ao)
+
+	if len(currencyPairs) == 0 {
+		var err error
+		if currencyPairs, err = w.currencyPairs(ctxac); err != nil {
 			return err
 		}
+	}
 
-		start = lastTime.Unix()
-		end = time.Now().Unix()
+	if start == 0 && end == 0 {
+		now := workflow.Now(ctx)
+		start = now.Add(-backfillLookback).Unix()
+		end = now.Unix()
 	}
 
 	if start >= end {
 		return nil
 	}
 
-	workflowDataChunks := make([][]RequestChunk, 0)
-	requestBatches := make([]RequestChunk, 0, chunkSize)
-	for i := start; i < end; i += int64(maxCandlesPerRequest * granularity) {
-		_startTime := i
-		_endTime := i + int64(maxCandlesPerRequest*granularity)
-		if _endTime > end {
-			_endTime = end
+	requests := make([]RequestChunk, 0)
+	for _, currencyPair := range currencyPairs {
+		var gaps []indexer.ExchangeRateGap
+		if err := workflow.ExecuteActivity(
+			ctxac,
+			w.GetExchangeRateGaps,
+			currencyPair,
+			start,
+			end,
+		).Get(ctx, &gaps); err != nil {
+			logger.Error(errors.New("fail to get exchange rate gaps"), zap.Error(err), zap.String("currencyPair", currencyPair))
+			return err
 		}
 
-		for _, currencyPair := range currencyPairs {
-			requestBatches = append(requestBatches, RequestChunk{currencyPair, _startTime, _endTime})
-			if len(requestBatches) == chunkSize {
-				workflowDataChunks = append(workflowDataChunks, requestBatches)
-				requestBatches = make([]RequestChunk, 0, chunkSize)
+		requests = append(requests, exchangeRateGapRequests(gaps, int64(maxCandlesPerRequest*granularity))...)
+	}
+
+	logger.Info("backfill exchange rate gaps", zap.Int("requests", len(requests)))
+
+	return w.crawlExchangeRateRequests(ctx, requests)
+}
+
+// exchangeRateGapRequests returns the crawl requests of the gaps. The adjacent gaps are merged
+// into a request as long as it is not longer than the window, so that the short gaps of the
+// minutes without trades do not end up in a request each.
+func exchangeRateGapRequests(gaps []indexer.ExchangeRateGap, window int64) []RequestChunk {
+	requests := make([]RequestChunk, 0)
+	for _, gap := range gaps {
+		from, to := gap.From.Unix(), gap.To.Unix()
+		for from < to {
+			if n := len(requests) - 1; n >= 0 &&
+				requests[n].currencyPair == gap.CurrencyPair &&
+				to-requests[n].startTime <= window {
+				requests[n].endTime = to
+				break
+			}
+
+			end := from + window
+			if end > to {
+				end = to
 			}
+			requests = append(requests, RequestChunk{gap.CurrencyPair, from, end})
+			from = end
 		}
 	}
 
-	if len(requestBatches) > 0 {
-		workflowDataChunks = append(workflowDataChunks, requestBatches)
-	}
+	return requests
+}
+
+// crawlExchangeRateRequests crawls the requests by child workflows in chunks
+func (w *Worker) crawlExchangeRateRequests(ctx workflow.Context, requests []RequestChunk) error {
+	logger := log.CadenceWorkflowLogger(ctx)
+
+	for i := 0; i < len(requests); i += chunkSize {
+		requestBatch := requests[i:min(i+chunkSize, len(requests))]
 
-	for _, requestBatch := range workflowDataChunks {
 		futures := make([]workflow.Future, 0, len(requestBatch))
 		for _, request := range requestBatch {
 			cwo := workflow.ChildWorkflowOptions{
@@ -114,7 +216,6 @@ func (w *Worker) CrawlHistoricalExchangeRate(
 				return err
 			}
 		}
-
 	}
 
 	return nil
@@ -143,12 +244,12 @@ func (w *Worker) CrawlExchangeRateByCurrencyPair(
 
 	if err := workflow.ExecuteActivity(
 		ctx,
-		w.CrawlExchangeRateFromCoinbase,
+		w.CrawlExchangeRateFromProviders,
 		currencyPair,
-		strconv.Itoa(granularity),
+		int64(granularity),
 		start,
 		end).Get(ctx, &rates); err != nil {
-		logger.Error(errors.New("fail to crawl exchange rate from coinbase"), zap.Error(err), zap.String("currencyPair", currencyPair), zap.Int64("start", start), zap.Int64("end", end))
+		logger.Error(errors.New("fail to crawl exchange rate from providers"), zap.Error(err), zap.String("currencyPair", currencyPair), zap.Int64("start", start), zap.Int64("end", end))
 		return err
 	}
 
@@ -97,6 +97,13 @@ func (w *Worker) IndexTokenWorkflow(ctx workflow.Context, owner, contract, token
 		return err
 	}
 
+	if indexPreview {
+		// the thumbnail is still generated by the regular queue if the request fails
+		if err := workflow.ExecuteActivity(ContextFastActivity(ctx, w.TaskListName), w.EnqueueThumbnailJob, update.Tokens[0].IndexID).Get(ctx, nil); err != nil {
+			logger.Warn("fail to queue thumbnail job", zap.Error(err), zap.String("indexID", update.Tokens[0].IndexID))
+		}
+	}
+
 	if owner != "" {
 		var balance int64
 		if err := workflow.ExecuteActivity(ctx, w.GetTokenBalanceOfOwner, contract, tokenID, owner).Get(ctx, &balance); err != nil {
@@ -149,6 +156,8 @@ func (w *Worker) IndexTokenWorkflow(ctx workflow.Context, owner, contract, token
 	return nil
 }
 
+// IndexEthereumTokenSaleInBlockRange indexes the sales of the txs in a block range. The indexed
+// sales are parsed again and replaced unless skipIndexed is true, e.g. to correct parsing bugs.
 func (w *Worker) IndexEthereumTokenSaleInBlockRange(
 	ctx workflow.Context,
 	fromBlk uint64,
@@ -159,11 +168,6 @@ func (w *Worker) IndexEthereumTokenSaleInBlockRange(
 	logger := log.CadenceWorkflowLogger(ctx)
 	ctx = ContextRegularActivity(ctx, w.TaskListName)
 
-	// TODO remove in the future
-	if !skipIndexed {
-		return errors.New("skipIndexed must be true until we have a unique index handled properly for sale time series data")
-	}
-
 	if fromBlk > toBlk {
 		err := errors.New("invalid block range")
 		logger.Error(err, zap.Uint64("fromBlk", fromBlk), zap.Uint64("toBlk", toBlk))
@@ -229,6 +233,17 @@ func (w *Worker) IndexTezosObjktTokenSaleFromTime(
 	offset int,
 	batchSize int,
 	skipIndexed bool) error {
+	return w.IndexTezosTokenSaleFromTime(ctx, TezosMarketplaceObjkt, startTime, offset, batchSize, skipIndexed)
+}
+
+// IndexTezosTokenSaleFromTime is a workflow to index the sales of a Tezos marketplace from a time
+func (w *Worker) IndexTezosTokenSaleFromTime(
+	ctx workflow.Context,
+	marketplaceID string,
+	startTime time.Time,
+	offset int,
+	batchSize int,
+	skipIndexed bool) error {
 	logger := log.CadenceWorkflowLogger(ctx)
 	ctx = ContextRegularActivity(ctx, w.TaskListName)
 
@@ -236,12 +251,14 @@ func (w *Worker) IndexTezosObjktTokenSaleFromTime(
 	hashes := make([]string, 0)
 	if err := workflow.ExecuteActivity(
 		ctx,
-		w.GetObjktSaleTransactionHashes,
+		w.GetTezosSaleTransactionHashes,
+		marketplaceID,
 		startTime,
 		offset,
 		batchSize).
 		Get(ctx, &hashes); err != nil {
-		logger.Error(errors.New("fail to get objkt sale transaction hashes"), zap.Error(err), zap.Time("startTime", startTime), zap.Int("offset", offset), zap.Int("batchSize", batchSize))
+		logger.Error(errors.New("fail to get tezos sale transaction hashes"), zap.Error(err), zap.String("marketplaceID", marketplaceID),
+			zap.Time("startTime", startTime), zap.Int("offset", offset), zap.Int("batchSize", batchSize))
 		return err
 	}
 
@@ -253,13 +270,14 @@ func (w *Worker) IndexTezosObjktTokenSaleFromTime(
 		}
 
 		indexedHashes[hash] = true
-		workflowID := fmt.Sprintf("IndexTezosObjktTokenSale-%s", hash)
+		workflowID := fmt.Sprintf("IndexTezosTokenSale-%s", hash)
 		cwctx := ContextNamedRegularChildWorkflow(ctx, workflowID, TaskListName)
 		futures = append(
 			futures,
 			workflow.ExecuteChildWorkflow(
 				cwctx,
-				w.IndexTezosObjktTokenSale,
+				w.IndexTezosMarketplaceTokenSale,
+				marketplaceID,
 				hash,
 				skipIndexed,
 			))
@@ -267,7 +285,7 @@ func (w *Worker) IndexTezosObjktTokenSaleFromTime(
 
 	for _, future := range futures {
 		if err := future.Get(ctx, nil); err != nil {
-			logger.Error(errors.New("fail to index tezos objkt token sale"), zap.Error(err))
+			logger.Error(errors.New("fail to index tezos token sale"), zap.Error(err), zap.String("marketplaceID", marketplaceID))
 			return err
 		}
 	}
@@ -275,7 +293,8 @@ func (w *Worker) IndexTezosObjktTokenSaleFromTime(
 	if len(hashes) > 0 {
 		return workflow.NewContinueAsNewError(
 			ctx,
-			w.IndexTezosObjktTokenSaleFromTime,
+			w.IndexTezosTokenSaleFromTime,
+			marketplaceID,
 			startTime,
 			offset+len(hashes),
 			batchSize,
@@ -5,6 +5,7 @@ import (
 
 	"github.com/spf13/viper"
 	"go.uber.org/cadence/client"
+	"go.uber.org/cadence/encoded"
 	"go.uber.org/cadence/workflow"
 )
 
@@ -48,3 +49,9 @@ func (c *WorkerClient) ExecuteWorkflow(ctx context.Context, clientName string,
 	options client.StartWorkflowOptions, workflowFunc interface{}, args ...interface{}) (client.WorkflowRun, error) {
 	return c.clients[clientName].ExecuteWorkflow(ctx, options, workflowFunc, args...)
 }
+
+// QueryWorkflow queries the state of a workflow in a specific client
+func (c *WorkerClient) QueryWorkflow(ctx context.Context, clientName string,
+	workflowID, runID, queryType string, args ...interface{}) (encoded.Value, error) {
+	return c.clients[clientName].QueryWorkflow(ctx, workflowID, runID, queryType, args...)
+}
@@ -23,6 +23,18 @@ var EthereumIndexExcludedOwners = map[string]struct{}{
 
 const TransferEventSignature = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
 const TransferSingleEventSignature = "0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62"
+const TransferBatchEventSignature = "0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb"
+
+// Marketplace sale events
+const SeaportOrderFulfilledEventSignature = "0x9d9af8e38d66c62e2c12f0225249fd9d721c54b83f48d9352c97c6cacdcb6f31"
+const BlurOrdersMatchedEventSignature = "0x61cbb2a3dee0b6064c2e681aadd61677fb4ef319f0b547508d495626f5a62f64"
+const X2Y2EvInventoryEventSignature = "0x3cbb63f144840e5b1b0a38a7c19211d2e89de4d7c5faf8b2d3c1776c302d1d33"
+const LooksRareTakerBidEventSignature = "0x3ee3de4684413690dee6fff1a0a4f92916a1b97d1c5a83cdf24671844306b2e3"
+const LooksRareTakerAskEventSignature = "0x9aaa45d6db2ef74ead0751ea9113263d1dec1b50cea05f0ca2002cb8063564a4"
+const FeralFileBuyArtworkEventSignature = "0x0475389cd69b8d3163620b43283bf74e8fc71020c3c6cef2a529b5c405e9687f"
+
+// BlurPoolAddress is the ETH pool which pays the Blur bids
+const BlurPoolAddress = "0x0000000000A39bb272e79075ade125fd351887Ac"
 
 // Series Registry Contract
 const SeriesRegistryEventRegisterSeriesSignature = "0x55d82c1e0fbf557aad06476685a2e64309e639e7b9763ffc3cffce16cb33f689"
@@ -48,6 +60,10 @@ const (
 	TezosOBJKTMarketplaceAddress     = "KT1FvqJwEDWb1Gwc55Jd1jjTHRVWbYKUUpyq"
 	TezosOBJKTMarketplaceAddressV2   = "KT1WvzYHCNBvDSdwafTHv7nJ1dWmZ8GCYuuC"
 	TezosOBJKTTreasuryProxyAddress   = "KT19kCpYFxrNPegMBYKKH44szaqv8offqBRz"
+	TezosFxhashMarketplaceAddressV1  = "KT1Xo5B7PNBAeynZPmca4bRh6LQ9ZJnYnqmM"
+	TezosFxhashMarketplaceAddressV2  = "KT1GbyoDi7H1sfXmimXpptZJuCdHMh66WN7n"
+	TezosFxhashMarketplaceAddressV3  = "KT1M1NyU9X4usEimt2f3kDaijZnDMNBu42Ja"
+	TezosVersumMarketplaceAddress    = "KT1GyRAJNdizF1nojQz62uGYkx8WFRUJm9X5"
 )
 
 // testent
@@ -76,6 +92,9 @@ const FXHASHContractAddressDev0_0 = "KT1NkZho1yRkDdQnN4Mz93sDYyY2pPrEHTNs"
 const FXHASHContractAddressDev0_1 = "KT1TtVAyjh4Ahdm8sLZwFnL7tqoLf59XrK2h"
 
 var OBJKTSaleEntrypoints = []string{"fulfill_ask", "fulfill_offer"}
+var HicEtNuncSaleEntrypoints = []string{"collect"}
+var FxhashSaleEntrypoints = []string{"collect", "listing_accept", "offer_accept"}
+var VersumSaleEntrypoints = []string{"collect_swap", "accept_offer"}
 
 const (
 	SourceFeralFile = "feralfile"
@@ -113,7 +132,13 @@ const (
 
 const hicetnuncDefaultThumbnailURL = "ipfs://QmNrhZHUaEqxhyLfqoq1mtHSipkWHeT31LNHb1QEbDHgnc"
 
-var SupportedCurrencyPairs = map[string]bool{
-	"ETH-USD": true,
-	"XTZ-USD": true,
+// SaleCurrencyDecimals are the decimals of the sale values of each currency
+var SaleCurrencyDecimals = map[string]int{
+	"ETH":  18,
+	"WETH": 18,
+	"XTZ":  6,
+	"USDC": 6,
+	"USDT": 6,
+	"DAI":  18,
+	"EURC": 6,
 }
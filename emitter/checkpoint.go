@@ -0,0 +1,288 @@
+package emitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bitmark-inc/config-loader/external/aws/ssm"
+	"go.mongodb.org/mongo-driver/mongo"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/feral-file/ff-indexer/cache"
+)
+
+const (
+	CheckpointStoreSSM      = "ssm"
+	CheckpointStorePostgres = "postgres"
+	CheckpointStoreMongoDB  = "mongodb"
+	CheckpointStoreFile     = "file"
+)
+
+// subscriptions of the emitters which keep their own checkpoints
+const (
+	SubscriptionNftTransfer    = "nft_transfer"
+	SubscriptionSeriesRegistry = "series_registry"
+)
+
+var ErrCheckpointNotFound = errors.New("checkpoint not found")
+
+// CheckpointStore keeps the last processed block (or level) of each chain and subscription
+type CheckpointStore interface {
+	GetCheckpoint(ctx context.Context, chain, subscription string) (uint64, error)
+	SaveCheckpoint(ctx context.Context, chain, subscription string, blockNumber uint64) error
+}
+
+// CheckpointStoreConfig is the configuration to create a checkpoint store
+type CheckpointStoreConfig struct {
+	Type string
+
+	// KeyName is the parameter name of the ssm checkpoint
+	KeyName string
+	// DSN is the connection string of the postgres checkpoint store
+	DSN string
+	// Path is the directory of the file checkpoint store
+	Path string
+	// CacheStore is the store of the mongodb checkpoint store
+	CacheStore cache.Store
+}
+
+// NewCheckpointStore creates a checkpoint store by the given type
+func NewCheckpointStore(ctx context.Context, config CheckpointStoreConfig) (CheckpointStore, error) {
+	switch config.Type {
+	case "", CheckpointStoreSSM:
+		parameterStore, err := ssm.New(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return NewSSMCheckpointStore(parameterStore, config.KeyName), nil
+	case CheckpointStorePostgres:
+		db, err := gorm.Open(postgres.Open(config.DSN), &gorm.Config{})
+		if err != nil {
+			return nil, err
+		}
+		return NewPostgresCheckpointStore(db)
+	case CheckpointStoreMongoDB:
+		if config.CacheStore == nil {
+			return nil, errors.New("cache store is required for the mongodb checkpoint store")
+		}
+		return NewMongoDBCheckpointStore(config.CacheStore), nil
+	case CheckpointStoreFile:
+		return NewFileCheckpointStore(config.Path)
+	default:
+		return nil, fmt.Errorf("unsupported checkpoint store: %s", config.Type)
+	}
+}
+
+// SSMCheckpointStore saves checkpoints into the AWS parameter store. Each subscription
+// is saved under the key name. The key name itself is the checkpoint before subscriptions
+// were tracked separately and it is used when a subscription has no checkpoint yet.
+type SSMCheckpointStore struct {
+	parameterStore *ssm.ParameterStore
+	keyName        string
+}
+
+func NewSSMCheckpointStore(parameterStore *ssm.ParameterStore, keyName string) *SSMCheckpointStore {
+	return &SSMCheckpointStore{
+		parameterStore: parameterStore,
+		keyName:        keyName,
+	}
+}
+
+func (s *SSMCheckpointStore) key(subscription string) string {
+	return fmt.Sprintf("%s/%s", s.keyName, subscription)
+}
+
+// GetCheckpoint reads the checkpoint of a subscription
+func (s *SSMCheckpointStore) GetCheckpoint(ctx context.Context, _, subscription string) (uint64, error) {
+	value, err := s.parameterStore.GetString(ctx, s.key(subscription))
+	if err != nil {
+		value, err = s.parameterStore.GetString(ctx, s.keyName)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return strconv.ParseUint(value, 10, 64)
+}
+
+// SaveCheckpoint saves the checkpoint of a subscription
+func (s *SSMCheckpointStore) SaveCheckpoint(ctx context.Context, _, subscription string, blockNumber uint64) error {
+	return s.parameterStore.PutString(ctx, s.key(subscription), strconv.FormatUint(blockNumber, 10))
+}
+
+// Checkpoint is the model of emitter checkpoints
+type Checkpoint struct {
+	Chain        string    `gorm:"primaryKey"`
+	Subscription string    `gorm:"primaryKey"`
+	BlockNumber  uint64    `gorm:"NOT NULL"`
+	UpdatedAt    time.Time `gorm:"default:now()"`
+}
+
+func (Checkpoint) TableName() string {
+	return "emitter_checkpoints"
+}
+
+// PostgresCheckpointStore saves checkpoints into a postgres table
+type PostgresCheckpointStore struct {
+	db *gorm.DB
+}
+
+func NewPostgresCheckpointStore(db *gorm.DB) (*PostgresCheckpointStore, error) {
+	if err := db.AutoMigrate(&Checkpoint{}); err != nil {
+		return nil, err
+	}
+
+	return &PostgresCheckpointStore{
+		db: db,
+	}, nil
+}
+
+// GetCheckpoint reads the checkpoint of a subscription
+func (s *PostgresCheckpointStore) GetCheckpoint(ctx context.Context, chain, subscription string) (uint64, error) {
+	var checkpoint Checkpoint
+	err := s.db.WithContext(ctx).
+		Where("chain = ? AND subscription = ?", chain, subscription).
+		First(&checkpoint).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, ErrCheckpointNotFound
+		}
+		return 0, err
+	}
+
+	return checkpoint.BlockNumber, nil
+}
+
+// SaveCheckpoint upserts the checkpoint of a subscription
+func (s *PostgresCheckpointStore) SaveCheckpoint(ctx context.Context, chain, subscription string, blockNumber uint64) error {
+	return s.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "chain"}, {Name: "subscription"}},
+			DoUpdates: clause.AssignmentColumns([]string{"block_number", "updated_at"}),
+		}).
+		Create(&Checkpoint{
+			Chain:        chain,
+			Subscription: subscription,
+			BlockNumber:  blockNumber,
+			UpdatedAt:    time.Now(),
+		}).Error
+}
+
+// MongoDBCheckpointStore saves checkpoints into the cache store
+type MongoDBCheckpointStore struct {
+	cacheStore cache.Store
+}
+
+func NewMongoDBCheckpointStore(cacheStore cache.Store) *MongoDBCheckpointStore {
+	return &MongoDBCheckpointStore{
+		cacheStore: cacheStore,
+	}
+}
+
+func (s *MongoDBCheckpointStore) key(chain, subscription string) string {
+	return fmt.Sprintf("checkpoint:%s:%s", chain, subscription)
+}
+
+// GetCheckpoint reads the checkpoint of a subscription
+func (s *MongoDBCheckpointStore) GetCheckpoint(ctx context.Context, chain, subscription string) (uint64, error) {
+	data, err := s.cacheStore.Get(ctx, s.key(chain, subscription))
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, ErrCheckpointNotFound
+		}
+		return 0, err
+	}
+
+	value, ok := data.(string)
+	if !ok {
+		return 0, fmt.Errorf("invalid checkpoint value: %v", data)
+	}
+
+	return strconv.ParseUint(value, 10, 64)
+}
+
+// SaveCheckpoint saves the checkpoint of a subscription
+func (s *MongoDBCheckpointStore) SaveCheckpoint(ctx context.Context, chain, subscription string, blockNumber uint64) error {
+	return s.cacheStore.Set(ctx, s.key(chain, subscription), strconv.FormatUint(blockNumber, 10))
+}
+
+// FileCheckpointStore saves each checkpoint into a file of a directory. A checkpoint
+// is written into a temporary file first and renamed, so it is never partially written.
+type FileCheckpointStore struct {
+	sync.Mutex
+
+	path string
+}
+
+func NewFileCheckpointStore(path string) (*FileCheckpointStore, error) {
+	if path == "" {
+		return nil, errors.New("checkpoint path is required")
+	}
+
+	if err := os.MkdirAll(path, 0o750); err != nil {
+		return nil, err
+	}
+
+	return &FileCheckpointStore{
+		path: path,
+	}, nil
+}
+
+func (s *FileCheckpointStore) filename(chain, subscription string) string {
+	return filepath.Join(s.path, fmt.Sprintf("%s-%s", chain, subscription))
+}
+
+// GetCheckpoint reads the checkpoint of a subscription
+func (s *FileCheckpointStore) GetCheckpoint(_ context.Context, chain, subscription string) (uint64, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	data, err := os.ReadFile(s.filename(chain, subscription))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, ErrCheckpointNotFound
+		}
+		return 0, err
+	}
+
+	return strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+}
+
+// SaveCheckpoint saves the checkpoint of a subscription
+func (s *FileCheckpointStore) SaveCheckpoint(_ context.Context, chain, subscription string, blockNumber uint64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	f, err := os.CreateTemp(s.path, ".checkpoint-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+
+	if _, err := f.WriteString(strconv.FormatUint(blockNumber, 10)); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), s.filename(chain, subscription))
+}
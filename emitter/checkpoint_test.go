@@ -0,0 +1,33 @@
+package emitter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileCheckpointStore(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := NewFileCheckpointStore(t.TempDir())
+	assert.NoError(t, err)
+
+	_, err = store.GetCheckpoint(ctx, "ethereum", SubscriptionNftTransfer)
+	assert.ErrorIs(t, err, ErrCheckpointNotFound)
+
+	assert.NoError(t, store.SaveCheckpoint(ctx, "ethereum", SubscriptionNftTransfer, 100))
+	assert.NoError(t, store.SaveCheckpoint(ctx, "ethereum", SubscriptionSeriesRegistry, 90))
+	assert.NoError(t, store.SaveCheckpoint(ctx, "ethereum", SubscriptionNftTransfer, 120))
+
+	blockNumber, err := store.GetCheckpoint(ctx, "ethereum", SubscriptionNftTransfer)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(120), blockNumber)
+
+	blockNumber, err = store.GetCheckpoint(ctx, "ethereum", SubscriptionSeriesRegistry)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(90), blockNumber)
+
+	_, err = store.GetCheckpoint(ctx, "tezos", SubscriptionNftTransfer)
+	assert.ErrorIs(t, err, ErrCheckpointNotFound)
+}
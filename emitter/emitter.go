@@ -11,6 +11,14 @@ import (
 	pb "github.com/feral-file/ff-indexer/services/event-processor/grpc"
 )
 
+// NftEventTypeReverted is the type of compensating events for nft events
+// which were emitted from orphaned blocks
+const NftEventTypeReverted = "reverted"
+
+// SeriesRegistryEventTypeReverted is the type of compensating events for series
+// registry events which were emitted from orphaned blocks
+const SeriesRegistryEventTypeReverted = "reverted"
+
 type EventsEmitter struct {
 	grpcClient pb.EventProcessorClient
 }
@@ -21,8 +29,11 @@ func New(grpcClient pb.EventProcessorClient) EventsEmitter {
 	}
 }
 
-// PushNftEvent submits nft events to event processor
-func (e *EventsEmitter) PushNftEvent(ctx context.Context, eventType, fromAddress, toAddress, contractAddress, blockchain, tokenID, txID string, eventIndex uint, txTime time.Time) error {
+// PushNftEvent submits nft events to event processor. The amount is the
+// transferred quantity of the token which is always 1 for non-fungible tokens.
+// The batch index is the position of the token in a batch transfer log and is 0
+// for the logs of a single token.
+func (e *EventsEmitter) PushNftEvent(ctx context.Context, eventType, fromAddress, toAddress, contractAddress, blockchain, tokenID, amount, txID string, eventIndex, batchIndex uint, txTime time.Time) error {
 	eventInput := pb.NftEventInput{
 		Type:       eventType,
 		Blockchain: blockchain,
@@ -30,8 +41,10 @@ func (e *EventsEmitter) PushNftEvent(ctx context.Context, eventType, fromAddress
 		From:       fromAddress,
 		To:         toAddress,
 		TokenID:    tokenID,
+		Amount:     amount,
 		TXID:       txID,
 		EventIndex: uint64(eventIndex),
+		BatchIndex: uint64(batchIndex),
 		TXTime:     timestamppb.New(txTime),
 	}
 
@@ -47,6 +60,18 @@ func (e *EventsEmitter) PushNftEvent(ctx context.Context, eventType, fromAddress
 	return nil
 }
 
+// PushRevertedNftEvent submits a compensating event for an nft event which
+// has been pushed before but its block is no longer canonical
+func (e *EventsEmitter) PushRevertedNftEvent(ctx context.Context, fromAddress, toAddress, contractAddress, blockchain, tokenID, amount, txID string, eventIndex, batchIndex uint, txTime time.Time) error {
+	return e.PushNftEvent(ctx, NftEventTypeReverted, fromAddress, toAddress, contractAddress, blockchain, tokenID, amount, txID, eventIndex, batchIndex, txTime)
+}
+
+// PushRevertedSeriesRegistryEvent submits a compensating event for a series registry event
+// which has been pushed before but its block is no longer canonical
+func (e *EventsEmitter) PushRevertedSeriesRegistryEvent(ctx context.Context, contractAddress, txID string, data map[string]interface{}, eventIndex uint, txTime time.Time) error {
+	return e.PushSeriesRegistryEvent(ctx, SeriesRegistryEventTypeReverted, contractAddress, txID, data, eventIndex, txTime)
+}
+
 // PushSeriesRegistryEvent submits series registry events to event processor
 func (e *EventsEmitter) PushSeriesRegistryEvent(ctx context.Context, eventType, contractAddress, txID string, data map[string]interface{}, eventIndex uint, txTime time.Time) error {
 	var sd *structpb.Struct
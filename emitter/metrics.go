@@ -0,0 +1,140 @@
+package emitter
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+)
+
+const DefaultLagReportInterval = 30 * time.Second
+
+var (
+	headBlockGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "emitter_head_block",
+		Help: "The latest block number of a chain",
+	}, []string{"chain"})
+
+	checkpointGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "emitter_checkpoint_block",
+		Help: "The block number of the checkpoint of a subscription",
+	}, []string{"chain", "subscription"})
+
+	scannedBlockGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "emitter_scanned_block",
+		Help: "The latest block which logs are all emitted by a subscription",
+	}, []string{"chain", "subscription"})
+
+	checkpointLagGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "emitter_checkpoint_lag_blocks",
+		Help: "The number of blocks between the head block and the checkpoint or the scanned block of a subscription",
+	}, []string{"chain", "subscription"})
+)
+
+// scannedBlocks keeps the scanned blocks of the subscriptions by chain and subscription
+var scannedBlocks = struct {
+	sync.Mutex
+	blocks map[string]uint64
+}{blocks: map[string]uint64{}}
+
+// ReportScannedBlock sets the latest block which logs are all emitted by a subscription. The
+// checkpoint of a subscription advances only when a log is emitted, so the scanned block keeps
+// the lag of a subscription without logs.
+func ReportScannedBlock(chain, subscription string, block uint64) {
+	scannedBlocks.Lock()
+	defer scannedBlocks.Unlock()
+
+	key := chain + "/" + subscription
+	if block <= scannedBlocks.blocks[key] {
+		return
+	}
+	scannedBlocks.blocks[key] = block
+	scannedBlockGauge.WithLabelValues(chain, subscription).Set(float64(block))
+}
+
+// scannedBlock returns the reported scanned block of a subscription
+func scannedBlock(chain, subscription string) uint64 {
+	scannedBlocks.Lock()
+	defer scannedBlocks.Unlock()
+
+	return scannedBlocks.blocks[chain+"/"+subscription]
+}
+
+// ReportCheckpointLag sets the lag metrics of a subscription. The lag is counted from the later
+// one of the checkpoint and the scanned block.
+func ReportCheckpointLag(chain, subscription string, headBlock, checkpoint uint64) {
+	lag := uint64(0)
+	if progress := max(checkpoint, scannedBlock(chain, subscription)); headBlock > progress {
+		lag = headBlock - progress
+	}
+
+	headBlockGauge.WithLabelValues(chain).Set(float64(headBlock))
+	checkpointGauge.WithLabelValues(chain, subscription).Set(float64(checkpoint))
+	checkpointLagGauge.WithLabelValues(chain, subscription).Set(float64(lag))
+}
+
+// MonitorCheckpointLag reports the lag between the head block and the checkpoints of
+// the subscriptions in an interval until the context is done
+func MonitorCheckpointLag(ctx context.Context, interval time.Duration, chain string, subscriptions []string,
+	store CheckpointStore, headBlock func(ctx context.Context) (uint64, error)) {
+	if interval <= 0 {
+		interval = DefaultLagReportInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		head, err := headBlock(ctx)
+		if err != nil {
+			log.WarnWithContext(ctx, "fail to get the head block", zap.String("chain", chain), zap.Error(err))
+		} else {
+			for _, subscription := range subscriptions {
+				checkpoint, err := store.GetCheckpoint(ctx, chain, subscription)
+				if err != nil {
+					if !errors.Is(err, ErrCheckpointNotFound) {
+						log.WarnWithContext(ctx, "fail to get the checkpoint",
+							zap.String("chain", chain), zap.String("subscription", subscription), zap.Error(err))
+						continue
+					}
+					// a subscription without logs has no checkpoint but its scanned block
+					if scannedBlock(chain, subscription) == 0 {
+						continue
+					}
+				}
+				ReportCheckpointLag(chain, subscription, head, checkpoint)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ServeMetrics serves the prometheus metrics at /metrics. It is disabled when the address is not set.
+func ServeMetrics(address string) error {
+	if address == "" {
+		log.Info("metrics server is disabled")
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	server := &http.Server{
+		Addr:              address,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return server.ListenAndServe()
+}
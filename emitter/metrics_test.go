@@ -0,0 +1,23 @@
+package emitter
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportCheckpointLagOfQuietSubscription(t *testing.T) {
+	ReportCheckpointLag("ethereum", "quiet", 120, 100)
+	assert.Equal(t, float64(20), testutil.ToFloat64(checkpointLagGauge.WithLabelValues("ethereum", "quiet")))
+
+	// the scanned block keeps the lag while the checkpoint stays
+	ReportScannedBlock("ethereum", "quiet", 118)
+	ReportCheckpointLag("ethereum", "quiet", 120, 100)
+	assert.Equal(t, float64(2), testutil.ToFloat64(checkpointLagGauge.WithLabelValues("ethereum", "quiet")))
+	assert.Equal(t, float64(100), testutil.ToFloat64(checkpointGauge.WithLabelValues("ethereum", "quiet")))
+
+	// the scanned block never goes back
+	ReportScannedBlock("ethereum", "quiet", 110)
+	assert.Equal(t, float64(118), testutil.ToFloat64(scannedBlockGauge.WithLabelValues("ethereum", "quiet")))
+}
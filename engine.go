@@ -1,6 +1,7 @@
 package indexer
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -66,3 +67,8 @@ func New(
 		blockchainQueryClient: blockchainQueryClient,
 	}
 }
+
+// ReadDataURI reads the data of an HTTPS or IPFS URI through the IPFS gateways of the engine
+func (e *IndexEngine) ReadDataURI(ctx context.Context, uri string) ([]byte, error) {
+	return ReadDataURI(ctx, e.ipfsGateways, uri)
+}
@@ -0,0 +1,112 @@
+package indexer
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// DefaultCurrencyPairs are the currency pairs of the exchange rates which are crawled
+// if `exchange_rate.currency_pairs` is not configured. EUR-USD converts the EUR stablecoins to USD.
+var DefaultCurrencyPairs = []string{"ETH-USD", "XTZ-USD", "ETH-EUR", "XTZ-EUR", "EUR-USD"}
+
+// DefaultBaseCurrencies maps the wrapped tokens and the stablecoins to the currencies of
+// their exchange rates. It can be extended by `exchange_rate.base_currencies`.
+var DefaultBaseCurrencies = map[string]string{
+	"WETH": "ETH",
+	"USDC": "USD",
+	"USDT": "USD",
+	"DAI":  "USD",
+	"EURC": "EUR",
+}
+
+// ExchangeRateCandle is the open, high, low and close prices of a currency pair in an interval
+type ExchangeRateCandle struct {
+	Timestamp    time.Time `json:"timestamp" bson:"timestamp"`
+	Open         float64   `json:"open" bson:"open"`
+	High         float64   `json:"high" bson:"high"`
+	Low          float64   `json:"low" bson:"low"`
+	Close        float64   `json:"close" bson:"close"`
+	CurrencyPair string    `json:"currencyPair" bson:"currencyPair"`
+}
+
+// ExchangeRateGap is a time range without the exchange rates of a currency pair
+type ExchangeRateGap struct {
+	CurrencyPair string    `json:"currencyPair"`
+	From         time.Time `json:"from"`
+	To           time.Time `json:"to"`
+}
+
+// CurrencyPairs returns the currency pairs of the exchange rates which are crawled
+func CurrencyPairs() []string {
+	pairs := viper.GetStringSlice("exchange_rate.currency_pairs")
+	if len(pairs) == 0 {
+		return DefaultCurrencyPairs
+	}
+
+	result := make([]string, 0, len(pairs))
+	for _, p := range pairs {
+		result = append(result, strings.ToUpper(p))
+	}
+	return result
+}
+
+// IsSupportedCurrencyPair returns whether the exchange rates of a currency pair are crawled
+func IsSupportedCurrencyPair(currencyPair string) bool {
+	return slices.Contains(CurrencyPairs(), currencyPair)
+}
+
+// BaseCurrency returns the currency of the exchange rates of a currency
+func BaseCurrency(currency string) string {
+	currency = strings.ToUpper(currency)
+
+	// the keys of viper maps are lower case
+	if base, ok := viper.GetStringMapString("exchange_rate.base_currencies")[strings.ToLower(currency)]; ok {
+		return strings.ToUpper(base)
+	}
+
+	if base, ok := DefaultBaseCurrencies[currency]; ok {
+		return base
+	}
+	return currency
+}
+
+// ExchangeRateCurrencyPair returns the currency pair of the exchange rates from a currency to a
+// quote currency. It returns an empty string if the currency is pegged to the quote currency.
+func ExchangeRateCurrencyPair(currency, quote string) string {
+	base := BaseCurrency(currency)
+	quote = strings.ToUpper(quote)
+	if base == quote {
+		return ""
+	}
+
+	return base + "-" + quote
+}
+
+// exchangeRateSeriesBin returns the unit and the bin size of $dateTrunc which group the exchange
+// rates by an interval. The interval is in the largest unit which divides it, so that the
+// candles of the days and the weeks are aligned to the calendar rather than to the epoch.
+func exchangeRateSeriesBin(interval time.Duration) (string, int64, error) {
+	units := []struct {
+		name     string
+		duration time.Duration
+	}{
+		{"week", 7 * 24 * time.Hour},
+		{"day", 24 * time.Hour},
+		{"hour", time.Hour},
+		{"minute", time.Minute},
+	}
+
+	if interval > 0 {
+		for _, u := range units {
+			if interval%u.duration == 0 {
+				return u.name, int64(interval / u.duration), nil
+			}
+		}
+	}
+
+	return "", 0, fmt.Errorf("invalid interval: %s", interval)
+}
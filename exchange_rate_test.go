@@ -0,0 +1,55 @@
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCurrencyPairs(t *testing.T) {
+	viper.Set("exchange_rate.currency_pairs", nil)
+	assert.Equal(t, DefaultCurrencyPairs, CurrencyPairs())
+	assert.True(t, IsSupportedCurrencyPair("ETH-EUR"))
+
+	viper.Set("exchange_rate.currency_pairs", []string{"eth-usd"})
+	defer viper.Set("exchange_rate.currency_pairs", nil)
+	assert.Equal(t, []string{"ETH-USD"}, CurrencyPairs())
+	assert.False(t, IsSupportedCurrencyPair("XTZ-USD"))
+}
+
+func TestExchangeRateCurrencyPair(t *testing.T) {
+	viper.Set("exchange_rate.base_currencies", map[string]string{"wxtz": "XTZ"})
+	defer viper.Set("exchange_rate.base_currencies", nil)
+
+	assert.Equal(t, "ETH-USD", ExchangeRateCurrencyPair("WETH", "USD"))
+	assert.Equal(t, "XTZ-EUR", ExchangeRateCurrencyPair("wXTZ", "EUR"))
+	assert.Equal(t, "USD-EUR", ExchangeRateCurrencyPair("USDC", "EUR"))
+	assert.Equal(t, "", ExchangeRateCurrencyPair("USDC", "USD"))
+	assert.Equal(t, "", ExchangeRateCurrencyPair("ETH", "eth"))
+}
+
+func TestExchangeRateSeriesBin(t *testing.T) {
+	for interval, expected := range map[time.Duration]struct {
+		unit    string
+		binSize int64
+	}{
+		15 * time.Minute:    {"minute", 15},
+		90 * time.Minute:    {"minute", 90},
+		4 * time.Hour:       {"hour", 4},
+		24 * time.Hour:      {"day", 1},
+		7 * 24 * time.Hour:  {"week", 1},
+		14 * 24 * time.Hour: {"week", 2},
+	} {
+		unit, binSize, err := exchangeRateSeriesBin(interval)
+		assert.NoError(t, err)
+		assert.Equal(t, expected.unit, unit, interval)
+		assert.Equal(t, expected.binSize, binSize, interval)
+	}
+
+	_, _, err := exchangeRateSeriesBin(90 * time.Second)
+	assert.Error(t, err)
+	_, _, err = exchangeRateSeriesBin(0)
+	assert.Error(t, err)
+}
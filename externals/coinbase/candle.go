@@ -25,6 +25,8 @@ type HistoricalExchangeRate struct {
 	Open         float64   `json:"open"`
 	Close        float64   `json:"close"`
 	CurrencyPair string    `json:"currencyPair"`
+	// Granularity is the length of the candle in seconds. Zero is the default crawl granularity.
+	Granularity int64 `json:"granularity,omitempty"`
 }
 
 func (c *HistoricalExchangeRate) Scan(
@@ -0,0 +1,59 @@
+package coingecko
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	getMarketChartRangeEndpoint = "/coins/%s/market_chart/range"
+	pricePointLength            = 2
+)
+
+type Price struct {
+	Time  time.Time `json:"time"`
+	Price float64   `json:"price"`
+}
+
+type marketChart struct {
+	Prices [][]float64 `json:"prices"`
+}
+
+// GetMarketChartRange returns the prices of a coin (e.g. ethereum) in a vs currency from and to
+// unix times. The granularity is decided by CoinGecko from the length of the range.
+func (c *Client) GetMarketChartRange(
+	ctx context.Context,
+	coinID string,
+	vsCurrency string,
+	from int64,
+	to int64,
+) ([]Price, error) {
+	queryParams := map[string]string{
+		"vs_currency": strings.ToLower(vsCurrency),
+		"from":        strconv.FormatInt(from, 10),
+		"to":          strconv.FormatInt(to, 10),
+	}
+
+	var chart marketChart
+	if err := c.MakeRequest(ctx, fmt.Sprintf(getMarketChartRangeEndpoint, coinID), queryParams, &chart); err != nil {
+		return nil, err
+	}
+
+	prices := make([]Price, 0, len(chart.Prices))
+	for _, p := range chart.Prices {
+		if len(p) < pricePointLength {
+			return nil, errors.New("incomplete price data")
+		}
+
+		prices = append(prices, Price{
+			Time:  time.UnixMilli(int64(p[0])).UTC(),
+			Price: p[1],
+		})
+	}
+
+	return prices, nil
+}
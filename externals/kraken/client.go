@@ -0,0 +1,85 @@
+package kraken
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	baseURL        = "https://api.kraken.com"
+	requestTimeout = 10 * time.Second
+)
+
+type Client struct {
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+func NewClient() *Client {
+	return &Client{
+		BaseURL:    baseURL,
+		HTTPClient: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// Response is the envelope of the Kraken public API responses
+type Response struct {
+	Error  []string        `json:"error"`
+	Result json.RawMessage `json:"result"`
+}
+
+func (c *Client) MakeRequest(
+	ctx context.Context,
+	endpoint string,
+	queryParams map[string]string, result any) error {
+	query := url.Values{}
+	for key, value := range queryParams {
+		query.Set(key, value)
+	}
+	reqURL := fmt.Sprintf("%s%s?%s", c.BaseURL, endpoint, query.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode < http.StatusOK ||
+		resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("request failed with status %s: %s",
+			resp.Status, respBody)
+	}
+
+	var r Response
+	if err := json.Unmarshal(respBody, &r); err != nil {
+		return fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+
+	if len(r.Error) > 0 {
+		return fmt.Errorf("request failed: %s", strings.Join(r.Error, ", "))
+	}
+
+	if err := json.Unmarshal(r.Result, result); err != nil {
+		return fmt.Errorf("failed to unmarshal response result: %w", err)
+	}
+
+	return nil
+}
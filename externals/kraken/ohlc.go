@@ -0,0 +1,108 @@
+package kraken
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	getOHLCEndpoint = "/0/public/OHLC"
+	ohlcLength      = 8
+	ohlcTimeIndex   = 0
+	ohlcOpenIndex   = 1
+	ohlcHighIndex   = 2
+	ohlcLowIndex    = 3
+	ohlcCloseIndex  = 4
+)
+
+type OHLC struct {
+	Time  time.Time `json:"time"`
+	Open  float64   `json:"open"`
+	High  float64   `json:"high"`
+	Low   float64   `json:"low"`
+	Close float64   `json:"close"`
+}
+
+func parsePrice(v interface{}) (float64, error) {
+	s, ok := v.(string)
+	if !ok {
+		return 0, errors.New("failed to parse price")
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+func (o *OHLC) Scan(ohlc []interface{}) error {
+	unixTime, ok := ohlc[ohlcTimeIndex].(float64)
+	if !ok {
+		return errors.New("failed to parse unix time")
+	}
+	o.Time = time.Unix(int64(unixTime), 0).UTC()
+
+	var err error
+	if o.Open, err = parsePrice(ohlc[ohlcOpenIndex]); err != nil {
+		return err
+	}
+	if o.High, err = parsePrice(ohlc[ohlcHighIndex]); err != nil {
+		return err
+	}
+	if o.Low, err = parsePrice(ohlc[ohlcLowIndex]); err != nil {
+		return err
+	}
+	if o.Close, err = parsePrice(ohlc[ohlcCloseIndex]); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetOHLC returns the OHLC data of a pair (e.g. ETHUSD) since a unix time. The interval is in
+// minutes. Kraken only returns the latest 720 entries of an interval.
+func (c *Client) GetOHLC(
+	ctx context.Context,
+	pair string,
+	interval int64,
+	since int64,
+) ([]OHLC, error) {
+	queryParams := map[string]string{
+		"pair":     pair,
+		"interval": strconv.FormatInt(interval, 10),
+		"since":    strconv.FormatInt(since, 10),
+	}
+
+	var result map[string]json.RawMessage
+	if err := c.MakeRequest(ctx, getOHLCEndpoint, queryParams, &result); err != nil {
+		return nil, err
+	}
+
+	var data []OHLC
+	for key, raw := range result {
+		// the result has the OHLC data keyed by the pair name and the id of the last entry
+		if key == "last" {
+			continue
+		}
+
+		var rawData [][]interface{}
+		if err := json.Unmarshal(raw, &rawData); err != nil {
+			return nil, err
+		}
+
+		for _, entry := range rawData {
+			if len(entry) < ohlcLength {
+				return nil, fmt.Errorf("incomplete ohlc data")
+			}
+
+			var o OHLC
+			if err := o.Scan(entry); err != nil {
+				return nil, err
+			}
+
+			data = append(data, o)
+		}
+	}
+
+	return data, nil
+}
@@ -0,0 +1,212 @@
+package indexer
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	SalesAnalyticsScopeCollection = "collection"
+	SalesAnalyticsScopeArtist     = "artist"
+	SalesAnalyticsScopeContract   = "contract"
+
+	SalesAnalyticsIntervalDay  = "day"
+	SalesAnalyticsIntervalWeek = "week"
+
+	DefaultSalesAnalyticsLimit = 10
+	MaxSalesAnalyticsLimit     = 100
+)
+
+// SalesAnalyticsFilter selects the sales of a collection, an artist or a contract
+type SalesAnalyticsFilter struct {
+	Scope       string
+	ID          string
+	Interval    string
+	Marketplace string
+	From        *time.Time
+	To          *time.Time
+	// Limit is the number of the top buyers and sellers
+	Limit int64
+}
+
+// SalesVolume is the USD volume of the sales in a period
+type SalesVolume struct {
+	Timestamp      time.Time `json:"timestamp" bson:"timestamp"`
+	Sales          int64     `json:"sales" bson:"sales"`
+	VolumeUSD      float64   `json:"volumeUSD" bson:"volumeUSD"`
+	MedianPriceUSD float64   `json:"medianPriceUSD" bson:"medianPriceUSD"`
+	FloorPriceUSD  float64   `json:"floorPriceUSD" bson:"floorPriceUSD"`
+}
+
+// SalesParticipant is the USD volume that an address bought or sold
+type SalesParticipant struct {
+	Address   string  `json:"address" bson:"address"`
+	Sales     int64   `json:"sales" bson:"sales"`
+	VolumeUSD float64 `json:"volumeUSD" bson:"volumeUSD"`
+}
+
+type SalesAnalytics struct {
+	Volumes    []SalesVolume      `json:"volumes" bson:"volumes"`
+	TopBuyers  []SalesParticipant `json:"topBuyers" bson:"topBuyers"`
+	TopSellers []SalesParticipant `json:"topSellers" bson:"topSellers"`
+}
+
+// IsSupportedSalesAnalyticsInterval returns whether the volumes can be grouped by an interval
+func IsSupportedSalesAnalyticsInterval(interval string) bool {
+	return interval == SalesAnalyticsIntervalDay || interval == SalesAnalyticsIntervalWeek
+}
+
+// salesAnalyticsLimit returns the number of the top buyers and sellers, which is the default
+// limit if it is not set and is capped by MaxSalesAnalyticsLimit
+func salesAnalyticsLimit(limit int64) int64 {
+	if limit <= 0 {
+		return DefaultSalesAnalyticsLimit
+	}
+	return min(limit, MaxSalesAnalyticsLimit)
+}
+
+// emptySalesAnalytics returns analytics without sales which are encoded to empty lists
+func emptySalesAnalytics() SalesAnalytics {
+	return SalesAnalytics{
+		Volumes:    []SalesVolume{},
+		TopBuyers:  []SalesParticipant{},
+		TopSellers: []SalesParticipant{},
+	}
+}
+
+// saleCurrencies returns the currencies of the sale values in a stable order
+func saleCurrencies() []string {
+	currencies := make([]string, 0, len(SaleCurrencyDecimals))
+	for currency := range SaleCurrencyDecimals {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+	return currencies
+}
+
+// salesAnalyticsPipeline aggregates the matched sales to the volumes of the periods and the top
+// buyers and sellers. The sale values are converted to USD by the latest exchange rates at the sale
+// time, or by the first exchange rates of the currency pairs if the sales are earlier than them.
+// The stablecoins are pegged to their currencies and the sales without exchange rates are skipped.
+// The price of a bundle sale is split evenly to its tokens, so the median and the floor prices
+// are the prices of a token while the volume is the sum of the sale prices.
+func salesAnalyticsPipeline(match bson.M, interval string, limit int64, firstRates map[string]float64) []bson.M {
+	currencyPairs := bson.A{}
+	rates := bson.A{}
+	divisors := bson.A{}
+	for _, currency := range saleCurrencies() {
+		isCurrency := bson.M{"$eq": bson.A{"$currency", currency}}
+
+		divisors = append(divisors, bson.M{
+			"case": isCurrency,
+			"then": math.Pow10(SaleCurrencyDecimals[currency]),
+		})
+
+		pair := ExchangeRateCurrencyPair(currency, "USD")
+		if pair == "" {
+			rates = append(rates, bson.M{"case": isCurrency, "then": 1})
+			continue
+		}
+
+		currencyPairs = append(currencyPairs, bson.M{"case": isCurrency, "then": pair})
+
+		rate := interface{}(bson.M{"$first": "$exchangeRates.price"})
+		if firstRate, ok := firstRates[pair]; ok {
+			rate = bson.M{"$ifNull": bson.A{rate, firstRate}}
+		}
+		rates = append(rates, bson.M{"case": isCurrency, "then": rate})
+	}
+
+	return []bson.M{
+		{"$match": match},
+		{"$project": bson.M{
+			"timestamp": 1,
+			"price":     1,
+			"currency":  "$metadata.pricingCurrency",
+			"tokens":    bson.M{"$ifNull": bson.A{"$metadata.bundleTokenInfo", bson.A{}}},
+		}},
+		{"$addFields": bson.M{
+			"currencyPair": bson.M{"$switch": bson.M{"branches": currencyPairs, "default": ""}},
+		}},
+		{"$lookup": bson.M{
+			"from": historicalExchangeRatesCollectionName,
+			"let":  bson.M{"currencyPair": "$currencyPair", "timestamp": "$timestamp"},
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{"$expr": bson.M{"$and": bson.A{
+					bson.M{"$eq": bson.A{"$currencyPair", "$$currencyPair"}},
+					bson.M{"$lte": bson.A{"$timestamp", "$$timestamp"}},
+				}}}},
+				bson.M{"$sort": bson.M{"timestamp": -1}},
+				bson.M{"$limit": 1},
+				bson.M{"$project": bson.M{"_id": 0, "price": 1}},
+			},
+			"as": "exchangeRates",
+		}},
+		{"$addFields": bson.M{
+			"priceUSD": bson.M{"$multiply": bson.A{
+				bson.M{"$divide": bson.A{
+					bson.M{"$toDouble": "$price"},
+					bson.M{"$switch": bson.M{"branches": divisors, "default": nil}},
+				}},
+				bson.M{"$switch": bson.M{"branches": rates, "default": nil}},
+			}},
+		}},
+		{"$match": bson.M{"priceUSD": bson.M{"$ne": nil}}},
+		{"$addFields": bson.M{
+			"tokenPriceUSD": bson.M{"$divide": bson.A{"$priceUSD", bson.M{"$max": bson.A{bson.M{"$size": "$tokens"}, 1}}}},
+		}},
+		{"$facet": bson.M{
+			"volumes": bson.A{
+				bson.M{"$group": bson.M{
+					"_id": bson.M{"$dateTrunc": bson.M{
+						"date":        "$timestamp",
+						"unit":        interval,
+						"startOfWeek": "monday",
+						"timezone":    "UTC",
+					}},
+					"sales":          bson.M{"$sum": 1},
+					"volumeUSD":      bson.M{"$sum": "$priceUSD"},
+					"medianPriceUSD": bson.M{"$median": bson.M{"input": "$tokenPriceUSD", "method": "approximate"}},
+					"floorPriceUSD":  bson.M{"$min": "$tokenPriceUSD"},
+				}},
+				bson.M{"$sort": bson.M{"_id": 1}},
+				bson.M{"$project": bson.M{
+					"_id":            0,
+					"timestamp":      "$_id",
+					"sales":          1,
+					"volumeUSD":      1,
+					"medianPriceUSD": 1,
+					"floorPriceUSD":  1,
+				}},
+			},
+			"topBuyers":  salesParticipantsPipeline("buyerAddress", limit),
+			"topSellers": salesParticipantsPipeline("sellerAddress", limit),
+		}},
+	}
+}
+
+// salesParticipantsPipeline ranks the addresses of a field of the sale tokens by their USD volumes.
+// An address is counted once in a sale of many tokens.
+func salesParticipantsPipeline(field string, limit int64) bson.A {
+	return bson.A{
+		bson.M{"$match": bson.M{"tokens.0": bson.M{"$exists": true}}},
+		bson.M{"$project": bson.M{"tokens": 1, "tokenPriceUSD": 1}},
+		bson.M{"$unwind": "$tokens"},
+		bson.M{"$match": bson.M{"tokens." + field: bson.M{"$nin": bson.A{nil, ""}}}},
+		bson.M{"$group": bson.M{
+			"_id":       bson.M{"sale": "$_id", "address": "$tokens." + field},
+			"volumeUSD": bson.M{"$sum": "$tokenPriceUSD"},
+		}},
+		bson.M{"$group": bson.M{
+			"_id":       "$_id.address",
+			"sales":     bson.M{"$sum": 1},
+			"volumeUSD": bson.M{"$sum": "$volumeUSD"},
+		}},
+		bson.M{"$sort": bson.D{{Key: "volumeUSD", Value: -1}, {Key: "_id", Value: 1}}},
+		bson.M{"$limit": limit},
+		bson.M{"$project": bson.M{"_id": 0, "address": "$_id", "sales": 1, "volumeUSD": 1}},
+	}
+}
@@ -0,0 +1,21 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSalesAnalyticsLimit(t *testing.T) {
+	assert.Equal(t, int64(DefaultSalesAnalyticsLimit), salesAnalyticsLimit(0))
+	assert.Equal(t, int64(DefaultSalesAnalyticsLimit), salesAnalyticsLimit(-1))
+	assert.Equal(t, int64(20), salesAnalyticsLimit(20))
+	assert.Equal(t, int64(MaxSalesAnalyticsLimit), salesAnalyticsLimit(100000))
+}
+
+func TestIsSupportedSalesAnalyticsInterval(t *testing.T) {
+	assert.True(t, IsSupportedSalesAnalyticsInterval(SalesAnalyticsIntervalDay))
+	assert.True(t, IsSupportedSalesAnalyticsInterval(SalesAnalyticsIntervalWeek))
+	assert.False(t, IsSupportedSalesAnalyticsInterval("year"))
+	assert.False(t, IsSupportedSalesAnalyticsInterval(""))
+}
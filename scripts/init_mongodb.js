@@ -17,16 +17,9 @@ if (!db.getCollectionNames().includes('collection_assets')) {
   db.createCollection('collection_assets', {});
 }
 
-// Collection: sales_time_series
-if (!db.getCollectionNames().includes('sales_time_series')) {
-  db.createCollection('sales_time_series', {
-    timeseries: {
-      timeField: 'timestamp',
-      metaField: 'metadata',
-      granularity: 'seconds',
-      bucketMaxSpanSeconds: 3600,
-    },
-  });
+// Collection: sales
+if (!db.getCollectionNames().includes('sales')) {
+  db.createCollection('sales', {});
 }
 
 // Collection: accounts
@@ -127,6 +120,10 @@ db.getCollection('historical_exchange_rates').createIndex(
   { timestamp: 1, currencyPair: 1 },
   { name: 'timestamp_1_currencyPair_1', unique: true }
 );
+db.getCollection('historical_exchange_rates').createIndex(
+  { currencyPair: 1, timestamp: 1 },
+  { name: 'currencyPair_1_timestamp_1' }
+);
 
 // Indexes for collection_assets
 db.getCollection('collection_assets').createIndex(
@@ -146,36 +143,41 @@ db.getCollection('collection_assets').createIndex(
   { name: 'tokenIndexID_1' }
 );
 
-// Indexes for sales_time_series
-db.getCollection('sales_time_series').createIndex(
+// Indexes for sales
+db.getCollection('sales').createIndex(
+  { 'metadata.saleID': 1 },
+  { name: 'metadata.saleID_1', unique: true }
+);
+db.getCollection('sales').createIndex({ timestamp: 1 }, { name: 'timestamp_1' });
+db.getCollection('sales').createIndex(
   { 'metadata.blockchain': 1 },
   { name: 'metadata.blockchain_1' }
 );
-db.getCollection('sales_time_series').createIndex(
+db.getCollection('sales').createIndex(
   { 'metadata.bundleTokenInfo.contractAddress': 1 },
   { name: 'metadata.bundleTokenInfo.contractAddress_1' }
 );
-db.getCollection('sales_time_series').createIndex(
+db.getCollection('sales').createIndex(
   { 'metadata.bundleTokenInfo.tokenID': 1 },
   { name: 'metadata.bundleTokenInfo.tokenID_1' }
 );
-db.getCollection('sales_time_series').createIndex(
+db.getCollection('sales').createIndex(
   { 'metadata.bundleTokenInfo.buyerAddress': 1 },
   { name: 'metadata.bundleTokenInfo.buyerAddress_1' }
 );
-db.getCollection('sales_time_series').createIndex(
+db.getCollection('sales').createIndex(
   { 'metadata.bundleTokenInfo.sellerAddress': 1 },
   { name: 'metadata.bundleTokenInfo.sellerAddress_1' }
 );
-db.getCollection('sales_time_series').createIndex(
+db.getCollection('sales').createIndex(
   { 'metadata.marketplace': 1 },
   { name: 'metadata.marketplace_1' }
 );
-db.getCollection('sales_time_series').createIndex(
+db.getCollection('sales').createIndex(
   { 'metadata.saleType': 1 },
   { name: 'metadata.saleType_1' }
 );
-db.getCollection('sales_time_series').createIndex(
+db.getCollection('sales').createIndex(
   { 'metadata.transactionIDs': 1 },
   { name: 'metadata.transactionIDs_1' }
 );
@@ -246,6 +248,28 @@ db.getCollection('assets').createIndex(
   { name: 'projectMetadata.latest.medium_1' }
 );
 db.getCollection('assets').createIndex({ id: 1 }, { name: 'id_1' });
+// the text index for SearchTokens. the language is none to keep the stop words
+// and the names without stemming.
+db.getCollection('assets').createIndex(
+  {
+    'projectMetadata.latest.title': 'text',
+    'projectMetadata.latest.artistName': 'text',
+    'projectMetadata.latest.artists.name': 'text',
+    'projectMetadata.latest.exhibitionTitle': 'text',
+    'projectMetadata.latest.description': 'text',
+  },
+  {
+    name: 'projectMetadata.latest_text',
+    default_language: 'none',
+    weights: {
+      'projectMetadata.latest.title': 10,
+      'projectMetadata.latest.artistName': 8,
+      'projectMetadata.latest.artists.name': 8,
+      'projectMetadata.latest.exhibitionTitle': 5,
+      'projectMetadata.latest.description': 1,
+    },
+  }
+);
 db.getCollection('assets').createIndex(
   { 'projectMetadata.latest.mimeType': 1 },
   { name: 'projectMetadata.latest.mimeType_1' }
@@ -418,3 +442,10 @@ db.getCollection('asset_static_preview_url').createIndex(
   { assetID: 1 },
   { name: 'assetID_1', unique: true }
 );
+
+// Indexes for ownership_changes. Subscribers only read the recent changes from the change
+// stream, so the changes expire after 7 days.
+db.getCollection('ownership_changes').createIndex(
+  { createdAt: 1 },
+  { name: 'createdAt_1', expireAfterSeconds: 604800 }
+);
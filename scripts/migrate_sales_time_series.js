@@ -0,0 +1,80 @@
+// Copies the sales of the sales_time_series collection into the sales collection.
+// The time series collection does not support unique indexes nor upserts, so the sales
+// are keyed by a deterministic saleID (see indexer.SaleID) in a regular collection.
+// Run it with mongosh after init_mongodb.js. It can be re-run safely.
+use('nft_indexer');
+
+const crypto = require('crypto');
+
+function saleID(blockchain, transactionIDs, saleIndex) {
+  const txIDs = [...transactionIDs].sort();
+  return crypto
+    .createHash('sha1')
+    .update(`${blockchain}|${txIDs.join(',')}|${saleIndex}`)
+    .digest('hex');
+}
+
+// the sale indexes of the transactions by the sold tokens, since the time series collection
+// may hold the same sale more than once
+const saleIndexes = {};
+
+function saleIndexOf(metadata, transactionIDs) {
+  if (metadata.saleIndex !== undefined) {
+    return String(metadata.saleIndex);
+  }
+
+  const tx = `${metadata.blockchain}|${[...transactionIDs].sort().join(',')}`;
+  const tokens = (metadata.bundleTokenInfo || [])
+    .map((token) => `${token.contractAddress || ''}-${token.tokenID}`)
+    .sort()
+    .join(',');
+
+  const indexes = (saleIndexes[tx] = saleIndexes[tx] || {});
+  if (indexes[tokens] === undefined) {
+    indexes[tokens] = String(Object.keys(indexes).length);
+  }
+  return indexes[tokens];
+}
+
+let batch = [];
+let migrated = 0;
+
+function flush() {
+  if (batch.length > 0) {
+    db.getCollection('sales').bulkWrite(batch, { ordered: true });
+    migrated += batch.length;
+    batch = [];
+  }
+}
+
+// the later records of a sale replace the earlier ones like WriteTimeSeriesData does
+db.getCollection('sales_time_series')
+  .find({})
+  .sort({ timestamp: 1 })
+  .forEach((doc) => {
+    const metadata = doc.metadata;
+    const transactionIDs = metadata.transactionIDs || [];
+
+    delete doc._id;
+    delete metadata.uniqueID;
+    metadata.saleID = saleID(
+      metadata.blockchain,
+      transactionIDs,
+      saleIndexOf(metadata, transactionIDs)
+    );
+
+    batch.push({
+      replaceOne: {
+        filter: { 'metadata.saleID': metadata.saleID },
+        replacement: doc,
+        upsert: true,
+      },
+    });
+
+    if (batch.length === 1000) {
+      flush();
+    }
+  });
+flush();
+
+print(`migrated ${migrated} sales`);
@@ -2,6 +2,8 @@ package sdk
 
 import (
 	"context"
+	"errors"
+	"io"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -81,6 +83,29 @@ func (i *GRPCClient) UpdateOwnerForFungibleToken(ctx context.Context, indexID st
 	return err
 }
 
+// UpdateFungibleTokenBalances applies the balance deltas of an event to the owners of a fungible token
+func (i *GRPCClient) UpdateFungibleTokenBalances(ctx context.Context, indexID, eventID string, deltas map[string]int64, activityTime time.Time) error {
+	_, err := i.client.UpdateFungibleTokenBalances(ctx, &pb.UpdateFungibleTokenBalancesRequest{
+		IndexID:      indexID,
+		EventID:      eventID,
+		Deltas:       deltas,
+		ActivityTime: activityTime.Format(time.RFC3339Nano),
+	})
+
+	return err
+}
+
+// RevertFungibleTokenBalances reverses the balance deltas of an event which were applied to a fungible token
+func (i *GRPCClient) RevertFungibleTokenBalances(ctx context.Context, indexID, eventID string, activityTime time.Time) error {
+	_, err := i.client.RevertFungibleTokenBalances(ctx, &pb.RevertFungibleTokenBalancesRequest{
+		IndexID:      indexID,
+		EventID:      eventID,
+		ActivityTime: activityTime.Format(time.RFC3339Nano),
+	})
+
+	return err
+}
+
 // IndexAccountTokens indexes account tokens
 func (i *GRPCClient) IndexAccountTokens(ctx context.Context, owner string, accountTokens []indexer.AccountToken) error {
 	_, err := i.client.IndexAccountTokens(ctx, &pb.IndexAccountTokensRequest{
@@ -289,3 +314,37 @@ func (i *GRPCClient) CheckAssetCreator(ctx context.Context, IDs []string, creato
 
 	return res.Result, nil
 }
+
+// PushOwnershipChange publishes an ownership change to the subscribers
+func (i *GRPCClient) PushOwnershipChange(ctx context.Context, change indexer.OwnershipChange) error {
+	_, err := i.client.PushOwnershipChange(ctx, i.mapper.MapIndexerOwnershipChangeToGrpcOwnershipChange(change))
+
+	return err
+}
+
+// SubscribeOwnershipChanges passes the ownership changes of the owners or the tokens to the handler
+// until the stream ends or the handler returns an error
+func (i *GRPCClient) SubscribeOwnershipChanges(ctx context.Context, owners, indexIDs []string, resumeToken string, handle func(indexer.OwnershipChange) error) error {
+	stream, err := i.client.SubscribeOwnershipChanges(ctx, &pb.SubscribeOwnershipChangesRequest{
+		Owners:      owners,
+		IndexIDs:    indexIDs,
+		ResumeToken: resumeToken,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		change, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		if err := handle(i.mapper.MapGrpcOwnershipChangeToIndexerOwnershipChange(change)); err != nil {
+			return err
+		}
+	}
+}
@@ -563,6 +563,30 @@ func (m *Mapper) MapTimeToGrpcTimestamp(time *time.Time) *timestamppb.Timestamp
 	return timestamppb.New(*time)
 }
 
+func (m *Mapper) MapIndexerOwnershipChangeToGrpcOwnershipChange(change indexer.OwnershipChange) *grpc.OwnershipChange {
+	return &grpc.OwnershipChange{
+		IndexID:     change.IndexID,
+		Type:        change.Type,
+		From:        change.From,
+		To:          change.To,
+		TxID:        change.TxID,
+		Timestamp:   timestamppb.New(change.Timestamp),
+		ResumeToken: change.ResumeToken,
+	}
+}
+
+func (m *Mapper) MapGrpcOwnershipChangeToIndexerOwnershipChange(change *grpc.OwnershipChange) indexer.OwnershipChange {
+	return indexer.OwnershipChange{
+		IndexID:     change.IndexID,
+		Type:        change.Type,
+		From:        change.From,
+		To:          change.To,
+		TxID:        change.TxID,
+		Timestamp:   change.Timestamp.AsTime(),
+		ResumeToken: change.ResumeToken,
+	}
+}
+
 func (m *Mapper) MapToJson(input map[string]interface{}) (string, error) {
 	b, err := json.Marshal(input)
 	if err != nil {
@@ -0,0 +1,97 @@
+package indexer
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	DefaultTokenSearchSize = 50
+	MaxTokenSearchSize     = 100
+
+	// tokenSearchFacetSize is the max number of the values of the artist and the collection facets
+	tokenSearchFacetSize = 20
+)
+
+// TokenSearchFilter selects the tokens of the assets which match a text. The tokens are
+// ranked by the relevance of the text. A text, artists or collection ids are required to
+// bound the matched assets, and the other filters narrow down the results.
+type TokenSearchFilter struct {
+	Text          string
+	Blockchains   []string
+	Mediums       []string
+	Artists       []string
+	CollectionIDs []string
+	Sources       []string
+	MintedFrom    *time.Time
+	MintedTo      *time.Time
+	// Cursor is the next cursor of the previous page
+	Cursor string
+	Size   int64
+}
+
+// SearchFacet is the number of the matched tokens of a facet value
+type SearchFacet struct {
+	Value string `json:"value" bson:"_id"`
+	Label string `json:"label,omitempty" bson:"label"`
+	Count int64  `json:"count" bson:"count"`
+}
+
+// TokenSearchFacets are the facet values of all the matched tokens
+type TokenSearchFacets struct {
+	Blockchains []SearchFacet `json:"blockchains" bson:"blockchains"`
+	Mediums     []SearchFacet `json:"mediums" bson:"mediums"`
+	Artists     []SearchFacet `json:"artists" bson:"artists"`
+	Collections []SearchFacet `json:"collections" bson:"collections"`
+}
+
+type TokenSearchResult struct {
+	Tokens     []DetailedTokenV2 `json:"tokens"`
+	Facets     TokenSearchFacets `json:"facets"`
+	Total      int64             `json:"total"`
+	NextCursor string            `json:"nextCursor,omitempty"`
+}
+
+// tokenSearchCursor is the position of the last token of a page in the relevance order
+type tokenSearchCursor struct {
+	Score   float64 `json:"s" bson:"score"`
+	IndexID string  `json:"id" bson:"indexID"`
+}
+
+// bounded returns whether the filter matches the assets by the text index or by the indexes of
+// the artists or the assets of the collections, so that a search does not scan all the assets
+func (f TokenSearchFilter) bounded() bool {
+	return f.Text != "" || len(f.Artists) > 0 || len(f.CollectionIDs) > 0
+}
+
+func encodeTokenSearchCursor(c tokenSearchCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeTokenSearchCursor(cursor string) (tokenSearchCursor, error) {
+	var c tokenSearchCursor
+
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return c, ErrInvalidSearchCursor
+	}
+
+	if err := json.Unmarshal(b, &c); err != nil || c.IndexID == "" {
+		return c, ErrInvalidSearchCursor
+	}
+
+	return c, nil
+}
+
+// match returns the filter of the tokens after the cursor in the order of
+// a descending score and an ascending index id
+func (c tokenSearchCursor) match() bson.M {
+	return bson.M{"$or": bson.A{
+		bson.M{"score": bson.M{"$lt": c.Score}},
+		bson.M{"score": c.Score, "indexID": bson.M{"$gt": c.IndexID}},
+	}}
+}
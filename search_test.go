@@ -0,0 +1,29 @@
+package indexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenSearchCursor(t *testing.T) {
+	c := tokenSearchCursor{Score: 12.5, IndexID: "eth-0x1-1"}
+
+	decoded, err := decodeTokenSearchCursor(encodeTokenSearchCursor(c))
+	assert.NoError(t, err)
+	assert.Equal(t, c, decoded)
+
+	_, err = decodeTokenSearchCursor("not a cursor")
+	assert.ErrorIs(t, err, ErrInvalidSearchCursor)
+
+	_, err = decodeTokenSearchCursor(encodeTokenSearchCursor(tokenSearchCursor{Score: 1}))
+	assert.ErrorIs(t, err, ErrInvalidSearchCursor)
+}
+
+func TestTokenSearchFilterBounded(t *testing.T) {
+	assert.True(t, TokenSearchFilter{Text: "sunset"}.bounded())
+	assert.True(t, TokenSearchFilter{Artists: []string{"artist"}}.bounded())
+	assert.True(t, TokenSearchFilter{CollectionIDs: []string{"collection"}}.bounded())
+	assert.False(t, TokenSearchFilter{}.bounded())
+	assert.False(t, TokenSearchFilter{Blockchains: []string{"ethereum"}, Mediums: []string{"image"}, Sources: []string{"feralfile"}}.bounded())
+}
@@ -0,0 +1,110 @@
+package indexer
+
+import (
+	"errors"
+	"fmt"
+
+	seriesRegistry "github.com/bitmark-inc/feralfile-exhibition-smart-contract/go-binding/series-registry"
+	goethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var ErrUnsupportedSeriesRegistryEvent = errors.New("unsupported series registry event")
+
+// SeriesRegistryEventSignatures are the signatures of the series registry events which are indexed
+var SeriesRegistryEventSignatures = []string{
+	SeriesRegistryEventRegisterSeriesSignature,
+	SeriesRegistryEventUpdateSeriesSignature,
+	SeriesRegistryEventDeleteSeriesSignature,
+	SeriesRegistryEventUpdateArtistAddressSignature,
+	SeriesRegistryEventOptInCollaborationSignature,
+	SeriesRegistryEventOptOutSeriesSignature,
+	SeriesRegistryEventAssignSeriesSignature,
+}
+
+// SeriesRegistryQuery returns the filter of the indexed series registry logs
+func SeriesRegistryQuery(contractAddress string) goethereum.FilterQuery {
+	topics := make([]common.Hash, 0, len(SeriesRegistryEventSignatures))
+	for _, signature := range SeriesRegistryEventSignatures {
+		topics = append(topics, common.HexToHash(signature))
+	}
+
+	return goethereum.FilterQuery{
+		Addresses: []common.Address{common.HexToAddress(contractAddress)},
+		Topics:    [][]common.Hash{topics},
+	}
+}
+
+// ParseSeriesRegistryLog returns the event type and the event data of a series registry log
+func ParseSeriesRegistryLog(contract *seriesRegistry.SeriesRegistry, eLog types.Log) (string, map[string]interface{}, error) {
+	if len(eLog.Topics) == 0 {
+		return "", nil, ErrUnsupportedSeriesRegistryEvent
+	}
+
+	switch eLog.Topics[0].Hex() {
+	case SeriesRegistryEventRegisterSeriesSignature:
+		ev, err := contract.ParseRegisterSeries(eLog)
+		if err != nil {
+			return "", nil, fmt.Errorf("fail to parse register series event: %w", err)
+		}
+		return "register_series", map[string]interface{}{
+			"series_id": ev.SeriesID.Text(10),
+		}, nil
+	case SeriesRegistryEventUpdateSeriesSignature:
+		ev, err := contract.ParseUpdateSeries(eLog)
+		if err != nil {
+			return "", nil, fmt.Errorf("fail to parse update series event: %w", err)
+		}
+		return "update_series", map[string]interface{}{
+			"series_id": ev.SeriesID.Text(10),
+		}, nil
+	case SeriesRegistryEventDeleteSeriesSignature:
+		ev, err := contract.ParseDeleteSeries(eLog)
+		if err != nil {
+			return "", nil, fmt.Errorf("fail to parse delete series event: %w", err)
+		}
+		return "delete_series", map[string]interface{}{
+			"series_id": ev.SeriesID.Text(10),
+		}, nil
+	case SeriesRegistryEventUpdateArtistAddressSignature:
+		ev, err := contract.ParseUpdateArtistAddress(eLog)
+		if err != nil {
+			return "", nil, fmt.Errorf("fail to parse update artist address event: %w", err)
+		}
+		return "update_artist_address", map[string]interface{}{
+			"old_address": ev.OldAddress.Hex(),
+			"new_address": ev.NewAddress.Hex(),
+		}, nil
+	case SeriesRegistryEventOptInCollaborationSignature:
+		ev, err := contract.ParseOptInCollaboration(eLog)
+		if err != nil {
+			return "", nil, fmt.Errorf("fail to parse opt in collaboration event: %w", err)
+		}
+		return "opt_in_collaboration", map[string]interface{}{
+			"series_id":            ev.SeriesID.Text(10),
+			"collaborator_address": ev.CollaboratorAddress.Hex(),
+		}, nil
+	case SeriesRegistryEventOptOutSeriesSignature:
+		ev, err := contract.ParseOptOutSeries(eLog)
+		if err != nil {
+			return "", nil, fmt.Errorf("fail to parse opt out series event: %w", err)
+		}
+		return "opt_out_series", map[string]interface{}{
+			"series_id":      ev.SeriesID.Text(10),
+			"artist_address": ev.ArtistAddress.Hex(),
+		}, nil
+	case SeriesRegistryEventAssignSeriesSignature:
+		ev, err := contract.ParseAssignSeries(eLog)
+		if err != nil {
+			return "", nil, fmt.Errorf("fail to parse assign series event: %w", err)
+		}
+		return "assign_series", map[string]interface{}{
+			"series_id":   ev.SeriesID.Text(10),
+			"old_address": ev.AssignerAddress.Hex(),
+			"new_address": ev.AssigneeAddress.Hex(),
+		}, nil
+	default:
+		return "", nil, ErrUnsupportedSeriesRegistryEvent
+	}
+}
@@ -13,13 +13,16 @@ type Artist struct {
 }
 
 type Asset struct {
-	IndexID                   string           `json:"indexID"`
-	ThumbnailID               string           `json:"thumbnailID"`
-	LastRefreshedTime         *time.Time       `json:"lastRefreshedTime,omitempty"`
-	Attributes                *AssetAttributes `json:"attributes,omitempty"`
-	Metadata                  *AssetMetadata   `json:"metadata"`
-	StaticPreviewURLLandscape *string          `json:"staticPreviewURLLandscape,omitempty"`
-	StaticPreviewURLPortrait  *string          `json:"staticPreviewURLPortrait,omitempty"`
+	IndexID                   string            `json:"indexID"`
+	ThumbnailID               string            `json:"thumbnailID"`
+	LastRefreshedTime         *time.Time        `json:"lastRefreshedTime,omitempty"`
+	Attributes                *AssetAttributes  `json:"attributes,omitempty"`
+	Metadata                  *AssetMetadata    `json:"metadata"`
+	StaticPreviewURLLandscape *string           `json:"staticPreviewURLLandscape,omitempty"`
+	StaticPreviewURLPortrait  *string           `json:"staticPreviewURLPortrait,omitempty"`
+	Placeholder               *ImagePlaceholder `json:"placeholder,omitempty"`
+	PreviewClipID             *string           `json:"previewClipID,omitempty"`
+	DurationSeconds           *float64          `json:"durationSeconds,omitempty"`
 }
 
 type AssetAttributes struct {
@@ -89,6 +92,14 @@ type Identity struct {
 	Name          string `json:"name"`
 }
 
+type ImagePlaceholder struct {
+	Width          int      `json:"width"`
+	Height         int      `json:"height"`
+	AspectRatio    float64  `json:"aspectRatio"`
+	Blurhash       string   `json:"blurhash"`
+	DominantColors []string `json:"dominantColors"`
+}
+
 type Mutation struct {
 }
 
@@ -97,6 +108,16 @@ type Owner struct {
 	Balance int64  `json:"balance"`
 }
 
+type OwnershipChange struct {
+	IndexID     string     `json:"indexID"`
+	Type        string     `json:"type"`
+	From        string     `json:"from"`
+	To          string     `json:"to"`
+	TxID        string     `json:"txID"`
+	Timestamp   *time.Time `json:"timestamp,omitempty"`
+	ResumeToken string     `json:"resumeToken"`
+}
+
 type ProjectMetadata struct {
 	ArtistID            string    `json:"artistID"`
 	ArtistName          string    `json:"artistName"`
@@ -133,6 +154,35 @@ type Provenance struct {
 type Query struct {
 }
 
+type SalesAnalytics struct {
+	Volumes    []*SalesVolume      `json:"volumes"`
+	TopBuyers  []*SalesParticipant `json:"topBuyers"`
+	TopSellers []*SalesParticipant `json:"topSellers"`
+}
+
+type SalesParticipant struct {
+	Address   string  `json:"address"`
+	Sales     int64   `json:"sales"`
+	VolumeUsd float64 `json:"volumeUSD"`
+}
+
+type SalesVolume struct {
+	Timestamp      time.Time `json:"timestamp"`
+	Sales          int64     `json:"sales"`
+	VolumeUsd      float64   `json:"volumeUSD"`
+	MedianPriceUsd float64   `json:"medianPriceUSD"`
+	FloorPriceUsd  float64   `json:"floorPriceUSD"`
+}
+
+type SearchFacet struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+	Count int64  `json:"count"`
+}
+
+type Subscription struct {
+}
+
 type TezosContractAddresses struct {
 	Fa2 []string `json:"FA2,omitempty"`
 }
@@ -161,6 +211,20 @@ type Token struct {
 	Asset             *Asset           `json:"asset"`
 }
 
+type TokenSearchFacets struct {
+	Blockchains []*SearchFacet `json:"blockchains"`
+	Mediums     []*SearchFacet `json:"mediums"`
+	Artists     []*SearchFacet `json:"artists"`
+	Collections []*SearchFacet `json:"collections"`
+}
+
+type TokenSearchResult struct {
+	Tokens     []*Token           `json:"tokens"`
+	Facets     *TokenSearchFacets `json:"facets"`
+	Total      int64              `json:"total"`
+	NextCursor *string            `json:"nextCursor,omitempty"`
+}
+
 type VersionedProjectMetadata struct {
 	Origin *ProjectMetadata `json:"origin"`
 	Latest *ProjectMetadata `json:"latest"`
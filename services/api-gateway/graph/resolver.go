@@ -89,6 +89,26 @@ func (r *Resolver) mapGraphQLAsset(a indexer.AssetV2) *model.Asset {
 		}
 	}
 
+	var placeholder *model.ImagePlaceholder
+	if p := a.Placeholder; p != nil {
+		placeholder = &model.ImagePlaceholder{
+			Width:          p.Width,
+			Height:         p.Height,
+			AspectRatio:    p.AspectRatio,
+			Blurhash:       p.Blurhash,
+			DominantColors: p.DominantColors,
+		}
+	}
+
+	var previewClipID *string
+	var durationSeconds *float64
+	if a.PreviewClipID != "" {
+		previewClipID = &a.PreviewClipID
+	}
+	if a.DurationSeconds > 0 {
+		durationSeconds = &a.DurationSeconds
+	}
+
 	return &model.Asset{
 		IndexID:           a.IndexID,
 		ThumbnailID:       a.ThumbnailID,
@@ -102,6 +122,9 @@ func (r *Resolver) mapGraphQLAsset(a indexer.AssetV2) *model.Asset {
 		},
 		StaticPreviewURLLandscape: a.StaticPreviewURLLandscape,
 		StaticPreviewURLPortrait:  a.StaticPreviewURLPortrait,
+		Placeholder:               placeholder,
+		PreviewClipID:             previewClipID,
+		DurationSeconds:           durationSeconds,
 	}
 }
 
@@ -175,6 +198,18 @@ func (r *Resolver) mapGraphQLBaseTokenInfo(t indexer.BaseTokenInfo) *model.BaseT
 	}
 }
 
+func (r *Resolver) mapGraphQLOwnershipChange(c indexer.OwnershipChange) *model.OwnershipChange {
+	return &model.OwnershipChange{
+		IndexID:     c.IndexID,
+		Type:        c.Type,
+		From:        c.From,
+		To:          c.To,
+		TxID:        c.TxID,
+		Timestamp:   &c.Timestamp,
+		ResumeToken: c.ResumeToken,
+	}
+}
+
 func (r *Resolver) mapGraphQLCollection(c indexer.Collection) *model.Collection {
 	return &model.Collection{
 		ID:          c.ID,
@@ -201,3 +236,70 @@ func (r *Resolver) mapGraphQLCollection(c indexer.Collection) *model.Collection
 		CreatedAt:       &c.CreatedAt,
 	}
 }
+
+func (r *Resolver) mapGraphQLSalesParticipants(participants []indexer.SalesParticipant) []*model.SalesParticipant {
+	result := make([]*model.SalesParticipant, 0, len(participants))
+	for _, p := range participants {
+		result = append(result, &model.SalesParticipant{
+			Address:   p.Address,
+			Sales:     p.Sales,
+			VolumeUsd: p.VolumeUSD,
+		})
+	}
+	return result
+}
+
+func (r *Resolver) mapGraphQLSalesAnalytics(a indexer.SalesAnalytics) *model.SalesAnalytics {
+	volumes := make([]*model.SalesVolume, 0, len(a.Volumes))
+	for _, v := range a.Volumes {
+		volumes = append(volumes, &model.SalesVolume{
+			Timestamp:      v.Timestamp,
+			Sales:          v.Sales,
+			VolumeUsd:      v.VolumeUSD,
+			MedianPriceUsd: v.MedianPriceUSD,
+			FloorPriceUsd:  v.FloorPriceUSD,
+		})
+	}
+
+	return &model.SalesAnalytics{
+		Volumes:    volumes,
+		TopBuyers:  r.mapGraphQLSalesParticipants(a.TopBuyers),
+		TopSellers: r.mapGraphQLSalesParticipants(a.TopSellers),
+	}
+}
+
+func (r *Resolver) mapGraphQLSearchFacets(facets []indexer.SearchFacet) []*model.SearchFacet {
+	result := make([]*model.SearchFacet, 0, len(facets))
+	for _, f := range facets {
+		result = append(result, &model.SearchFacet{
+			Value: f.Value,
+			Label: f.Label,
+			Count: f.Count,
+		})
+	}
+	return result
+}
+
+func (r *Resolver) mapGraphQLTokenSearchResult(s indexer.TokenSearchResult) *model.TokenSearchResult {
+	tokens := make([]*model.Token, 0, len(s.Tokens))
+	for _, t := range s.Tokens {
+		tokens = append(tokens, r.mapGraphQLToken(t))
+	}
+
+	var nextCursor *string
+	if s.NextCursor != "" {
+		nextCursor = &s.NextCursor
+	}
+
+	return &model.TokenSearchResult{
+		Tokens: tokens,
+		Facets: &model.TokenSearchFacets{
+			Blockchains: r.mapGraphQLSearchFacets(s.Facets.Blockchains),
+			Mediums:     r.mapGraphQLSearchFacets(s.Facets.Mediums),
+			Artists:     r.mapGraphQLSearchFacets(s.Facets.Artists),
+			Collections: r.mapGraphQLSearchFacets(s.Facets.Collections),
+		},
+		Total:      s.Total,
+		NextCursor: nextCursor,
+	}
+}
@@ -6,10 +6,13 @@ package graph
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	log "github.com/bitmark-inc/autonomy-logger"
 	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
 
 	indexer "github.com/feral-file/ff-indexer"
 	indexerWorker "github.com/feral-file/ff-indexer/background/worker"
@@ -157,11 +160,89 @@ func (r *queryResolver) Collection(ctx context.Context, id string) (*model.Colle
 	return r.mapGraphQLCollection(*collectionInfo), nil
 }
 
+// SalesAnalytics is the resolver for the salesAnalytics field.
+func (r *queryResolver) SalesAnalytics(ctx context.Context, scope string, id string, interval string, marketplace string, from *time.Time, to *time.Time, limit int64) (*model.SalesAnalytics, error) {
+	if !indexer.IsSupportedSalesAnalyticsInterval(interval) {
+		return nil, fmt.Errorf("invalid interval: %s", interval)
+	}
+
+	if limit <= 0 || limit > indexer.MaxSalesAnalyticsLimit {
+		return nil, fmt.Errorf("limit must be between 1 and %d", indexer.MaxSalesAnalyticsLimit)
+	}
+
+	analytics, err := r.indexerStore.GetSalesAnalytics(ctx, indexer.SalesAnalyticsFilter{
+		Scope:       scope,
+		ID:          id,
+		Interval:    interval,
+		Marketplace: marketplace,
+		From:        from,
+		To:          to,
+		Limit:       limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.mapGraphQLSalesAnalytics(analytics), nil
+}
+
+// SearchTokens is the resolver for the searchTokens field.
+func (r *queryResolver) SearchTokens(ctx context.Context, text string, blockchains []string, mediums []string, artists []string, collectionIDs []string, sources []string, mintedFrom *time.Time, mintedTo *time.Time, cursor string, size int64) (*model.TokenSearchResult, error) {
+	result, err := r.indexerStore.SearchTokens(ctx, indexer.TokenSearchFilter{
+		Text:          text,
+		Blockchains:   blockchains,
+		Mediums:       mediums,
+		Artists:       artists,
+		CollectionIDs: collectionIDs,
+		Sources:       sources,
+		MintedFrom:    mintedFrom,
+		MintedTo:      mintedTo,
+		Cursor:        cursor,
+		Size:          size,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.mapGraphQLTokenSearchResult(result), nil
+}
+
+// OwnershipChanges is the resolver for the ownershipChanges field.
+func (r *subscriptionResolver) OwnershipChanges(ctx context.Context, owners []string, ids []string, resumeToken string) (<-chan *model.OwnershipChange, error) {
+	if len(owners) == 0 && len(ids) == 0 {
+		return nil, fmt.Errorf("either owners or ids is required")
+	}
+
+	changes := make(chan *model.OwnershipChange)
+	go func() {
+		defer close(changes)
+
+		err := r.indexerStore.WatchOwnershipChanges(ctx, owners, indexer.NormalizeIndexIDs(ids, false), resumeToken,
+			func(change indexer.OwnershipChange) error {
+				select {
+				case changes <- r.mapGraphQLOwnershipChange(change):
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			})
+		if err != nil && ctx.Err() == nil {
+			log.ErrorWithContext(ctx, errors.New("fail to watch ownership changes"), zap.Error(err))
+		}
+	}()
+
+	return changes, nil
+}
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
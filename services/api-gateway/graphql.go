@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/99designs/gqlgen/graphql"
@@ -22,6 +24,16 @@ func (s *Server) graphqlHandler(c *gin.Context) {
 	filteredHandler.ServeHTTP(c.Writer, c.Request)
 }
 
+// graphqlWebsocketHandler serves the subscriptions over websocket
+func (s *Server) graphqlWebsocketHandler(c *gin.Context) {
+	if !c.IsWebsocket() {
+		abortWithError(c, http.StatusBadRequest, "websocket is required", fmt.Errorf("not a websocket request"))
+		return
+	}
+
+	s.graphqlHandler(c)
+}
+
 // Defining the Playground handler
 func (s *Server) playgroundHandler(c *gin.Context) {
 	h := playground.Handler("Token", "/v2/graphql")
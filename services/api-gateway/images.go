@@ -0,0 +1,45 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	imageStore "github.com/feral-file/ff-indexer/services/image-indexer/store"
+	"github.com/feral-file/ff-indexer/traceutils"
+)
+
+// GetImage serves a variant of an image which is cached by the image indexer. The images of
+// Cloudflare are redirected to the delivery URLs of Cloudflare.
+func (s *Server) GetImage(c *gin.Context) {
+	traceutils.SetHandlerTag(c, "GetImage")
+
+	imageID := c.Param("image_id")
+	variant := c.Param("variant")
+
+	switch backend := s.imageBackend.(type) {
+	case imageStore.ImageVariantReader:
+		file, contentType, err := backend.GetImageVariant(c, imageID, variant)
+		if err != nil {
+			if errors.Is(err, imageStore.ErrImageNotFound) {
+				abortWithError(c, http.StatusNotFound, "image not found", err)
+				return
+			}
+			abortWithError(c, http.StatusInternalServerError, "fail to read image", err)
+			return
+		}
+		defer file.Close()
+
+		// the image ids are regenerated when the images are updated
+		c.Header("Cache-Control", "public, max-age=31536000, immutable")
+		c.Header("Content-Type", contentType)
+		c.Status(http.StatusOK)
+		_, _ = io.Copy(c.Writer, file)
+	case imageStore.ImageURLResolver:
+		c.Redirect(http.StatusFound, backend.ImageURL(imageID, variant))
+	default:
+		abortWithError(c, http.StatusNotFound, "image not found", errors.New("unsupported image backend"))
+	}
+}
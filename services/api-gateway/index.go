@@ -52,6 +52,19 @@ type NFTQueryParams struct {
 	SortBy        string `form:"sortBy"`
 }
 
+type NFTSearchQueryParams struct {
+	Text          string     `form:"text"`
+	Blockchains   []string   `form:"blockchains"`
+	Mediums       []string   `form:"mediums"`
+	Artists       []string   `form:"artists"`
+	CollectionIDs []string   `form:"collectionIDs"`
+	Sources       []string   `form:"sources"`
+	MintedFrom    *time.Time `form:"mintedFrom"`
+	MintedTo      *time.Time `form:"mintedTo"`
+	Cursor        string     `form:"cursor"`
+	Size          int64      `form:"size"`
+}
+
 type CollectionQueryParams struct {
 	// global
 	Offset int64 `form:"offset"`
@@ -66,6 +79,16 @@ type ExchangeRateQueryParams struct {
 	Timestamp    time.Time `form:"timestamp"`
 }
 
+// maxExchangeRateCandles is the max number of candles of an exchange rate series query
+const maxExchangeRateCandles = 1000
+
+type ExchangeRateSeriesQueryParams struct {
+	CurrencyPair string        `form:"currencyPair" binding:"required"`
+	From         time.Time     `form:"from"`
+	To           time.Time     `form:"to"`
+	Interval     time.Duration `form:"interval"`
+}
+
 // FIXME: remove this and merge with background / helpers
 func (s *Server) startIndexWorkflow(c context.Context, owner, blockchain string, workflowFunc interface{}) {
 	workflowContext := buildIndexNFTsContext(owner, blockchain)
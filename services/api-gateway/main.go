@@ -25,6 +25,7 @@ import (
 	"github.com/feral-file/ff-indexer/externals/objkt"
 	"github.com/feral-file/ff-indexer/externals/opensea"
 	tezosDomain "github.com/feral-file/ff-indexer/externals/tezos-domain"
+	imageStore "github.com/feral-file/ff-indexer/services/image-indexer/store"
 )
 
 func main() {
@@ -88,7 +89,12 @@ func main() {
 		managedblockchainquery.New(awsSession),
 	)
 
-	s := NewServer(cadenceClient, ensClient, tezosDomain, ethClient, indexerStore, cacheStore, engine, viper.GetString("server.api_token"), viper.GetString("server.admin_api_token"))
+	imageBackend, err := imageStore.NewImageBackend()
+	if err != nil {
+		log.Panic("fail to initiate image backend", zap.Error(err))
+	}
+
+	s := NewServer(cadenceClient, ensClient, tezosDomain, ethClient, indexerStore, cacheStore, engine, imageBackend, viper.GetString("server.api_token"), viper.GetString("server.admin_api_token"))
 	s.SetupRoute()
 	if err := s.Run(viper.GetString("server.port")); err != nil {
 		log.Panic("server interrupted", zap.Error(err))
@@ -172,6 +172,46 @@ func (s *Server) SearchNFTs(c *gin.Context) {
 	c.JSON(http.StatusOK, tokens)
 }
 
+// SearchNFTsV2 returns the NFTs which match a text in the order of the relevance, along with the
+// facets of all the matched NFTs
+func (s *Server) SearchNFTsV2(c *gin.Context) {
+	traceutils.SetHandlerTag(c, "SearchNFTsV2")
+
+	var reqParams = NFTSearchQueryParams{
+		Size: indexer.DefaultTokenSearchSize,
+	}
+
+	if err := c.BindQuery(&reqParams); err != nil {
+		abortWithError(c, http.StatusBadRequest, "invalid parameters", err)
+		return
+	}
+
+	filter := indexer.TokenSearchFilter{
+		Text:          strings.TrimSpace(reqParams.Text),
+		Blockchains:   reqParams.Blockchains,
+		Mediums:       reqParams.Mediums,
+		Artists:       reqParams.Artists,
+		CollectionIDs: reqParams.CollectionIDs,
+		Sources:       reqParams.Sources,
+		MintedFrom:    reqParams.MintedFrom,
+		MintedTo:      reqParams.MintedTo,
+		Cursor:        reqParams.Cursor,
+		Size:          reqParams.Size,
+	}
+
+	result, err := s.indexerStore.SearchTokens(c, filter)
+	if err != nil {
+		if errors.Is(err, indexer.ErrInvalidSearchCursor) || errors.Is(err, indexer.ErrUnboundedTokenSearch) {
+			abortWithError(c, http.StatusBadRequest, "invalid parameters", err)
+			return
+		}
+		abortWithError(c, http.StatusInternalServerError, "fail to search tokens from indexer store", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
 // fetchIdentity collects information from the blockchains and returns an identity object
 func (s *Server) fetchIdentity(c context.Context, accountNumber string) (*indexer.AccountIdentity, error) {
 	blockchain := utils.GetBlockchainByAddress(accountNumber)
@@ -598,7 +638,7 @@ func (s *Server) GetExchangeRate(c *gin.Context) {
 		return
 	}
 
-	if !indexer.SupportedCurrencyPairs[reqParams.CurrencyPair] {
+	if !indexer.IsSupportedCurrencyPair(reqParams.CurrencyPair) {
 		abortWithError(c, http.StatusBadRequest, "invalid parameters", fmt.Errorf("unsupported currency pair"))
 		return
 	}
@@ -614,3 +654,47 @@ func (s *Server) GetExchangeRate(c *gin.Context) {
 
 	c.JSON(http.StatusOK, result)
 }
+
+func (s *Server) GetExchangeRateSeries(c *gin.Context) {
+	traceutils.SetHandlerTag(c, "GetExchangeRateSeries")
+
+	var reqParams = ExchangeRateSeriesQueryParams{
+		Interval: time.Hour,
+	}
+
+	if err := c.BindQuery(&reqParams); err != nil {
+		abortWithError(c, http.StatusBadRequest, "invalid parameters", err)
+		return
+	}
+
+	if !indexer.IsSupportedCurrencyPair(reqParams.CurrencyPair) {
+		abortWithError(c, http.StatusBadRequest, "invalid parameters", fmt.Errorf("unsupported currency pair"))
+		return
+	}
+
+	if reqParams.To.IsZero() {
+		reqParams.To = time.Now()
+	}
+	if reqParams.From.IsZero() {
+		reqParams.From = reqParams.To.Add(-24 * time.Hour)
+	}
+
+	if reqParams.Interval < time.Minute || reqParams.Interval%time.Minute != 0 || !reqParams.From.Before(reqParams.To) ||
+		reqParams.To.Sub(reqParams.From)/reqParams.Interval > maxExchangeRateCandles {
+		abortWithError(c, http.StatusBadRequest, "invalid parameters", fmt.Errorf("invalid time range or interval"))
+		return
+	}
+
+	candles, err := s.indexerStore.GetExchangeRateSeries(c, indexer.ExchangeRateSeriesFilter{
+		CurrencyPair: reqParams.CurrencyPair,
+		From:         reqParams.From,
+		To:           reqParams.To,
+		Interval:     reqParams.Interval,
+	})
+	if err != nil {
+		abortWithError(c, http.StatusInternalServerError, "fail to query exchange rate series from indexer store", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, candles)
+}
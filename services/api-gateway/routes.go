@@ -41,6 +41,9 @@ func (s *Server) SetupRoute() {
 	s.route.GET("/eth/:block_hash/block_time", s.GetETHBlockTime)
 
 	s.route.GET("/exchange_rate", s.GetExchangeRate)
+	s.route.GET("/exchange_rate/series", s.GetExchangeRateSeries)
+
+	s.route.GET("/images/:image_id/:variant", s.GetImage)
 
 	v1 := s.route.Group("/v1")
 	v1NFT := v1.Group("/nft")
@@ -62,6 +65,7 @@ func (s *Server) SetupRoute() {
 	v2NFT.GET("", s.GetAccountNFTsV2)
 	v2NFT.GET("/count", s.CountAccountNFTsV2)
 	v2NFT.POST("/query", s.QueryNFTsV2)
+	v2NFT.GET("/search", s.SearchNFTsV2)
 	v2NFT.POST("/index_one", s.IndexOneNFT)
 	v2NFT.POST("/index", s.IndexNFTsV2)
 	v2NFT.POST("/index_history", s.IndexHistory)
@@ -70,7 +74,10 @@ func (s *Server) SetupRoute() {
 	v2Collections.GET("", s.GetCollectionsByCreators)
 	v2Collections.GET("/:collection_id", s.GetCollectionByID)
 
+	v2.GET("/sales/analytics", s.GetSalesAnalytics)
+
 	v2.POST("/graphql", s.graphqlHandler)
+	v2.GET("/graphql", s.graphqlWebsocketHandler)
 	v2.GET("/graphiql", s.playgroundHandler)
 
 	s.route.GET("/healthz", func(c *gin.Context) {
@@ -2,6 +2,7 @@ package main
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
@@ -20,6 +21,16 @@ type SalesQueryParams struct {
 	Marketplace      string   `form:"marketplace"`
 }
 
+type SalesAnalyticsQueryParams struct {
+	Scope       string     `form:"scope" binding:"required,oneof=collection artist contract"`
+	ID          string     `form:"id" binding:"required"`
+	Interval    string     `form:"interval" binding:"omitempty,oneof=day week"`
+	Marketplace string     `form:"marketplace"`
+	From        *time.Time `form:"from"`
+	To          *time.Time `form:"to"`
+	Limit       int64      `form:"limit" binding:"omitempty,min=1,max=100"`
+}
+
 // SalesTimeSeries - store a time series record
 func (s *Server) SalesTimeSeries(c *gin.Context) {
 	traceutils.SetHandlerTag(c, "Sales")
@@ -40,3 +51,34 @@ func (s *Server) SalesTimeSeries(c *gin.Context) {
 		"ok": 1,
 	})
 }
+
+// GetSalesAnalytics - get the USD volumes and the top buyers and sellers of a collection, an artist or a contract
+func (s *Server) GetSalesAnalytics(c *gin.Context) {
+	traceutils.SetHandlerTag(c, "GetSalesAnalytics")
+
+	var reqParams = SalesAnalyticsQueryParams{
+		Interval: indexer.SalesAnalyticsIntervalDay,
+		Limit:    indexer.DefaultSalesAnalyticsLimit,
+	}
+
+	if err := c.BindQuery(&reqParams); err != nil {
+		abortWithError(c, http.StatusBadRequest, "invalid parameters", err)
+		return
+	}
+
+	analytics, err := s.indexerStore.GetSalesAnalytics(c, indexer.SalesAnalyticsFilter{
+		Scope:       reqParams.Scope,
+		ID:          reqParams.ID,
+		Interval:    reqParams.Interval,
+		Marketplace: reqParams.Marketplace,
+		From:        reqParams.From,
+		To:          reqParams.To,
+		Limit:       reqParams.Limit,
+	})
+	if err != nil {
+		abortWithError(c, http.StatusInternalServerError, "fail to query sales analytics from indexer store", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, analytics)
+}
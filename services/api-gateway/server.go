@@ -9,6 +9,7 @@ import (
 	"github.com/feral-file/ff-indexer/cadence"
 	"github.com/feral-file/ff-indexer/externals/ens"
 	tezosDomain "github.com/feral-file/ff-indexer/externals/tezos-domain"
+	imageStore "github.com/feral-file/ff-indexer/services/image-indexer/store"
 )
 
 type Server struct {
@@ -22,6 +23,7 @@ type Server struct {
 	indexerStore  indexer.Store
 	cacheStore    cache.Store
 	indexerEngine *indexer.IndexEngine
+	imageBackend  imageStore.ImageBackend
 }
 
 func NewServer(cadenceWorker *cadence.WorkerClient,
@@ -31,6 +33,7 @@ func NewServer(cadenceWorker *cadence.WorkerClient,
 	indexerStore indexer.Store,
 	cacheStore cache.Store,
 	indexerEngine *indexer.IndexEngine,
+	imageBackend imageStore.ImageBackend,
 	apiToken string,
 	adminAPIToken string) *Server {
 	r := gin.New()
@@ -46,6 +49,7 @@ func NewServer(cadenceWorker *cadence.WorkerClient,
 		indexerStore:  indexerStore,
 		cacheStore:    cacheStore,
 		indexerEngine: indexerEngine,
+		imageBackend:  imageBackend,
 	}
 }
 
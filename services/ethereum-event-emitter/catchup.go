@@ -0,0 +1,218 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultCatchupMinBlockRange     = uint64(1)
+	defaultCatchupInitialBlockRange = uint64(100)
+	defaultCatchupMaxBlockRange     = uint64(2000)
+	defaultCatchupConcurrency       = 4
+
+	// catchupGrowAfter is the number of successful queries in a row before the window grows
+	catchupGrowAfter = 4
+)
+
+// tooManyResultsMessages are the error messages of providers which reject a log query
+// because the block range or the response is too large
+var tooManyResultsMessages = []string{
+	"too many results",
+	"query returned more than",
+	"response size exceeded",
+	"log response size exceeded",
+	"block range is too large",
+	"exceed maximum block range",
+	"limit exceeded",
+}
+
+// isTooManyResultsError returns whether a log query should be retried with a smaller range
+func isTooManyResultsError(err error) bool {
+	message := strings.ToLower(err.Error())
+	for _, m := range tooManyResultsMessages {
+		if strings.Contains(message, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// logRangeFetcher fetches logs between two blocks, both inclusive
+type logRangeFetcher func(ctx context.Context, fromBlock, toBlock uint64) ([]types.Log, error)
+
+// rangeCatchup fetches logs of a block range in batches. The batch size grows while the
+// queries succeed and shrinks when the provider returns too many results. Batches are
+// fetched concurrently but their logs are handled in the block order.
+type rangeCatchup struct {
+	sync.Mutex
+
+	fetch       logRangeFetcher
+	concurrency int
+	minRange    uint64
+	maxRange    uint64
+	window      uint64
+	successes   int
+}
+
+func newRangeCatchup(fetch logRangeFetcher, concurrency int, minRange, maxRange uint64) *rangeCatchup {
+	if concurrency <= 0 {
+		concurrency = defaultCatchupConcurrency
+	}
+	if minRange == 0 {
+		minRange = defaultCatchupMinBlockRange
+	}
+	if maxRange == 0 {
+		maxRange = defaultCatchupMaxBlockRange
+	}
+	if maxRange < minRange {
+		maxRange = minRange
+	}
+
+	return &rangeCatchup{
+		fetch:       fetch,
+		concurrency: concurrency,
+		minRange:    minRange,
+		maxRange:    maxRange,
+		window:      max(min(defaultCatchupInitialBlockRange, maxRange), minRange),
+	}
+}
+
+type rangeResult struct {
+	logs []types.Log
+	err  error
+}
+
+// Run fetches logs from fromBlock to toBlock and passes them to the handler in order.
+// It stops at the first batch which fails.
+func (c *rangeCatchup) Run(ctx context.Context, fromBlock, toBlock uint64, handle func(eLog types.Log)) error {
+	if fromBlock > toBlock {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// results keeps the pending batches in order. Its capacity limits the number of
+	// fetched batches which are waiting for the former ones.
+	results := make(chan chan rangeResult, c.concurrency)
+	sem := make(chan struct{}, c.concurrency)
+
+	go func() {
+		defer close(results)
+
+		for from := fromBlock; from <= toBlock; {
+			to := min(from+c.currentWindow()-1, toBlock)
+
+			result := make(chan rangeResult, 1)
+			select {
+			case results <- result:
+			case <-ctx.Done():
+				return
+			}
+
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			go func(from, to uint64) {
+				defer func() { <-sem }()
+				logs, err := c.fetchRange(ctx, from, to)
+				result <- rangeResult{logs: logs, err: err}
+			}(from, to)
+
+			if to == toBlock {
+				return
+			}
+			from = to + 1
+		}
+	}()
+
+	for result := range results {
+		var r rangeResult
+		select {
+		case r = <-result:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		if r.err != nil {
+			return r.err
+		}
+
+		sortLogs(r.logs)
+		for _, l := range r.logs {
+			handle(l)
+		}
+	}
+
+	return ctx.Err()
+}
+
+// fetchRange fetches a batch and splits it when the provider returns too many results
+func (c *rangeCatchup) fetchRange(ctx context.Context, fromBlock, toBlock uint64) ([]types.Log, error) {
+	logs, err := c.fetch(ctx, fromBlock, toBlock)
+	if err == nil {
+		c.grow(toBlock - fromBlock + 1)
+		return logs, nil
+	}
+
+	if !isTooManyResultsError(err) || fromBlock == toBlock {
+		return nil, err
+	}
+
+	c.shrink(toBlock - fromBlock + 1)
+	log.WarnWithContext(ctx, "too many results in a block range, split the range",
+		zap.Uint64("fromBlock", fromBlock), zap.Uint64("toBlock", toBlock), zap.Error(err))
+
+	middle := fromBlock + (toBlock-fromBlock)/2
+	first, err := c.fetchRange(ctx, fromBlock, middle)
+	if err != nil {
+		return nil, err
+	}
+
+	second, err := c.fetchRange(ctx, middle+1, toBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(first, second...), nil
+}
+
+func (c *rangeCatchup) currentWindow() uint64 {
+	c.Lock()
+	defer c.Unlock()
+	return c.window
+}
+
+// grow doubles the window after a few successful queries of the full window
+func (c *rangeCatchup) grow(succeededRange uint64) {
+	c.Lock()
+	defer c.Unlock()
+
+	if succeededRange < c.window {
+		return
+	}
+
+	c.successes++
+	if c.successes >= catchupGrowAfter {
+		c.successes = 0
+		c.window = min(c.window*2, c.maxRange)
+	}
+}
+
+// shrink halves a failed range size and uses it as the window
+func (c *rangeCatchup) shrink(failedRange uint64) {
+	c.Lock()
+	defer c.Unlock()
+
+	c.successes = 0
+	c.window = max(min(c.window, failedRange/2), c.minRange)
+}
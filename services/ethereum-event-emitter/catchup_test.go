@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// testLogSource serves two logs per block and rejects ranges which are larger than a limit
+type testLogSource struct {
+	sync.Mutex
+
+	rangeLimit uint64
+	queries    int
+}
+
+func (s *testLogSource) fetch(_ context.Context, fromBlock, toBlock uint64) ([]types.Log, error) {
+	s.Lock()
+	s.queries++
+	s.Unlock()
+
+	if toBlock-fromBlock+1 > s.rangeLimit {
+		return nil, errors.New("query returned more than 10000 results")
+	}
+
+	// finish the queries in a random order
+	time.Sleep(time.Duration(rand.Intn(3)) * time.Millisecond) // #nosec G404 -- test only
+
+	logs := []types.Log{}
+	for n := toBlock; n >= fromBlock; n-- {
+		logs = append(logs, types.Log{BlockNumber: n, Index: 1}, types.Log{BlockNumber: n, Index: 0})
+	}
+	return logs, nil
+}
+
+func TestRangeCatchupDeliversLogsInOrder(t *testing.T) {
+	if err := log.Initialize(false, nil); err != nil {
+		panic(err)
+	}
+
+	source := &testLogSource{rangeLimit: 16}
+	catchup := newRangeCatchup(source.fetch, 4, 1, 64)
+
+	var logs []types.Log
+	err := catchup.Run(context.Background(), 10, 500, func(eLog types.Log) {
+		logs = append(logs, eLog)
+	})
+	assert.NoError(t, err)
+
+	assert.Len(t, logs, 2*491)
+	for i, l := range logs {
+		assert.Equal(t, uint64(10+i/2), l.BlockNumber)
+		assert.Equal(t, uint(i%2), l.Index)
+	}
+
+	// the window shrinks to the limit of the provider
+	assert.LessOrEqual(t, catchup.currentWindow(), uint64(32))
+	assert.Less(t, source.queries, 491)
+}
+
+func TestRangeCatchupStopsOnFailure(t *testing.T) {
+	if err := log.Initialize(false, nil); err != nil {
+		panic(err)
+	}
+
+	failure := errors.New("connection lost")
+	catchup := newRangeCatchup(func(_ context.Context, fromBlock, toBlock uint64) ([]types.Log, error) {
+		if fromBlock <= 20 && toBlock >= 20 {
+			return nil, failure
+		}
+		return []types.Log{{BlockNumber: fromBlock}}, nil
+	}, 2, 1, 4)
+
+	var handled []uint64
+	err := catchup.Run(context.Background(), 1, 100, func(eLog types.Log) {
+		handled = append(handled, eLog.BlockNumber)
+	})
+	assert.ErrorIs(t, err, failure)
+	for _, n := range handled {
+		assert.Less(t, n, uint64(20))
+	}
+}
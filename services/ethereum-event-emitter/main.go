@@ -2,11 +2,12 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	log "github.com/bitmark-inc/autonomy-logger"
+	utils "github.com/bitmark-inc/autonomy-utils"
 	"github.com/bitmark-inc/config-loader"
-	"github.com/bitmark-inc/config-loader/external/aws/ssm"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/getsentry/sentry-go"
 	"github.com/spf13/viper"
@@ -15,6 +16,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/feral-file/ff-indexer/cache"
+	"github.com/feral-file/ff-indexer/emitter"
 	pb "github.com/feral-file/ff-indexer/services/event-processor/grpc"
 )
 
@@ -36,14 +38,20 @@ func main() {
 		log.Panic(err.Error(), zap.Error(err))
 	}
 
-	parameterStore, err := ssm.New(ctx)
+	cacheStore, err := cache.NewMongoDBCacheStore(ctx, viper.GetString("cache_store.db_uri"), viper.GetString("cache_store.db_name"))
 	if err != nil {
-		log.Panic("can not create new parameter store", zap.Error(err))
+		log.Panic("fail to initiate cache store", zap.Error(err))
 	}
 
-	cacheStore, err := cache.NewMongoDBCacheStore(ctx, viper.GetString("cache_store.db_uri"), viper.GetString("cache_store.db_name"))
+	checkpointStore, err := emitter.NewCheckpointStore(ctx, emitter.CheckpointStoreConfig{
+		Type:       viper.GetString("checkpoint_store.type"),
+		KeyName:    viper.GetString("ethereum.lastBlockKeyName"),
+		DSN:        viper.GetString("checkpoint_store.dsn"),
+		Path:       viper.GetString("checkpoint_store.path"),
+		CacheStore: cacheStore,
+	})
 	if err != nil {
-		log.Panic("fail to initiate cache store", zap.Error(err))
+		log.Panic("fail to initiate checkpoint store", zap.Error(err))
 	}
 
 	// connect to the processor
@@ -57,12 +65,24 @@ func main() {
 
 	c := pb.NewEventProcessorClient(conn)
 	ethereumEventsEmitter := NewEthereumEventsEmitter(
-		viper.GetString("ethereum.lastBlockKeyName"),
 		viper.GetString("contract.series_registry"),
+		viper.GetUint64("ethereum.confirmations"),
+		viper.GetInt("ethereum.catchup.concurrency"),
+		viper.GetUint64("ethereum.catchup.max_block_range"),
 		wsClient,
-		parameterStore,
+		checkpointStore,
 		cacheStore,
 		c)
+
+	go func() {
+		if err := emitter.ServeMetrics(viper.GetString("metrics.address")); err != nil {
+			log.ErrorWithContext(ctx, errors.New("metrics server stopped with error"), zap.Error(err))
+		}
+	}()
+
+	go emitter.MonitorCheckpointLag(ctx, viper.GetDuration("metrics.lag_report_interval"), utils.EthereumBlockchain,
+		[]string{emitter.SubscriptionNftTransfer, emitter.SubscriptionSeriesRegistry}, checkpointStore, wsClient.BlockNumber)
+
 	ethereumEventsEmitter.Run(ctx)
 
 	log.InfoWithContext(ctx, "Ethereum Emitter terminated")
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.uber.org/zap"
+)
+
+// reorgTrackingBlocks is the number of blocks that an emitted block is kept
+// after it is confirmed. Orphaned blocks inside this window are reverted.
+const reorgTrackingBlocks = 64
+
+// headerReader reads canonical block headers from an ethereum node
+type headerReader interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+type logHandler func(ctx context.Context, eLog types.Log)
+
+type trackedBlock struct {
+	number uint64
+	hash   common.Hash
+	logs   []types.Log
+}
+
+// removeLog removes a log from the block and returns whether it was found
+func (b *trackedBlock) removeLog(eLog types.Log) bool {
+	for i, l := range b.logs {
+		if l.TxHash == eLog.TxHash && l.Index == eLog.Index {
+			b.logs = append(b.logs[:i], b.logs[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// reorgTracker holds logs until their blocks reach the confirmation depth and
+// remembers the recently emitted blocks. When an emitted block gets orphaned,
+// its logs are passed to the revert handler.
+type reorgTracker struct {
+	sync.Mutex
+
+	confirmations uint64
+	headers       headerReader
+	emit          logHandler
+	revert        logHandler
+
+	head    uint64
+	heads   map[uint64]common.Hash
+	pending map[common.Hash]*trackedBlock
+	emitted map[common.Hash]*trackedBlock
+}
+
+func newReorgTracker(confirmations uint64, headers headerReader, emit, revert logHandler) *reorgTracker {
+	return &reorgTracker{
+		confirmations: confirmations,
+		headers:       headers,
+		emit:          emit,
+		revert:        revert,
+
+		heads:   map[uint64]common.Hash{},
+		pending: map[common.Hash]*trackedBlock{},
+		emitted: map[common.Hash]*trackedBlock{},
+	}
+}
+
+// AddLog adds a log from the subscription. Removed logs drop the pending ones
+// or revert the emitted ones. Without a confirmation depth, logs are emitted immediately.
+func (t *reorgTracker) AddLog(ctx context.Context, eLog types.Log) {
+	t.Lock()
+
+	if eLog.Removed {
+		reverted := false
+		if b, ok := t.pending[eLog.BlockHash]; ok {
+			b.removeLog(eLog)
+		} else if b, ok := t.emitted[eLog.BlockHash]; ok {
+			reverted = b.removeLog(eLog)
+		}
+		t.Unlock()
+
+		if reverted {
+			log.WarnWithContext(ctx, "an emitted log is removed by chain reorganization",
+				zap.String("txHash", eLog.TxHash.Hex()), zap.Uint("logIndex", eLog.Index), zap.Uint64("blockNumber", eLog.BlockNumber))
+			t.revert(ctx, eLog)
+		}
+		return
+	}
+
+	if t.confirmations == 0 {
+		t.track(t.emitted, eLog)
+		t.Unlock()
+		t.emit(ctx, eLog)
+		return
+	}
+
+	t.track(t.pending, eLog)
+	t.Unlock()
+}
+
+// track appends a log into the block it belongs to
+func (t *reorgTracker) track(blocks map[common.Hash]*trackedBlock, eLog types.Log) {
+	b, ok := blocks[eLog.BlockHash]
+	if !ok {
+		b = &trackedBlock{number: eLog.BlockNumber, hash: eLog.BlockHash}
+		blocks[eLog.BlockHash] = b
+	}
+
+	for _, l := range b.logs {
+		if l.TxHash == eLog.TxHash && l.Index == eLog.Index {
+			return
+		}
+	}
+	b.logs = append(b.logs, eLog)
+}
+
+// NewHead handles a new chain head. It reverts the emitted blocks which are
+// no longer canonical and emits the pending blocks which are deep enough.
+// The canonical hashes are read from the node without holding the lock, so
+// the logs from the subscriptions are not blocked by a slow node.
+func (t *reorgTracker) NewHead(ctx context.Context, head *types.Header) {
+	if head == nil || head.Number == nil {
+		return
+	}
+	number := head.Number.Uint64()
+
+	t.Lock()
+
+	reorged := false
+	if parent, ok := t.heads[number-1]; ok && number > 0 && parent != head.ParentHash {
+		reorged = true
+	}
+	if current, ok := t.heads[number]; ok && current != head.Hash() {
+		reorged = true
+	}
+
+	for n := range t.heads {
+		if n > number || n+t.confirmations+reorgTrackingBlocks < number {
+			delete(t.heads, n)
+		}
+	}
+	t.heads[number] = head.Hash()
+	t.head = number
+
+	// the known heads are used for the pending blocks unless the chain is reorganized
+	canonical := map[uint64]common.Hash{}
+	refresh := map[uint64]bool{}
+	if reorged {
+		log.WarnWithContext(ctx, "chain reorganization detected", zap.Uint64("head", number), zap.String("hash", head.Hash().Hex()))
+
+		for _, b := range t.emitted {
+			refresh[b.number] = true
+		}
+	}
+
+	var ready []*trackedBlock
+	for _, b := range t.pending {
+		if b.number+t.confirmations > number {
+			continue
+		}
+
+		ready = append(ready, b)
+		if hash, ok := t.heads[b.number]; ok && !reorged {
+			canonical[b.number] = hash
+		} else {
+			refresh[b.number] = true
+		}
+	}
+
+	t.Unlock()
+
+	fetched := map[uint64]common.Hash{}
+	for n := range refresh {
+		header, err := t.headers.HeaderByNumber(ctx, new(big.Int).SetUint64(n))
+		if err != nil {
+			log.ErrorWithContext(ctx, errors.New("fail to read canonical block hash"), zap.Uint64("blockNumber", n), zap.Error(err))
+			continue
+		}
+		fetched[n] = header.Hash()
+		canonical[n] = header.Hash()
+	}
+
+	t.Lock()
+
+	for n, hash := range fetched {
+		t.heads[n] = hash
+	}
+
+	var revertLogs []types.Log
+	if reorged {
+		for hash, b := range t.emitted {
+			if c, ok := fetched[b.number]; ok && c != hash {
+				revertLogs = append(revertLogs, b.logs...)
+				delete(t.emitted, hash)
+			}
+		}
+	}
+
+	var emitBlocks []*trackedBlock
+	for _, b := range ready {
+		// the block may be handled by another head in the meantime
+		if t.pending[b.hash] != b {
+			continue
+		}
+
+		c, ok := canonical[b.number]
+		if !ok {
+			continue
+		}
+
+		delete(t.pending, b.hash)
+		if c != b.hash {
+			log.WarnWithContext(ctx, "drop logs of an orphaned block",
+				zap.Uint64("blockNumber", b.number), zap.String("blockHash", b.hash.Hex()), zap.Int("logs", len(b.logs)))
+			continue
+		}
+
+		t.emitted[b.hash] = b
+		emitBlocks = append(emitBlocks, &trackedBlock{number: b.number, hash: b.hash, logs: append([]types.Log{}, b.logs...)})
+	}
+
+	for hash, b := range t.emitted {
+		if b.number+t.confirmations+reorgTrackingBlocks < number {
+			delete(t.emitted, hash)
+		}
+	}
+
+	t.Unlock()
+
+	// revert the latest changes first
+	sortLogs(revertLogs)
+	for i := len(revertLogs) - 1; i >= 0; i-- {
+		t.revert(ctx, revertLogs[i])
+	}
+
+	sort.Slice(emitBlocks, func(i, j int) bool {
+		return emitBlocks[i].number < emitBlocks[j].number
+	})
+	for _, b := range emitBlocks {
+		sortLogs(b.logs)
+		for _, l := range b.logs {
+			t.emit(ctx, l)
+		}
+	}
+}
+
+// ScannedBlock returns the latest block which logs are all emitted or dropped. The logs of
+// the later blocks are held for their confirmations.
+func (t *reorgTracker) ScannedBlock() uint64 {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.head < t.confirmations {
+		return 0
+	}
+
+	scanned := t.head - t.confirmations
+	for _, b := range t.pending {
+		if b.number <= scanned {
+			scanned = max(b.number, 1) - 1
+		}
+	}
+
+	return scanned
+}
+
+// sortLogs sorts logs by block number and log index
+func sortLogs(logs []types.Log) {
+	sort.Slice(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].Index < logs[j].Index
+	})
+}
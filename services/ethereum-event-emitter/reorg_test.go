@@ -0,0 +1,241 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type testChain struct {
+	headers map[uint64]*types.Header
+}
+
+func (c *testChain) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	h, ok := c.headers[number.Uint64()]
+	if !ok {
+		return nil, errors.New("header not found")
+	}
+	return h, nil
+}
+
+// extend appends blocks on top of a parent block. The fork value makes the blocks unique.
+func (c *testChain) extend(from, to uint64, fork byte) {
+	for n := from; n <= to; n++ {
+		var parentHash common.Hash
+		if parent, ok := c.headers[n-1]; ok {
+			parentHash = parent.Hash()
+		}
+		c.headers[n] = &types.Header{
+			Number:     new(big.Int).SetUint64(n),
+			ParentHash: parentHash,
+			Extra:      []byte{fork},
+		}
+	}
+}
+
+func testLog(chain *testChain, number uint64, index uint) types.Log {
+	return types.Log{
+		BlockNumber: number,
+		BlockHash:   chain.headers[number].Hash(),
+		TxHash:      common.BigToHash(big.NewInt(int64(number*100) + int64(index))),
+		Index:       index,
+	}
+}
+
+type logRecorder struct {
+	emitted  []types.Log
+	reverted []types.Log
+}
+
+func (r *logRecorder) emit(_ context.Context, l types.Log) {
+	r.emitted = append(r.emitted, l)
+}
+
+func (r *logRecorder) revert(_ context.Context, l types.Log) {
+	r.reverted = append(r.reverted, l)
+}
+
+func TestReorgTrackerWaitsForConfirmations(t *testing.T) {
+	if err := log.Initialize(false, nil); err != nil {
+		panic(err)
+	}
+	ctx := context.Background()
+	chain := &testChain{headers: map[uint64]*types.Header{}}
+	chain.extend(1, 10, 0)
+
+	r := &logRecorder{}
+	tracker := newReorgTracker(3, chain, r.emit, r.revert)
+
+	tracker.AddLog(ctx, testLog(chain, 5, 1))
+	tracker.AddLog(ctx, testLog(chain, 5, 0))
+	tracker.AddLog(ctx, testLog(chain, 6, 0))
+
+	tracker.NewHead(ctx, chain.headers[7])
+	assert.Empty(t, r.emitted)
+
+	tracker.NewHead(ctx, chain.headers[8])
+	assert.Len(t, r.emitted, 2)
+	assert.Equal(t, uint(0), r.emitted[0].Index)
+	assert.Equal(t, uint(1), r.emitted[1].Index)
+
+	tracker.NewHead(ctx, chain.headers[9])
+	assert.Len(t, r.emitted, 3)
+	assert.Empty(t, r.reverted)
+}
+
+func TestReorgTrackerDropsRemovedPendingLogs(t *testing.T) {
+	if err := log.Initialize(false, nil); err != nil {
+		panic(err)
+	}
+	ctx := context.Background()
+	chain := &testChain{headers: map[uint64]*types.Header{}}
+	chain.extend(1, 10, 0)
+
+	r := &logRecorder{}
+	tracker := newReorgTracker(2, chain, r.emit, r.revert)
+
+	l := testLog(chain, 5, 0)
+	tracker.AddLog(ctx, l)
+	l.Removed = true
+	tracker.AddLog(ctx, l)
+
+	tracker.NewHead(ctx, chain.headers[10])
+	assert.Empty(t, r.emitted)
+	assert.Empty(t, r.reverted)
+}
+
+func TestReorgTrackerDropsOrphanedPendingBlocks(t *testing.T) {
+	if err := log.Initialize(false, nil); err != nil {
+		panic(err)
+	}
+	ctx := context.Background()
+	chain := &testChain{headers: map[uint64]*types.Header{}}
+	chain.extend(1, 6, 0)
+
+	r := &logRecorder{}
+	tracker := newReorgTracker(2, chain, r.emit, r.revert)
+
+	orphaned := testLog(chain, 5, 0)
+	tracker.AddLog(ctx, orphaned)
+
+	// block 5 is replaced before the log is confirmed
+	chain.extend(5, 8, 1)
+	canonical := testLog(chain, 5, 0)
+	tracker.AddLog(ctx, canonical)
+
+	tracker.NewHead(ctx, chain.headers[7])
+	assert.Len(t, r.emitted, 1)
+	assert.Equal(t, canonical.BlockHash, r.emitted[0].BlockHash)
+	assert.Empty(t, r.reverted)
+}
+
+func TestReorgTrackerRevertsOrphanedEmittedBlocks(t *testing.T) {
+	if err := log.Initialize(false, nil); err != nil {
+		panic(err)
+	}
+	ctx := context.Background()
+	chain := &testChain{headers: map[uint64]*types.Header{}}
+	chain.extend(1, 10, 0)
+
+	r := &logRecorder{}
+	tracker := newReorgTracker(1, chain, r.emit, r.revert)
+
+	tracker.AddLog(ctx, testLog(chain, 8, 0))
+	tracker.AddLog(ctx, testLog(chain, 9, 0))
+	tracker.NewHead(ctx, chain.headers[10])
+	assert.Len(t, r.emitted, 2)
+
+	// a deep reorganization replaces the blocks from 8
+	chain.extend(8, 11, 1)
+	tracker.NewHead(ctx, chain.headers[11])
+
+	assert.Len(t, r.reverted, 2)
+	// the latest change is reverted first
+	assert.Equal(t, uint64(9), r.reverted[0].BlockNumber)
+	assert.Equal(t, uint64(8), r.reverted[1].BlockNumber)
+}
+
+func TestReorgTrackerRevertsRemovedEmittedLogs(t *testing.T) {
+	if err := log.Initialize(false, nil); err != nil {
+		panic(err)
+	}
+	ctx := context.Background()
+	chain := &testChain{headers: map[uint64]*types.Header{}}
+	chain.extend(1, 10, 0)
+
+	r := &logRecorder{}
+	tracker := newReorgTracker(0, chain, r.emit, r.revert)
+
+	l := testLog(chain, 10, 0)
+	tracker.AddLog(ctx, l)
+	assert.Len(t, r.emitted, 1)
+
+	l.Removed = true
+	tracker.AddLog(ctx, l)
+	tracker.AddLog(ctx, l)
+	assert.Len(t, r.reverted, 1)
+}
+
+// lockingChain adds a log while a header is read, which blocks if the tracker holds its lock
+type lockingChain struct {
+	*testChain
+	tracker *reorgTracker
+	log     types.Log
+}
+
+func (c *lockingChain) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	c.tracker.AddLog(ctx, c.log)
+	return c.testChain.HeaderByNumber(ctx, number)
+}
+
+func TestReorgTrackerReadsHeadersWithoutLock(t *testing.T) {
+	if err := log.Initialize(false, nil); err != nil {
+		panic(err)
+	}
+	ctx := context.Background()
+	chain := &testChain{headers: map[uint64]*types.Header{}}
+	chain.extend(1, 10, 0)
+
+	r := &logRecorder{}
+	reader := &lockingChain{testChain: chain, log: testLog(chain, 9, 0)}
+	tracker := newReorgTracker(2, reader, r.emit, r.revert)
+	reader.tracker = tracker
+
+	tracker.AddLog(ctx, testLog(chain, 5, 0))
+	tracker.NewHead(ctx, chain.headers[10])
+
+	assert.Len(t, r.emitted, 1)
+	assert.Len(t, tracker.pending, 1)
+}
+
+func TestReorgTrackerScannedBlock(t *testing.T) {
+	if err := log.Initialize(false, nil); err != nil {
+		panic(err)
+	}
+	ctx := context.Background()
+	chain := &testChain{headers: map[uint64]*types.Header{}}
+	chain.extend(1, 20, 0)
+
+	r := &logRecorder{}
+	tracker := newReorgTracker(3, chain, r.emit, r.revert)
+	assert.Equal(t, uint64(0), tracker.ScannedBlock())
+
+	// the blocks without logs are scanned once they are confirmed
+	tracker.NewHead(ctx, chain.headers[10])
+	assert.Equal(t, uint64(7), tracker.ScannedBlock())
+
+	tracker.AddLog(ctx, testLog(chain, 11, 0))
+	tracker.NewHead(ctx, chain.headers[13])
+	assert.Len(t, r.emitted, 0)
+	assert.Equal(t, uint64(10), tracker.ScannedBlock())
+
+	tracker.NewHead(ctx, chain.headers[14])
+	assert.Len(t, r.emitted, 1)
+	assert.Equal(t, uint64(11), tracker.ScannedBlock())
+}
@@ -4,13 +4,11 @@ import (
 	"context"
 	"errors"
 	"math/big"
-	"strconv"
 	"sync"
 	"time"
 
 	log "github.com/bitmark-inc/autonomy-logger"
 	utils "github.com/bitmark-inc/autonomy-utils"
-	"github.com/bitmark-inc/config-loader/external/aws/ssm"
 	goethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -25,48 +23,67 @@ import (
 	seriesRegistry "github.com/bitmark-inc/feralfile-exhibition-smart-contract/go-binding/series-registry"
 )
 
-var currentLastStoppedBlock = uint64(0)
-
 type EthereumEventsEmitter struct {
-	lastBlockKeyName       string
 	seriesRegistryContract string
+	confirmations          uint64
+	catchupConcurrency     int
+	catchupMaxBlockRange   uint64
 
 	emitter.EventsEmitter
-	wsClient       *ethclient.Client
-	parameterStore *ssm.ParameterStore
-	cacheStore     cache.Store
+	wsClient        *ethclient.Client
+	checkpointStore emitter.CheckpointStore
+	cacheStore      cache.Store
+
+	checkpointLock    sync.Mutex
+	lastStoppedBlocks map[string]uint64
 
 	nftTransferLogChan         chan types.Log
 	seriesRegistryLogChan      chan types.Log
+	headChan                   chan *types.Header
 	nftTransferSubscription    *goethereum.Subscription
 	seriesRegistrySubscription *goethereum.Subscription
+	headSubscription           *goethereum.Subscription
+
+	nftTransferTracker    *reorgTracker
+	seriesRegistryTracker *reorgTracker
 }
 
 func NewEthereumEventsEmitter(
-	lastBlockKeyName string,
 	seriesRegistryContract string,
+	confirmations uint64,
+	catchupConcurrency int,
+	catchupMaxBlockRange uint64,
 	wsClient *ethclient.Client,
-	parameterStore *ssm.ParameterStore,
+	checkpointStore emitter.CheckpointStore,
 	cacheStore cache.Store,
 	grpcClient pb.EventProcessorClient,
 ) *EthereumEventsEmitter {
-	return &EthereumEventsEmitter{
-		lastBlockKeyName:       lastBlockKeyName,
+	e := &EthereumEventsEmitter{
 		seriesRegistryContract: seriesRegistryContract,
-		parameterStore:         parameterStore,
+		confirmations:          confirmations,
+		catchupConcurrency:     catchupConcurrency,
+		catchupMaxBlockRange:   catchupMaxBlockRange,
+		checkpointStore:        checkpointStore,
 		cacheStore:             cacheStore,
+		lastStoppedBlocks:      map[string]uint64{},
 		EventsEmitter:          emitter.New(grpcClient),
 		wsClient:               wsClient,
 		nftTransferLogChan:     make(chan types.Log, 100),
 		seriesRegistryLogChan:  make(chan types.Log, 100),
+		headChan:               make(chan *types.Header, 100),
 	}
+
+	e.nftTransferTracker = newReorgTracker(confirmations, wsClient, e.processNftTransferLog, e.revertNftTransferLog)
+	e.seriesRegistryTracker = newReorgTracker(confirmations, wsClient, e.processSeriesRegistryLog, e.revertSeriesRegistryLog)
+
+	return e
 }
 
 func (e *EthereumEventsEmitter) Watch(ctx context.Context) {
 	log.InfoWithContext(ctx, "start watching Ethereum events")
 
 	var wg sync.WaitGroup
-	wg.Add(2)
+	wg.Add(3)
 
 	go func() {
 		defer wg.Done()
@@ -74,9 +91,7 @@ func (e *EthereumEventsEmitter) Watch(ctx context.Context) {
 			if e.nftTransferSubscription != nil {
 				(*e.nftTransferSubscription).Unsubscribe()
 			}
-			nftTransferSubscription, err := e.wsClient.SubscribeFilterLogs(ctx, goethereum.FilterQuery{Topics: [][]common.Hash{
-				{common.HexToHash(indexer.TransferEventSignature), common.HexToHash(indexer.TransferSingleEventSignature)}, // transfer event
-			}}, e.nftTransferLogChan)
+			nftTransferSubscription, err := e.wsClient.SubscribeFilterLogs(ctx, e.nftTransferQuery(), e.nftTransferLogChan)
 			if err != nil {
 				log.WarnWithContext(ctx, "fail to start nft transfer subscription connection", zap.Error(err), log.SourceETHClient)
 				time.Sleep(time.Second)
@@ -103,22 +118,7 @@ func (e *EthereumEventsEmitter) Watch(ctx context.Context) {
 			if e.seriesRegistrySubscription != nil {
 				(*e.seriesRegistrySubscription).Unsubscribe()
 			}
-			seriesRegistrySubscription, err := e.wsClient.SubscribeFilterLogs(ctx, goethereum.FilterQuery{
-				Addresses: []common.Address{
-					common.HexToAddress(e.seriesRegistryContract),
-				},
-				Topics: [][]common.Hash{
-					{
-						common.HexToHash(indexer.SeriesRegistryEventRegisterSeriesSignature),
-						common.HexToHash(indexer.SeriesRegistryEventUpdateSeriesSignature),
-						common.HexToHash(indexer.SeriesRegistryEventDeleteSeriesSignature),
-						common.HexToHash(indexer.SeriesRegistryEventUpdateArtistAddressSignature),
-						common.HexToHash(indexer.SeriesRegistryEventOptInCollaborationSignature),
-						common.HexToHash(indexer.SeriesRegistryEventOptOutSeriesSignature),
-						common.HexToHash(indexer.SeriesRegistryEventAssignSeriesSignature),
-					},
-				},
-			}, e.seriesRegistryLogChan)
+			seriesRegistrySubscription, err := e.wsClient.SubscribeFilterLogs(ctx, e.seriesRegistryQuery(), e.seriesRegistryLogChan)
 			if err != nil {
 				log.WarnWithContext(ctx, "fail to start series registry subscription connection", zap.Error(err), log.SourceETHClient)
 				time.Sleep(time.Second)
@@ -138,11 +138,70 @@ func (e *EthereumEventsEmitter) Watch(ctx context.Context) {
 		}
 	}()
 
+	go func() {
+		defer wg.Done()
+		for {
+			if e.headSubscription != nil {
+				(*e.headSubscription).Unsubscribe()
+			}
+			headSubscription, err := e.wsClient.SubscribeNewHead(ctx, e.headChan)
+			if err != nil {
+				log.WarnWithContext(ctx, "fail to start new head subscription connection", zap.Error(err), log.SourceETHClient)
+				time.Sleep(time.Second)
+				continue
+			}
+			e.headSubscription = &headSubscription
+
+			// Block until an error occurs in the subscription or the context is canceled.
+			select {
+			case err = <-headSubscription.Err():
+				log.ErrorWithContext(ctx, errors.New("new head subscription stopped with failure"), zap.Error(err), log.SourceETHClient)
+			case <-ctx.Done():
+				log.InfoWithContext(ctx, "context done: unsubscribing new head subscription")
+				headSubscription.Unsubscribe()
+				return
+			}
+		}
+	}()
+
 	wg.Wait()
 }
 
-func (e *EthereumEventsEmitter) fetchLogsFromLastStoppedBlock(ctx context.Context, lastStopBlock uint64) {
-	log.InfoWithContext(ctx, "fetch logs from last stopped block", zap.Uint64("lastStopBlock", lastStopBlock))
+// nftTransferQuery returns the filter of ERC-721 and ERC-1155 transfer logs
+func (e *EthereumEventsEmitter) nftTransferQuery() goethereum.FilterQuery {
+	return goethereum.FilterQuery{
+		Topics: [][]common.Hash{
+			{
+				common.HexToHash(indexer.TransferEventSignature),
+				common.HexToHash(indexer.TransferSingleEventSignature),
+				common.HexToHash(indexer.TransferBatchEventSignature),
+			},
+		},
+	}
+}
+
+// seriesRegistryQuery returns the filter of series registry logs
+func (e *EthereumEventsEmitter) seriesRegistryQuery() goethereum.FilterQuery {
+	return indexer.SeriesRegistryQuery(e.seriesRegistryContract)
+}
+
+// rangeFetcher returns a log fetcher of a block range for a filter query
+func (e *EthereumEventsEmitter) rangeFetcher(query goethereum.FilterQuery) logRangeFetcher {
+	return func(ctx context.Context, fromBlock, toBlock uint64) ([]types.Log, error) {
+		q := query
+		q.FromBlock = new(big.Int).SetUint64(fromBlock)
+		q.ToBlock = new(big.Int).SetUint64(toBlock)
+		return e.wsClient.FilterLogs(ctx, q)
+	}
+}
+
+// fetchLogsFromLastStoppedBlock fetches the logs of each subscription since its last stopped block
+func (e *EthereumEventsEmitter) fetchLogsFromLastStoppedBlock(ctx context.Context, lastStopBlocks map[string]uint64) {
+	log.InfoWithContext(ctx, "fetch logs from last stopped block", zap.Any("lastStopBlocks", lastStopBlocks))
+
+	if len(lastStopBlocks) == 0 {
+		return
+	}
 
 	latestBlock, err := e.wsClient.BlockNumber(ctx)
 	if err != nil {
@@ -150,51 +209,39 @@ func (e *EthereumEventsEmitter) fetchLogsFromLastStoppedBlock(ctx context.Contex
 		return
 	}
 
-	// iterate every block to avoid heavy response
-	for i := lastStopBlock; i <= latestBlock; i++ {
-		block := new(big.Int)
-		block.SetUint64(i)
-		logs, err := e.wsClient.FilterLogs(ctx, goethereum.FilterQuery{
-			FromBlock: block,
-			ToBlock:   block,
-			Topics: [][]common.Hash{
-				{common.HexToHash(indexer.TransferEventSignature), common.HexToHash(indexer.TransferSingleEventSignature)},
-			},
-		})
+	// logs of the blocks which are not deep enough are held by the trackers
+	confirmedBlock := uint64(0)
+	if latestBlock > e.confirmations {
+		confirmedBlock = latestBlock - e.confirmations
+	}
 
-		if err != nil {
-			log.ErrorWithContext(ctx, errors.New("failed to fetch nft transfer logs from las stopped block"), zap.Uint64("blockNum", i), zap.Error(err), log.SourceETHClient)
-			return
-		}
+	subscriptions := []struct {
+		name    string
+		query   goethereum.FilterQuery
+		process logHandler
+		tracker *reorgTracker
+	}{
+		{emitter.SubscriptionNftTransfer, e.nftTransferQuery(), e.processNftTransferLog, e.nftTransferTracker},
+		{emitter.SubscriptionSeriesRegistry, e.seriesRegistryQuery(), e.processSeriesRegistryLog, e.seriesRegistryTracker},
+	}
 
-		for _, log := range logs {
-			e.processNftTransferLog(ctx, log)
+	for _, subscription := range subscriptions {
+		fromBlock, ok := lastStopBlocks[subscription.name]
+		if !ok {
+			continue
 		}
 
-		logs, err = e.wsClient.FilterLogs(ctx, goethereum.FilterQuery{
-			FromBlock: block,
-			ToBlock:   block,
-			Addresses: []common.Address{
-				common.HexToAddress(e.seriesRegistryContract),
-			},
-			Topics: [][]common.Hash{
-				{
-					common.HexToHash(indexer.SeriesRegistryEventRegisterSeriesSignature),
-					common.HexToHash(indexer.SeriesRegistryEventUpdateSeriesSignature),
-					common.HexToHash(indexer.SeriesRegistryEventDeleteSeriesSignature),
-					common.HexToHash(indexer.SeriesRegistryEventUpdateArtistAddressSignature),
-					common.HexToHash(indexer.SeriesRegistryEventOptInCollaborationSignature),
-				},
-			},
+		catchup := newRangeCatchup(e.rangeFetcher(subscription.query), e.catchupConcurrency, defaultCatchupMinBlockRange, e.catchupMaxBlockRange)
+		err := catchup.Run(ctx, fromBlock, latestBlock, func(eLog types.Log) {
+			if eLog.BlockNumber <= confirmedBlock {
+				subscription.process(ctx, eLog)
+			} else {
+				subscription.tracker.AddLog(ctx, eLog)
+			}
 		})
-
 		if err != nil {
-			log.ErrorWithContext(ctx, errors.New("failed to fetch series registry logs from las stopped block"), zap.Uint64("blockNum", i), zap.Error(err), log.SourceETHClient)
-			return
-		}
-
-		for _, log := range logs {
-			e.processSeriesRegistryLog(ctx, log)
+			log.ErrorWithContext(ctx, errors.New("failed to fetch logs from last stopped block"),
+				zap.String("subscription", subscription.name), zap.Uint64("fromBlock", fromBlock), zap.Error(err), log.SourceETHClient)
 		}
 	}
 }
@@ -202,17 +249,23 @@ func (e *EthereumEventsEmitter) fetchLogsFromLastStoppedBlock(ctx context.Contex
 func (e *EthereumEventsEmitter) processLogsSinceLastStoppedBlock(ctx context.Context) {
 	log.InfoWithContext(ctx, "process logs since last stopped block")
 
-	lastStopBlock, err := e.parameterStore.GetString(ctx, e.lastBlockKeyName)
-	if err != nil {
-		log.ErrorWithContext(ctx, errors.New("failed to read last stop bloc from parameter store"), zap.Error(err), log.SourceETHClient)
-	} else {
-		fromBlock, err := strconv.ParseUint(lastStopBlock, 10, 64)
+	lastStopBlocks := map[string]uint64{}
+	for _, subscription := range []string{emitter.SubscriptionNftTransfer, emitter.SubscriptionSeriesRegistry} {
+		lastStopBlock, err := e.checkpointStore.GetCheckpoint(ctx, utils.EthereumBlockchain, subscription)
 		if err != nil {
-			log.ErrorWithContext(ctx, errors.New("failed to parse last stop block"), zap.Error(err), log.SourceETHClient)
-		} else {
-			e.fetchLogsFromLastStoppedBlock(ctx, fromBlock)
+			if errors.Is(err, emitter.ErrCheckpointNotFound) {
+				log.InfoWithContext(ctx, "no checkpoint for the subscription", zap.String("subscription", subscription))
+			} else {
+				log.ErrorWithContext(ctx, errors.New("failed to read last stop block from checkpoint store"),
+					zap.String("subscription", subscription), zap.Error(err), log.SourceETHClient)
+			}
+			continue
 		}
+
+		lastStopBlocks[subscription] = lastStopBlock
 	}
+
+	e.fetchLogsFromLastStoppedBlock(ctx, lastStopBlocks)
 }
 
 func (e *EthereumEventsEmitter) Run(ctx context.Context) {
@@ -223,13 +276,13 @@ func (e *EthereumEventsEmitter) Run(ctx context.Context) {
 	go e.Watch(ctx)
 
 	var wg sync.WaitGroup
-	wg.Add(2)
+	wg.Add(3)
 
 	go func() {
 		defer wg.Done()
 		log.InfoWithContext(ctx, "start receiving nft transfer event log")
 		for eLog := range e.nftTransferLogChan {
-			e.processNftTransferLog(ctx, eLog)
+			e.nftTransferTracker.AddLog(ctx, eLog)
 		}
 	}()
 
@@ -237,13 +290,98 @@ func (e *EthereumEventsEmitter) Run(ctx context.Context) {
 		defer wg.Done()
 		log.InfoWithContext(ctx, "start receiving series registry event log")
 		for eLog := range e.seriesRegistryLogChan {
-			e.processSeriesRegistryLog(ctx, eLog)
+			e.seriesRegistryTracker.AddLog(ctx, eLog)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		log.InfoWithContext(ctx, "start receiving new heads", zap.Uint64("confirmations", e.confirmations))
+		for head := range e.headChan {
+			e.nftTransferTracker.NewHead(ctx, head)
+			e.seriesRegistryTracker.NewHead(ctx, head)
+
+			// the checkpoints advance only by the emitted logs, so the quiet subscriptions report
+			// the scanned blocks to keep their lags
+			emitter.ReportScannedBlock(utils.EthereumBlockchain, emitter.SubscriptionNftTransfer, e.nftTransferTracker.ScannedBlock())
+			emitter.ReportScannedBlock(utils.EthereumBlockchain, emitter.SubscriptionSeriesRegistry, e.seriesRegistryTracker.ScannedBlock())
 		}
 	}()
 
 	wg.Wait()
 }
 
+// nftTransfer is a single token movement decoded from a transfer log
+type nftTransfer struct {
+	fromAddress     string
+	toAddress       string
+	contractAddress string
+	tokenID         string
+	amount          string
+	// batchIndex is the position of the token in a TransferBatch log, which tells apart
+	// the transfers of a log that repeats a token id
+	batchIndex uint
+}
+
+// parseNftTransferLog extracts the token transfers from an ERC-721 / ERC-1155 transfer log.
+// A TransferBatch log is expanded into one transfer for each (id, value) pair.
+func parseNftTransferLog(eLog types.Log) ([]nftTransfer, error) {
+	if len(eLog.Topics) != 4 {
+		return nil, errors.New("not a nft transfer log")
+	}
+
+	contractAddress := indexer.EthereumChecksumAddress(eLog.Address.String())
+
+	switch eLog.Topics[0].Hex() {
+	case indexer.TransferEventSignature:
+		return []nftTransfer{
+			{
+				fromAddress:     indexer.EthereumChecksumAddress(eLog.Topics[1].Hex()),
+				toAddress:       indexer.EthereumChecksumAddress(eLog.Topics[2].Hex()),
+				contractAddress: contractAddress,
+				tokenID:         eLog.Topics[3].Big().Text(10),
+				amount:          "1",
+			},
+		}, nil
+	case indexer.TransferSingleEventSignature:
+		tokenID, value, err := indexer.ParseERC1155SingleTransferData(eLog)
+		if err != nil {
+			return nil, err
+		}
+
+		return []nftTransfer{
+			{
+				fromAddress:     indexer.EthereumChecksumAddress(eLog.Topics[2].Hex()),
+				toAddress:       indexer.EthereumChecksumAddress(eLog.Topics[3].Hex()),
+				contractAddress: contractAddress,
+				tokenID:         tokenID.Text(10),
+				amount:          value.Text(10),
+			},
+		}, nil
+	case indexer.TransferBatchEventSignature:
+		tokenIDs, values, err := indexer.ParseERC1155BatchTransferData(eLog)
+		if err != nil {
+			return nil, err
+		}
+
+		transfers := make([]nftTransfer, 0, len(tokenIDs))
+		for i, tokenID := range tokenIDs {
+			transfers = append(transfers, nftTransfer{
+				fromAddress:     indexer.EthereumChecksumAddress(eLog.Topics[2].Hex()),
+				toAddress:       indexer.EthereumChecksumAddress(eLog.Topics[3].Hex()),
+				contractAddress: contractAddress,
+				tokenID:         tokenID.Text(10),
+				amount:          values[i].Text(10),
+				batchIndex:      uint(i),
+			})
+		}
+
+		return transfers, nil
+	default:
+		return nil, errors.New("unsupported event")
+	}
+}
+
 func (e *EthereumEventsEmitter) processNftTransferLog(ctx context.Context, eLog types.Log) {
 	paringStartTime := time.Now()
 	log.InfoWithContext(ctx, "start processing ethereum log",
@@ -252,21 +390,9 @@ func (e *EthereumEventsEmitter) processNftTransferLog(ctx context.Context, eLog
 		zap.Time("time", paringStartTime))
 
 	if topicLen := len(eLog.Topics); topicLen == 4 {
-		contractAddress := indexer.EthereumChecksumAddress(eLog.Address.String())
-		var fromAddress, toAddress string
-		var tokenIDHash common.Hash
-
-		switch eLog.Topics[0].Hex() {
-		case indexer.TransferEventSignature:
-			fromAddress = indexer.EthereumChecksumAddress(eLog.Topics[1].Hex())
-			toAddress = indexer.EthereumChecksumAddress(eLog.Topics[2].Hex())
-			tokenIDHash = eLog.Topics[3]
-		case indexer.TransferSingleEventSignature:
-			fromAddress = indexer.EthereumChecksumAddress(eLog.Topics[2].Hex())
-			toAddress = indexer.EthereumChecksumAddress(eLog.Topics[3].Hex())
-			tokenIDHash = common.BytesToHash(eLog.Data[0:32])
-		default:
-			log.ErrorWithContext(ctx, errors.New("unsupported event"))
+		transfers, err := parseNftTransferLog(eLog)
+		if err != nil {
+			log.ErrorWithContext(ctx, err)
 			return
 		}
 
@@ -276,33 +402,119 @@ func (e *EthereumEventsEmitter) processNftTransferLog(ctx context.Context, eLog
 			return
 		}
 
-		log.InfoWithContext(ctx, "receive transfer event on ethereum",
-			zap.String("from", fromAddress),
-			zap.String("to", toAddress),
-			zap.String("contractAddress", contractAddress),
-			zap.String("tokenIDHash", tokenIDHash.Hex()),
-			zap.String("txID", eLog.TxHash.Hex()),
-			zap.Uint("txIndex", eLog.TxIndex),
-			zap.String("txTime", txTime.String()),
-		)
+		for _, t := range transfers {
+			log.InfoWithContext(ctx, "receive transfer event on ethereum",
+				zap.String("from", t.fromAddress),
+				zap.String("to", t.toAddress),
+				zap.String("contractAddress", t.contractAddress),
+				zap.String("tokenID", t.tokenID),
+				zap.String("amount", t.amount),
+				zap.String("txID", eLog.TxHash.Hex()),
+				zap.Uint("txIndex", eLog.TxIndex),
+				zap.String("txTime", txTime.String()),
+			)
+
+			eventType := "transfer"
+			if t.fromAddress == indexer.EthereumZeroAddress {
+				eventType = "mint"
+			} else if t.toAddress == indexer.EthereumZeroAddress {
+				eventType = "burned"
+			}
 
-		eventType := "transfer"
-		if fromAddress == indexer.EthereumZeroAddress {
-			eventType = "mint"
-		} else if toAddress == indexer.EthereumZeroAddress {
-			eventType = "burned"
+			if err := e.PushNftEvent(ctx, eventType, t.fromAddress, t.toAddress, t.contractAddress, utils.EthereumBlockchain, t.tokenID, t.amount, eLog.TxHash.Hex(), eLog.Index, t.batchIndex, txTime); err != nil {
+				log.ErrorWithContext(ctx, errors.New("gRPC request failed"), zap.Error(err), log.SourceGRPC)
+				return
+			}
 		}
+	}
+
+	e.saveLastStoppedBlock(ctx, emitter.SubscriptionNftTransfer, eLog.BlockNumber)
+}
+
+// revertNftTransferLog pushes compensating events for a transfer log of an orphaned block
+func (e *EthereumEventsEmitter) revertNftTransferLog(ctx context.Context, eLog types.Log) {
+	if len(eLog.Topics) != 4 {
+		return
+	}
+
+	transfers, err := parseNftTransferLog(eLog)
+	if err != nil {
+		log.ErrorWithContext(ctx, err)
+		return
+	}
+
+	// the orphaned block may not be retrievable anymore. use the receiving time instead
+	txTime, err := indexer.GetETHBlockTime(ctx, e.cacheStore, e.wsClient, eLog.BlockHash)
+	if err != nil {
+		log.WarnWithContext(ctx, "fail to get the block time of an orphaned block", zap.Error(err))
+		txTime = time.Now()
+	}
+
+	for _, t := range transfers {
+		log.InfoWithContext(ctx, "revert transfer event on ethereum",
+			zap.String("from", t.fromAddress),
+			zap.String("to", t.toAddress),
+			zap.String("contractAddress", t.contractAddress),
+			zap.String("tokenID", t.tokenID),
+			zap.String("amount", t.amount),
+			zap.String("txID", eLog.TxHash.Hex()),
+			zap.Uint64("blockNumber", eLog.BlockNumber),
+			zap.String("blockHash", eLog.BlockHash.Hex()),
+		)
 
-		if err := e.PushNftEvent(ctx, eventType, fromAddress, toAddress, contractAddress, utils.EthereumBlockchain, tokenIDHash.Big().Text(10), eLog.TxHash.Hex(), eLog.Index, txTime); err != nil {
+		if err := e.PushRevertedNftEvent(ctx, t.fromAddress, t.toAddress, t.contractAddress, utils.EthereumBlockchain, t.tokenID, t.amount, eLog.TxHash.Hex(), eLog.Index, t.batchIndex, txTime); err != nil {
 			log.ErrorWithContext(ctx, errors.New("gRPC request failed"), zap.Error(err), log.SourceGRPC)
 			return
 		}
 	}
+}
+
+// revertSeriesRegistryLog pushes a compensating event for a series registry log of an orphaned
+// block. The event processor reconciles the collections of the log against the contract.
+func (e *EthereumEventsEmitter) revertSeriesRegistryLog(ctx context.Context, eLog types.Log) {
+	log.WarnWithContext(ctx, "series registry log is reverted by chain reorganization",
+		zap.String("txID", eLog.TxHash.Hex()),
+		zap.Uint("logIndex", eLog.Index),
+		zap.Uint64("blockNumber", eLog.BlockNumber),
+		zap.String("blockHash", eLog.BlockHash.Hex()),
+	)
+
+	contract, err := seriesRegistry.NewSeriesRegistry(common.HexToAddress(e.seriesRegistryContract), e.wsClient)
+	if err != nil {
+		log.ErrorWithContext(ctx, errors.New("fail to create series registry contract instance"), zap.Error(err))
+		return
+	}
+
+	_, data, err := indexer.ParseSeriesRegistryLog(contract, eLog)
+	if err != nil {
+		log.ErrorWithContext(ctx, errors.New("fail to parse series registry event"), zap.Error(err))
+		return
+	}
+
+	// the orphaned block may not be retrievable anymore. use the receiving time instead
+	txTime, err := indexer.GetETHBlockTime(ctx, e.cacheStore, e.wsClient, eLog.BlockHash)
+	if err != nil {
+		log.WarnWithContext(ctx, "fail to get the block time of an orphaned block", zap.Error(err))
+		txTime = time.Now()
+	}
+
+	contractAddress := indexer.EthereumChecksumAddress(eLog.Address.String())
+	if err := e.PushRevertedSeriesRegistryEvent(ctx, contractAddress, eLog.TxHash.Hex(), data, eLog.Index, txTime); err != nil {
+		log.ErrorWithContext(ctx, errors.New("gRPC request failed"), zap.Error(err), log.SourceGRPC)
+		return
+	}
+}
 
-	if eLog.BlockNumber > currentLastStoppedBlock {
-		currentLastStoppedBlock = eLog.BlockNumber
-		if err := e.parameterStore.PutString(ctx, e.lastBlockKeyName, strconv.FormatUint(currentLastStoppedBlock, 10)); err != nil {
-			log.ErrorWithContext(ctx, errors.New("error put parameterStore"), zap.Error(err), log.SourceGRPC)
+// saveLastStoppedBlock saves the block number of the latest emitted log of a subscription
+func (e *EthereumEventsEmitter) saveLastStoppedBlock(ctx context.Context, subscription string, blockNumber uint64) {
+	e.checkpointLock.Lock()
+	defer e.checkpointLock.Unlock()
+
+	if blockNumber > e.lastStoppedBlocks[subscription] {
+		e.lastStoppedBlocks[subscription] = blockNumber
+		if err := e.checkpointStore.SaveCheckpoint(ctx, utils.EthereumBlockchain, subscription, blockNumber); err != nil {
+			log.ErrorWithContext(ctx, errors.New("error save checkpoint"),
+				zap.String("subscription", subscription), zap.Error(err))
 			return
 		}
 	}
@@ -329,83 +541,9 @@ func (e *EthereumEventsEmitter) processSeriesRegistryLog(ctx context.Context, eL
 		return
 	}
 
-	var data map[string]interface{}
-	var eventType string
-	switch eLog.Topics[0].Hex() {
-	case indexer.SeriesRegistryEventRegisterSeriesSignature:
-		eventType = "register_series"
-		ev, err := contract.ParseRegisterSeries(eLog)
-		if err != nil {
-			log.ErrorWithContext(ctx, errors.New("fail to parse register series event"), zap.Error(err))
-			return
-		}
-		data = map[string]interface{}{
-			"series_id": ev.SeriesID.Text(10),
-		}
-	case indexer.SeriesRegistryEventUpdateSeriesSignature:
-		eventType = "update_series"
-		ev, err := contract.ParseUpdateSeries(eLog)
-		if err != nil {
-			log.ErrorWithContext(ctx, errors.New("fail to parse update series event"), zap.Error(err))
-			return
-		}
-		data = map[string]interface{}{
-			"series_id": ev.SeriesID.Text(10),
-		}
-	case indexer.SeriesRegistryEventDeleteSeriesSignature:
-		eventType = "delete_series"
-		ev, err := contract.ParseDeleteSeries(eLog)
-		if err != nil {
-			log.ErrorWithContext(ctx, errors.New("fail to parse delete series event"), zap.Error(err))
-			return
-		}
-		data = map[string]interface{}{
-			"series_id": ev.SeriesID.Text(10),
-		}
-	case indexer.SeriesRegistryEventUpdateArtistAddressSignature:
-		eventType = "update_artist_address"
-		ev, err := contract.ParseUpdateArtistAddress(eLog)
-		if err != nil {
-			log.ErrorWithContext(ctx, errors.New("fail to parse update artist address event"), zap.Error(err))
-			return
-		}
-		data = map[string]interface{}{
-			"old_address": ev.OldAddress.Hex(),
-			"new_address": ev.NewAddress.Hex(),
-		}
-	case indexer.SeriesRegistryEventOptInCollaborationSignature:
-		eventType = "opt_in_collaboration"
-		ev, err := contract.ParseOptInCollaboration(eLog)
-		if err != nil {
-			log.ErrorWithContext(ctx, errors.New("fail to parse opt in collaboration event"), zap.Error(err))
-			return
-		}
-		data = map[string]interface{}{
-			"series_id": ev.SeriesID.Text(10),
-		}
-	case indexer.SeriesRegistryEventOptOutSeriesSignature:
-		eventType = "opt_out_series"
-		ev, err := contract.ParseOptOutSeries(eLog)
-		if err != nil {
-			log.ErrorWithContext(ctx, errors.New("fail to parse opt out series event"), zap.Error(err))
-			return
-		}
-		data = map[string]interface{}{
-			"series_id": ev.SeriesID.Text(10),
-		}
-	case indexer.SeriesRegistryEventAssignSeriesSignature:
-		eventType = "assign_series"
-		ev, err := contract.ParseAssignSeries(eLog)
-		if err != nil {
-			log.ErrorWithContext(ctx, errors.New("fail to parse assign series event"), zap.Error(err))
-			return
-		}
-		data = map[string]interface{}{
-			"old_address": ev.AssignerAddress.Hex(),
-			"new_address": ev.AssigneeAddress.Hex(),
-		}
-	default:
-		log.ErrorWithContext(ctx, errors.New("unsupported event"))
+	eventType, data, err := indexer.ParseSeriesRegistryLog(contract, eLog)
+	if err != nil {
+		log.ErrorWithContext(ctx, errors.New("fail to parse series registry event"), zap.Error(err))
 		return
 	}
 
@@ -423,13 +561,7 @@ func (e *EthereumEventsEmitter) processSeriesRegistryLog(ctx context.Context, eL
 		return
 	}
 
-	if eLog.BlockNumber > currentLastStoppedBlock {
-		currentLastStoppedBlock = eLog.BlockNumber
-		if err := e.parameterStore.PutString(ctx, e.lastBlockKeyName, strconv.FormatUint(currentLastStoppedBlock, 10)); err != nil {
-			log.ErrorWithContext(ctx, errors.New("error put parameterStore"), zap.Error(err), log.SourceGRPC)
-			return
-		}
-	}
+	e.saveLastStoppedBlock(ctx, emitter.SubscriptionSeriesRegistry, eLog.BlockNumber)
 }
 
 func (e *EthereumEventsEmitter) Close() {
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+
+	indexer "github.com/feral-file/ff-indexer"
+)
+
+func TestParseNftTransferLogTransferBatch(t *testing.T) {
+	uint256Array, err := abi.NewType("uint256[]", "", nil)
+	assert.NoError(t, err)
+
+	data, err := abi.Arguments{{Type: uint256Array}, {Type: uint256Array}}.Pack(
+		[]*big.Int{big.NewInt(1), big.NewInt(2), big.NewInt(1)},
+		[]*big.Int{big.NewInt(10), big.NewInt(20), big.NewInt(30)},
+	)
+	assert.NoError(t, err)
+
+	from := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	to := common.HexToAddress("0x0000000000000000000000000000000000000002")
+	transfers, err := parseNftTransferLog(types.Log{
+		Address: common.HexToAddress("0x0000000000000000000000000000000000000003"),
+		Topics: []common.Hash{
+			common.HexToHash(indexer.TransferBatchEventSignature),
+			common.HexToHash("0x0000000000000000000000000000000000000004"),
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+		},
+		Data: data,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, transfers, 3)
+
+	assert.Equal(t, "1", transfers[0].tokenID)
+	assert.Equal(t, "10", transfers[0].amount)
+	assert.Equal(t, uint(0), transfers[0].batchIndex)
+	assert.Equal(t, "2", transfers[1].tokenID)
+	assert.Equal(t, "20", transfers[1].amount)
+	assert.Equal(t, uint(1), transfers[1].batchIndex)
+	// a repeated token id is kept apart by its position in the batch
+	assert.Equal(t, "1", transfers[2].tokenID)
+	assert.Equal(t, "30", transfers[2].amount)
+	assert.Equal(t, uint(2), transfers[2].batchIndex)
+	for _, transfer := range transfers {
+		assert.Equal(t, from.Hex(), transfer.fromAddress)
+		assert.Equal(t, to.Hex(), transfer.toAddress)
+		assert.Equal(t, "0x0000000000000000000000000000000000000003", transfer.contractAddress)
+	}
+}
@@ -0,0 +1,286 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	indexerWorker "github.com/feral-file/ff-indexer/background/worker"
+	"github.com/feral-file/ff-indexer/cadence"
+)
+
+// SeriesRegistryReplayer replays the series registry logs of a block range
+type SeriesRegistryReplayer interface {
+	ReplaySeriesRegistry(ctx context.Context, opts SeriesRegistryReplayOptions) (*SeriesRegistryReplayReport, error)
+}
+
+// AdminServer serves the endpoints to operate the event queues
+type AdminServer struct {
+	// ctx is the context of the background jobs, which is set once the server runs
+	ctx      context.Context
+	address  string
+	apiToken string
+	store    EventStore
+	worker   *cadence.WorkerClient
+	replays  *replayJobs
+	route    *gin.Engine
+}
+
+func NewAdminServer(address, apiToken string, store EventStore, worker *cadence.WorkerClient, replayer SeriesRegistryReplayer) *AdminServer {
+	s := &AdminServer{
+		ctx:      context.Background(),
+		address:  address,
+		apiToken: apiToken,
+		store:    store,
+		worker:   worker,
+		replays:  newReplayJobs(replayer),
+		route:    gin.New(),
+	}
+	s.setupRoute()
+
+	return s
+}
+
+func (s *AdminServer) setupRoute() {
+	admin := s.route.Group("/admin", tokenAuthenticate("API-TOKEN", s.apiToken))
+
+	admin.GET("/nft-events/dead-letter", s.ListDeadLetterNftEvents)
+	admin.GET("/nft-events/:id", s.GetNftEvent)
+	admin.POST("/nft-events/:id/requeue", s.RequeueNftEvent)
+
+	admin.GET("/series-registry-events/dead-letter", s.ListDeadLetterSeriesRegistryEvents)
+	admin.GET("/series-registry-events/:id", s.GetSeriesRegistryEvent)
+	admin.POST("/series-registry-events/:id/requeue", s.RequeueSeriesRegistryEvent)
+	admin.GET("/series-registry-events/:id/workflow", s.GetSeriesRegistryEventWorkflow)
+
+	admin.POST("/series-registry/replay", s.ReplaySeriesRegistry)
+	admin.GET("/series-registry/replay/:id", s.GetSeriesRegistryReplay)
+}
+
+// Run starts the admin server. It is disabled when the address or the api token is not set.
+// The background jobs started by the server are stopped once the context is done.
+func (s *AdminServer) Run(ctx context.Context) error {
+	if s.address == "" || s.apiToken == "" {
+		log.Info("admin server is disabled")
+		return nil
+	}
+
+	s.ctx = ctx
+
+	return s.route.Run(s.address)
+}
+
+func tokenAuthenticate(tokenKey, tokenValue string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if subtle.ConstantTimeCompare([]byte(c.GetHeader(tokenKey)), []byte(tokenValue)) != 1 {
+			abortWithError(c, http.StatusForbidden, "invalid api token", fmt.Errorf("invalid api token"))
+			return
+		}
+		c.Next()
+	}
+}
+
+func abortWithError(c *gin.Context, code int, message string, traceErr error) {
+	if code == http.StatusInternalServerError {
+		log.ErrorWithContext(c, errors.New(message), zap.Error(traceErr))
+	} else {
+		log.WarnWithContext(c, message, zap.Error(traceErr))
+	}
+
+	c.AbortWithStatusJSON(code, gin.H{
+		"message": message,
+	})
+}
+
+type PaginationParams struct {
+	Offset int `form:"offset"`
+	Size   int `form:"size"`
+}
+
+// bindPagination reads the pagination from the query string
+func bindPagination(c *gin.Context) (Pagination, bool) {
+	params := PaginationParams{
+		Offset: 0,
+		Size:   50,
+	}
+
+	if err := c.BindQuery(&params); err != nil {
+		abortWithError(c, http.StatusBadRequest, "invalid parameters", err)
+		return Pagination{}, false
+	}
+
+	if params.Size <= 0 || params.Size > 200 || params.Offset < 0 {
+		abortWithError(c, http.StatusBadRequest, "invalid parameters", fmt.Errorf("invalid pagination"))
+		return Pagination{}, false
+	}
+
+	return Pagination{Limit: params.Size, Offset: params.Offset}, true
+}
+
+// ListDeadLetterNftEvents returns the dead-lettered nft events
+func (s *AdminServer) ListDeadLetterNftEvents(c *gin.Context) {
+	pagination, ok := bindPagination(c)
+	if !ok {
+		return
+	}
+
+	events, err := s.store.GetNftEventsByStatus(c, NftEventStatusDeadLetter, pagination)
+	if err != nil {
+		abortWithError(c, http.StatusInternalServerError, "fail to query events", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
+
+// GetNftEvent returns a queued nft event
+func (s *AdminServer) GetNftEvent(c *gin.Context) {
+	event, err := s.store.GetNftEvent(c, c.Param("id"))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			abortWithError(c, http.StatusNotFound, "event not found", err)
+			return
+		}
+		abortWithError(c, http.StatusInternalServerError, "fail to query event", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, event)
+}
+
+// RequeueNftEvent moves a dead-lettered nft event back to the queue
+func (s *AdminServer) RequeueNftEvent(c *gin.Context) {
+	if err := s.store.RequeueNftEvent(c, c.Param("id")); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			abortWithError(c, http.StatusNotFound, "dead-lettered event not found", err)
+			return
+		}
+		abortWithError(c, http.StatusInternalServerError, "fail to requeue event", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"ok": 1,
+	})
+}
+
+// ListDeadLetterSeriesRegistryEvents returns the dead-lettered series registry events
+func (s *AdminServer) ListDeadLetterSeriesRegistryEvents(c *gin.Context) {
+	pagination, ok := bindPagination(c)
+	if !ok {
+		return
+	}
+
+	events, err := s.store.GetSeriesRegistryEventsByStatus(c, SeriesRegistryEventStatusDeadLetter, pagination)
+	if err != nil {
+		abortWithError(c, http.StatusInternalServerError, "fail to query events", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, events)
+}
+
+// GetSeriesRegistryEvent returns a series registry event
+func (s *AdminServer) GetSeriesRegistryEvent(c *gin.Context) {
+	event, err := s.store.GetSeriesRegistryEvent(c, c.Param("id"))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			abortWithError(c, http.StatusNotFound, "event not found", err)
+			return
+		}
+		abortWithError(c, http.StatusInternalServerError, "fail to query event", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, event)
+}
+
+// RequeueSeriesRegistryEvent moves a dead-lettered series registry event back to the queue
+func (s *AdminServer) RequeueSeriesRegistryEvent(c *gin.Context) {
+	if err := s.store.RequeueSeriesRegistryEvent(c, c.Param("id")); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			abortWithError(c, http.StatusNotFound, "dead-lettered event not found", err)
+			return
+		}
+		abortWithError(c, http.StatusInternalServerError, "fail to requeue event", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"ok": 1,
+	})
+}
+
+// GetSeriesRegistryEventWorkflow returns the progress of the workflow which is started by a series registry event
+func (s *AdminServer) GetSeriesRegistryEventWorkflow(c *gin.Context) {
+	event, err := s.store.GetSeriesRegistryEvent(c, c.Param("id"))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			abortWithError(c, http.StatusNotFound, "event not found", err)
+			return
+		}
+		abortWithError(c, http.StatusInternalServerError, "fail to query event", err)
+		return
+	}
+
+	if event.WorkflowID == "" {
+		abortWithError(c, http.StatusNotFound, "event has no workflow", fmt.Errorf("no workflow for event %s", event.ID))
+		return
+	}
+
+	status, err := indexerWorker.GetIndexCollectionStatus(c, s.worker, event.WorkflowID)
+	if err != nil {
+		abortWithError(c, http.StatusInternalServerError, "fail to query workflow", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"workflowID": event.WorkflowID,
+		"status":     status,
+	})
+}
+
+// ReplaySeriesRegistry starts a background job to replay the series registry logs of a block range
+// and reconcile the collections. It returns the job, whose report is read by GetSeriesRegistryReplay.
+func (s *AdminServer) ReplaySeriesRegistry(c *gin.Context) {
+	var opts SeriesRegistryReplayOptions
+	if err := c.BindJSON(&opts); err != nil {
+		abortWithError(c, http.StatusBadRequest, "invalid parameters", err)
+		return
+	}
+
+	if opts.ToBlock != 0 && opts.FromBlock > opts.ToBlock {
+		abortWithError(c, http.StatusBadRequest, "invalid parameters", fmt.Errorf("invalid block range"))
+		return
+	}
+
+	job, err := s.replays.start(s.ctx, opts)
+	if err != nil {
+		if errors.Is(err, ErrReplayJobRunning) {
+			abortWithError(c, http.StatusConflict, "a replay is running", err)
+			return
+		}
+		abortWithError(c, http.StatusInternalServerError, "fail to replay series registry", err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, job)
+}
+
+// GetSeriesRegistryReplay returns a series registry replay job and its report once it is finished
+func (s *AdminServer) GetSeriesRegistryReplay(c *gin.Context) {
+	job, ok := s.replays.get(c.Param("id"))
+	if !ok {
+		abortWithError(c, http.StatusNotFound, "replay not found", fmt.Errorf("replay not found"))
+		return
+	}
+
+	c.JSON(http.StatusOK, job)
+}
@@ -18,13 +18,13 @@ import (
 
 type GRPCServer struct {
 	server     *grpc.Server
-	eventQueue *EventQueue
+	eventQueue EventQueue
 
 	network string
 	address string
 }
 
-func NewGRPCServer(network, address string, eventQueue *EventQueue) *GRPCServer {
+func NewGRPCServer(network, address string, eventQueue EventQueue) *GRPCServer {
 	server := grpc.NewServer()
 	grpcHandler := NewGRPCHandler(eventQueue)
 
@@ -55,10 +55,10 @@ func (s *GRPCServer) Run() error {
 type GRPCHandler struct {
 	pb.UnimplementedEventProcessorServer
 
-	eventQueue *EventQueue
+	eventQueue EventQueue
 }
 
-func NewGRPCHandler(eventQueue *EventQueue) *GRPCHandler {
+func NewGRPCHandler(eventQueue EventQueue) *GRPCHandler {
 	return &GRPCHandler{
 		eventQueue: eventQueue,
 	}
@@ -66,12 +66,17 @@ func NewGRPCHandler(eventQueue *EventQueue) *GRPCHandler {
 
 // PushNftEvent handles PushNftEvent requests and save it to event store
 func (t *GRPCHandler) PushNftEvent(
-	_ context.Context,
+	ctx context.Context,
 	i *pb.NftEventInput,
 ) (*pb.EventOutput, error) {
 	log.Debug("receive event input", zap.Any("input", i))
 
-	if err := t.eventQueue.PushNftEvent(NFTEvent{
+	amount := i.Amount
+	if amount == "" {
+		amount = "1"
+	}
+
+	if err := t.eventQueue.PushNftEvent(ctx, NFTEvent{
 		Type:       i.Type,
 		Blockchain: i.Blockchain,
 		Contract:   i.Contract,
@@ -81,6 +86,8 @@ func (t *GRPCHandler) PushNftEvent(
 		TXID:       i.TXID,
 		TXTime:     i.TXTime.AsTime(),
 		EventIndex: uint(i.EventIndex),
+		BatchIndex: uint(i.BatchIndex),
+		Amount:     amount,
 		Stage:      NftEventStages[1],
 		Status:     NftEventStatusCreated,
 	}); err != nil {
@@ -97,7 +104,7 @@ func (t *GRPCHandler) PushNftEvent(
 
 // PushSeriesRegistryEvent handles PushSeriesRegistryEvent requests and save it to event store
 func (t *GRPCHandler) PushSeriesRegistryEvent(
-	_ context.Context,
+	ctx context.Context,
 	i *pb.SeriesRegistryEventInput,
 ) (*pb.EventOutput, error) {
 	log.Debug("receive event input", zap.Any("input", i))
@@ -119,7 +126,7 @@ func (t *GRPCHandler) PushSeriesRegistryEvent(
 		se.Data = b
 	}
 
-	if err := t.eventQueue.PushSeriesRegistryEvent(se); err != nil {
+	if _, err := t.eventQueue.PushSeriesRegistryEvent(ctx, se); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"time"
+)
+
+const (
+	DefaultStageWorkers   = 1
+	DefaultLeaseBatchSize = 10
+	DefaultLeaseDuration  = 5 * time.Minute
+)
+
+// WorkerPoolConfig decides how many workers process a stage and how events are leased to them
+type WorkerPoolConfig struct {
+	Workers       int
+	StageWorkers  map[string]int
+	BatchSize     int
+	LeaseDuration time.Duration
+}
+
+// NewWorkerPoolConfig returns a worker pool config. Zero values fall back to the defaults.
+func NewWorkerPoolConfig(workers int, stageWorkers map[string]int, batchSize int, leaseDuration time.Duration) WorkerPoolConfig {
+	if workers <= 0 {
+		workers = DefaultStageWorkers
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultLeaseBatchSize
+	}
+	if leaseDuration <= 0 {
+		leaseDuration = DefaultLeaseDuration
+	}
+
+	return WorkerPoolConfig{
+		Workers:       workers,
+		StageWorkers:  stageWorkers,
+		BatchSize:     batchSize,
+		LeaseDuration: leaseDuration,
+	}
+}
+
+// WorkersOf returns the number of workers of a stage
+func (c WorkerPoolConfig) WorkersOf(stage string) int {
+	if n := c.StageWorkers[stage]; n > 0 {
+		return n
+	}
+	return c.Workers
+}
+
+// firstEventPerToken keeps the first event of every token, up to the size. Events
+// are expected in the created order, so the kept event is the oldest of the token.
+func firstEventPerToken(events []NFTEvent, size int) []NFTEvent {
+	tokens := map[string]struct{}{}
+	result := make([]NFTEvent, 0, min(len(events), size))
+	for _, event := range events {
+		if len(result) == size {
+			break
+		}
+
+		indexID := event.Blockchain + "-" + event.Contract + "-" + event.TokenID
+		if _, ok := tokens[indexID]; ok {
+			continue
+		}
+		tokens[indexID] = struct{}{}
+		result = append(result, event)
+	}
+
+	return result
+}
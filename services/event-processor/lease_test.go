@@ -0,0 +1,45 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFirstEventPerToken(t *testing.T) {
+	events := []NFTEvent{
+		{ID: "1", Blockchain: "ethereum", Contract: "0x1", TokenID: "1"},
+		{ID: "2", Blockchain: "ethereum", Contract: "0x1", TokenID: "2"},
+		{ID: "3", Blockchain: "ethereum", Contract: "0x1", TokenID: "1"},
+		{ID: "4", Blockchain: "tezos", Contract: "0x1", TokenID: "1"},
+		{ID: "5", Blockchain: "ethereum", Contract: "0x2", TokenID: "1"},
+	}
+
+	ids := func(events []NFTEvent) []string {
+		result := []string{}
+		for _, e := range events {
+			result = append(result, e.ID)
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"1", "2", "4", "5"}, ids(firstEventPerToken(events, 10)))
+	assert.Equal(t, []string{"1", "2"}, ids(firstEventPerToken(events, 2)))
+	assert.Empty(t, firstEventPerToken(nil, 10))
+}
+
+func TestWorkerPoolConfig(t *testing.T) {
+	config := NewWorkerPoolConfig(0, map[string]int{"stage_1_init": 8}, 0, 0)
+
+	assert.Equal(t, 8, config.WorkersOf("stage_1_init"))
+	assert.Equal(t, DefaultStageWorkers, config.WorkersOf("stage_2_full_sync"))
+	assert.Equal(t, DefaultLeaseBatchSize, config.BatchSize)
+	assert.Equal(t, DefaultLeaseDuration, config.LeaseDuration)
+}
+
+func TestNftEventStageOrder(t *testing.T) {
+	assert.Equal(t, "CASE l.stage WHEN 'stage_1_init' THEN 1 WHEN 'stage_2_full_sync' THEN 2"+
+		" WHEN 'stage_3_send_notification' THEN 3 WHEN 'stage_4_send_to_feed' THEN 4"+
+		" WHEN 'stage_5_index_token_sale' THEN 5 WHEN 'stage_11_double_sync_token' THEN 11 END",
+		nftEventStageOrder("l.stage"))
+}
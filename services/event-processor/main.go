@@ -49,6 +49,21 @@ func main() {
 		panic(err)
 	}
 
+	var queue EventQueue
+	switch backend := viper.GetString("events.queue.backend"); backend {
+	case "", EventQueueBackendPolling:
+		queue = NewPollingEventQueue(store)
+	case EventQueueBackendPostgresNotify:
+		notifyQueue, err := NewPostgresNotifyEventQueue(store, viper.GetString("store.dsn"))
+		if err != nil {
+			log.Panic("fail to listen events", zap.Error(err))
+		}
+		go notifyQueue.Listen(ctx)
+		queue = notifyQueue
+	default:
+		log.Panic("unsupported event queue backend", zap.String("backend", backend))
+	}
+
 	indexerGRPC, err := grpcGateway.NewGRPCClient(viper.GetString("indexer_grpc.endpoint"))
 	if err != nil {
 		log.Fatal("fail to connect indexer grpc", zap.Error(err))
@@ -75,6 +90,24 @@ func main() {
 	}
 	eventExpiryDuration := time.Duration(eventExpiryDays) * time.Hour * 24
 
+	retryPolicy := NewRetryPolicy(
+		viper.GetInt("events.retry.max_attempts"),
+		viper.GetDuration("events.retry.base_delay"),
+		viper.GetDuration("events.retry.max_delay"),
+	)
+
+	var stageWorkers map[string]int
+	if err := viper.UnmarshalKey("events.workers.stages", &stageWorkers); err != nil {
+		log.Panic("invalid stage workers", zap.Error(err))
+	}
+
+	workerPool := NewWorkerPoolConfig(
+		viper.GetInt("events.workers.default"),
+		stageWorkers,
+		viper.GetInt("events.lease.batch_size"),
+		viper.GetDuration("events.lease.duration"),
+	)
+
 	rpcClient, err := ethclient.Dial(viper.GetString("ethereum.rpc_url"))
 	if err != nil {
 		log.Panic(err.Error(), zap.Error(err))
@@ -86,9 +119,14 @@ func main() {
 		viper.GetStringSlice("ipfs.preferred_gateways"),
 		checkInterval,
 		eventExpiryDuration,
+		retryPolicy,
+		workerPool,
 		viper.GetString("server.network"),
 		viper.GetString("server.address"),
-		NewPostgresEventStore(db),
+		viper.GetString("admin.address"),
+		viper.GetString("admin.api_token"),
+		viper.GetString("metrics.address"),
+		queue,
 		indexerGRPC,
 		cadenceClient,
 		indexerStore,
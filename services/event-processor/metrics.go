@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+)
+
+const (
+	metricsQueueNft            = "nft"
+	metricsQueueSeriesRegistry = "series_registry"
+
+	backlogMetricsInterval = 30 * time.Second
+)
+
+var (
+	eventBacklogGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "event_processor_backlog_events",
+		Help: "The number of queued events by stage and status",
+	}, []string{"queue", "stage", "status"})
+
+	oldestPendingEventAgeGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "event_processor_oldest_pending_event_age_seconds",
+		Help: "The age of the oldest event which is waiting or being processed in a stage",
+	}, []string{"queue", "stage"})
+
+	stageDurationHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "event_processor_stage_duration_seconds",
+		Help:    "The processing duration of an event in a stage",
+		Buckets: prometheus.ExponentialBuckets(0.01, 2, 15),
+	}, []string{"queue", "stage"})
+
+	stageFailureCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "event_processor_stage_failures_total",
+		Help: "The number of failed attempts by stage and event type",
+	}, []string{"queue", "stage", "type"})
+)
+
+// EventBacklog is the number of events of a stage in a status
+type EventBacklog struct {
+	Stage           string
+	Status          string
+	Count           int64
+	OldestCreatedAt time.Time
+}
+
+// observeStage records the processing duration and the failure of an event in a stage
+func observeStage(queue, stage, eventType string, startedAt time.Time, err error) {
+	stageDurationHistogram.WithLabelValues(queue, stage).Observe(time.Since(startedAt).Seconds())
+	if err != nil {
+		stageFailureCounter.WithLabelValues(queue, stage, eventType).Inc()
+	}
+}
+
+// reportBacklog sets the backlog metrics of a queue. Dead-lettered events are not pending.
+func reportBacklog(queue string, backlog []EventBacklog, pendingStatuses []string, now time.Time) {
+	eventBacklogGauge.DeletePartialMatch(prometheus.Labels{"queue": queue})
+	oldestPendingEventAgeGauge.DeletePartialMatch(prometheus.Labels{"queue": queue})
+
+	oldest := map[string]time.Time{}
+	for _, b := range backlog {
+		eventBacklogGauge.WithLabelValues(queue, b.Stage, b.Status).Set(float64(b.Count))
+
+		for _, status := range pendingStatuses {
+			if b.Status != status {
+				continue
+			}
+			if o, ok := oldest[b.Stage]; !ok || b.OldestCreatedAt.Before(o) {
+				oldest[b.Stage] = b.OldestCreatedAt
+			}
+		}
+	}
+
+	for stage, createdAt := range oldest {
+		oldestPendingEventAgeGauge.WithLabelValues(queue, stage).Set(now.Sub(createdAt).Seconds())
+	}
+}
+
+// ReportBacklogMetrics reads the backlog of the queues in an interval until the context is done
+func (e *EventProcessor) ReportBacklogMetrics(ctx context.Context) {
+	ticker := time.NewTicker(backlogMetricsInterval)
+	defer ticker.Stop()
+
+	for {
+		if backlog, err := e.eventQueue.Store().GetNftEventBacklog(ctx); err != nil {
+			log.WarnWithContext(ctx, "fail to query the nft event backlog", zap.Error(err))
+		} else {
+			reportBacklog(metricsQueueNft, backlog, []string{
+				string(NftEventStatusCreated), string(NftEventStatusProcessing), string(NftEventStatusFailed),
+			}, time.Now())
+		}
+
+		if backlog, err := e.eventQueue.Store().GetSeriesRegistryEventBacklog(ctx); err != nil {
+			log.WarnWithContext(ctx, "fail to query the series registry event backlog", zap.Error(err))
+		} else {
+			reportBacklog(metricsQueueSeriesRegistry, backlog, []string{
+				string(SeriesRegistryEventStatusCreated), string(SeriesRegistryEventStatusProcessing), string(SeriesRegistryEventStatusFailed),
+			}, time.Now())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ServeMetrics serves the prometheus metrics at /metrics. It is disabled when the address is not set.
+func (e *EventProcessor) ServeMetrics(ctx context.Context) {
+	if e.metricsAddress == "" {
+		log.InfoWithContext(ctx, "metrics server is disabled")
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	server := &http.Server{
+		Addr:              e.metricsAddress,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	if err := server.ListenAndServe(); err != nil {
+		log.ErrorWithContext(ctx, errors.New("metrics server stopped with error"), zap.Error(err))
+	}
+}
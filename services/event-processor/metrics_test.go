@@ -0,0 +1,31 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportBacklog(t *testing.T) {
+	now := time.Now()
+
+	reportBacklog(metricsQueueNft, []EventBacklog{
+		{Stage: "stage_1_init", Status: "created", Count: 3, OldestCreatedAt: now.Add(-time.Minute)},
+		{Stage: "stage_1_init", Status: "failed", Count: 1, OldestCreatedAt: now.Add(-time.Hour)},
+		{Stage: "stage_2_full_sync", Status: "dead_letter", Count: 2, OldestCreatedAt: now.Add(-24 * time.Hour)},
+	}, []string{"created", "processing", "failed"}, now)
+
+	assert.Equal(t, float64(3), testutil.ToFloat64(eventBacklogGauge.WithLabelValues(metricsQueueNft, "stage_1_init", "created")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(eventBacklogGauge.WithLabelValues(metricsQueueNft, "stage_2_full_sync", "dead_letter")))
+	assert.Equal(t, time.Hour.Seconds(), testutil.ToFloat64(oldestPendingEventAgeGauge.WithLabelValues(metricsQueueNft, "stage_1_init")))
+
+	// the dead-lettered events are not pending
+	assert.Equal(t, 1, testutil.CollectAndCount(oldestPendingEventAgeGauge))
+
+	// the stages without events are removed
+	reportBacklog(metricsQueueNft, nil, []string{"created"}, now)
+	assert.Equal(t, 0, testutil.CollectAndCount(eventBacklogGauge))
+	assert.Equal(t, 0, testutil.CollectAndCount(oldestPendingEventAgeGauge))
+}
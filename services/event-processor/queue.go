@@ -2,32 +2,97 @@ package main
 
 import (
 	"context"
+	"time"
 )
 
-type EventQueue struct {
+const (
+	EventQueueBackendPolling        = "polling"
+	EventQueueBackendPostgresNotify = "postgres_notify"
+)
+
+// EventQueue queues the events for the processing stages. Workers lease events from
+// the queue and wait on it when there is nothing to process.
+type EventQueue interface {
+	Store() EventStore
+
+	PushNftEvent(ctx context.Context, event NFTEvent) error
+	LeaseNftEvents(ctx context.Context, owner string, duration time.Duration, size int, filters ...FilterOption) ([]NFTEvent, error)
+	BeginNftEventTx(ctx context.Context, event NFTEvent) (*NftEventTx, error)
+	// NotifyNftEvents wakes up the workers of a stage
+	NotifyNftEvents(ctx context.Context, stage string)
+	// NftEventsSignal returns a channel which is closed once new events may be ready for a stage.
+	// Workers take the signal before leasing, so a wake-up sent while leasing is not lost.
+	NftEventsSignal(stage string) <-chan struct{}
+
+	// PushSeriesRegistryEvent adds a series event and returns whether it is added. An event
+	// which is already in the queue is skipped.
+	PushSeriesRegistryEvent(ctx context.Context, event SeriesRegistryEvent) (bool, error)
+	GetSeriesRegistryEventTransaction(ctx context.Context, filters ...FilterOption) (*SeriesRegistryEventTx, error)
+	// SeriesRegistryEventsSignal returns a channel which is closed once new events may be ready for a stage
+	SeriesRegistryEventsSignal(stage string) <-chan struct{}
+}
+
+// PollingEventQueue is an event queue which workers poll in an interval
+type PollingEventQueue struct {
 	store EventStore
 }
 
-func NewEventQueue(store EventStore) *EventQueue {
-	return &EventQueue{
+func NewPollingEventQueue(store EventStore) *PollingEventQueue {
+	return &PollingEventQueue{
 		store: store,
 	}
 }
 
+func (q *PollingEventQueue) Store() EventStore {
+	return q.store
+}
+
 // PushNftEvent adds a nft event into event store
-func (q *EventQueue) PushNftEvent(event NFTEvent) error {
+func (q *PollingEventQueue) PushNftEvent(_ context.Context, event NFTEvent) error {
 	return q.store.CreateNftEvent(event)
 }
 
 // PushSeriesRegistryEvent adds a series event into event store
-func (q *EventQueue) PushSeriesRegistryEvent(event SeriesRegistryEvent) error {
+func (q *PollingEventQueue) PushSeriesRegistryEvent(_ context.Context, event SeriesRegistryEvent) (bool, error) {
 	return q.store.CreateSeriesRegistryEvent(event)
 }
 
-func (q *EventQueue) GetNftEventTransaction(ctx context.Context, filters ...FilterOption) (*NftEventTx, error) {
-	return q.store.GetNftEventTransaction(ctx, filters...)
+func (q *PollingEventQueue) LeaseNftEvents(ctx context.Context, owner string, duration time.Duration, size int, filters ...FilterOption) ([]NFTEvent, error) {
+	return q.store.LeaseNftEvents(ctx, owner, duration, size, filters...)
+}
+
+func (q *PollingEventQueue) BeginNftEventTx(ctx context.Context, event NFTEvent) (*NftEventTx, error) {
+	return q.store.BeginNftEventTx(ctx, event)
 }
 
-func (q *EventQueue) GetSeriesRegistryEventTransaction(ctx context.Context, filters ...FilterOption) (*SeriesRegistryEventTx, error) {
+func (q *PollingEventQueue) GetSeriesRegistryEventTransaction(ctx context.Context, filters ...FilterOption) (*SeriesRegistryEventTx, error) {
 	return q.store.GetSeriesRegistryEventTransaction(ctx, filters...)
 }
+
+// NotifyNftEvents does nothing since the workers poll the store
+func (q *PollingEventQueue) NotifyNftEvents(_ context.Context, _ string) {}
+
+// NftEventsSignal returns a nil channel since the workers poll the store
+func (q *PollingEventQueue) NftEventsSignal(_ string) <-chan struct{} {
+	return nil
+}
+
+// SeriesRegistryEventsSignal returns a nil channel since the workers poll the store
+func (q *PollingEventQueue) SeriesRegistryEventsSignal(_ string) <-chan struct{} {
+	return nil
+}
+
+// waitSignal blocks until a signal is closed or the timeout passes. A nil signal
+// waits for the timeout. It returns whether it is woken up by the signal.
+func waitSignal(ctx context.Context, signal <-chan struct{}, timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-signal:
+		return true
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+const (
+	nftEventsChannel            = "nft_events"
+	seriesRegistryEventsChannel = "series_registry_events"
+
+	listenerPingInterval = 90 * time.Second
+)
+
+// signalHub wakes up the waiters of a topic
+type signalHub struct {
+	sync.Mutex
+
+	signals map[string]chan struct{}
+}
+
+func newSignalHub() *signalHub {
+	return &signalHub{
+		signals: map[string]chan struct{}{},
+	}
+}
+
+// signal returns a channel which is closed on the next broadcast of a topic
+func (h *signalHub) signal(topic string) <-chan struct{} {
+	h.Lock()
+	defer h.Unlock()
+
+	c, ok := h.signals[topic]
+	if !ok {
+		c = make(chan struct{})
+		h.signals[topic] = c
+	}
+	return c
+}
+
+// broadcast wakes up all waiters of a topic
+func (h *signalHub) broadcast(topic string) {
+	h.Lock()
+	defer h.Unlock()
+
+	if c, ok := h.signals[topic]; ok {
+		close(c)
+		delete(h.signals, topic)
+	}
+}
+
+// broadcastAll wakes up all waiters
+func (h *signalHub) broadcastAll() {
+	h.Lock()
+	defer h.Unlock()
+
+	for topic, c := range h.signals {
+		close(c)
+		delete(h.signals, topic)
+	}
+}
+
+// PostgresNotifyEventQueue is an event queue which wakes up the workers by postgres
+// LISTEN/NOTIFY. The payload of a notification is the stage of new events. Workers
+// still poll in their check interval for the events which are ready later, like retries.
+type PostgresNotifyEventQueue struct {
+	*PollingEventQueue
+
+	store    *PostgresEventStore
+	listener *pq.Listener
+	hub      *signalHub
+}
+
+func NewPostgresNotifyEventQueue(store *PostgresEventStore, dsn string) (*PostgresNotifyEventQueue, error) {
+	listener := pq.NewListener(dsn, time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Warn("event listener error", zap.Int("event", int(event)), zap.Error(err))
+		}
+	})
+
+	for _, channel := range []string{nftEventsChannel, seriesRegistryEventsChannel} {
+		if err := listener.Listen(channel); err != nil {
+			_ = listener.Close()
+			return nil, err
+		}
+	}
+
+	return &PostgresNotifyEventQueue{
+		PollingEventQueue: NewPollingEventQueue(store),
+		store:             store,
+		listener:          listener,
+		hub:               newSignalHub(),
+	}, nil
+}
+
+// Listen dispatches the notifications to the waiting workers until the context is done
+func (q *PostgresNotifyEventQueue) Listen(ctx context.Context) {
+	defer q.listener.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case n := <-q.listener.Notify:
+			if n == nil {
+				// the connection is re-established and notifications could be lost
+				q.hub.broadcastAll()
+				continue
+			}
+			q.hub.broadcast(n.Channel + ":" + n.Extra)
+		case <-time.After(listenerPingInterval):
+			go func() {
+				if err := q.listener.Ping(); err != nil {
+					log.WarnWithContext(ctx, "fail to ping the event listener", zap.Error(err))
+				}
+			}()
+		}
+	}
+}
+
+func (q *PostgresNotifyEventQueue) notify(ctx context.Context, channel, stage string) {
+	if err := q.store.db.WithContext(ctx).Exec("SELECT pg_notify(?, ?)", channel, stage).Error; err != nil {
+		log.ErrorWithContext(ctx, errors.New("fail to notify events"), zap.String("channel", channel), zap.Error(err))
+	}
+}
+
+// PushNftEvent adds a nft event into event store and wakes up the workers of its stage
+func (q *PostgresNotifyEventQueue) PushNftEvent(ctx context.Context, event NFTEvent) error {
+	if err := q.PollingEventQueue.PushNftEvent(ctx, event); err != nil {
+		return err
+	}
+
+	q.notify(ctx, nftEventsChannel, event.Stage)
+	return nil
+}
+
+// PushSeriesRegistryEvent adds a series event into event store and wakes up the workers of its stage
+func (q *PostgresNotifyEventQueue) PushSeriesRegistryEvent(ctx context.Context, event SeriesRegistryEvent) (bool, error) {
+	created, err := q.PollingEventQueue.PushSeriesRegistryEvent(ctx, event)
+	if err != nil {
+		return false, err
+	}
+
+	if created {
+		q.notify(ctx, seriesRegistryEventsChannel, event.Stage)
+	}
+	return created, nil
+}
+
+func (q *PostgresNotifyEventQueue) NotifyNftEvents(ctx context.Context, stage string) {
+	q.notify(ctx, nftEventsChannel, stage)
+}
+
+func (q *PostgresNotifyEventQueue) NftEventsSignal(stage string) <-chan struct{} {
+	return q.hub.signal(nftEventsChannel + ":" + stage)
+}
+
+func (q *PostgresNotifyEventQueue) SeriesRegistryEventsSignal(stage string) <-chan struct{} {
+	return q.hub.signal(seriesRegistryEventsChannel + ":" + stage)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignalHubBroadcast(t *testing.T) {
+	hub := newSignalHub()
+
+	first := hub.signal("nft_events:stage_1_init")
+	second := hub.signal("nft_events:stage_1_init")
+	other := hub.signal("nft_events:stage_2_full_sync")
+
+	hub.broadcast("nft_events:stage_1_init")
+
+	assert.True(t, isClosed(first))
+	assert.True(t, isClosed(second))
+	assert.False(t, isClosed(other))
+
+	// waiters after a broadcast wait for the next one
+	assert.False(t, isClosed(hub.signal("nft_events:stage_1_init")))
+
+	hub.broadcastAll()
+	assert.True(t, isClosed(other))
+}
+
+func TestSignalHubWait(t *testing.T) {
+	hub := newSignalHub()
+
+	woken := make(chan bool)
+	go func() {
+		woken <- waitSignal(context.Background(), hub.signal("nft_events:stage_1_init"), time.Minute)
+	}()
+
+	assert.Eventually(t, func() bool {
+		hub.broadcast("nft_events:stage_1_init")
+		select {
+		case w := <-woken:
+			return w
+		default:
+			return false
+		}
+	}, time.Second, 5*time.Millisecond)
+
+	// a broadcast between taking the signal and waiting on it is not lost
+	signal := hub.signal("nft_events:stage_1_init")
+	hub.broadcast("nft_events:stage_1_init")
+	assert.True(t, waitSignal(context.Background(), signal, time.Minute))
+
+	assert.False(t, waitSignal(context.Background(), hub.signal("nft_events:stage_1_init"), 10*time.Millisecond))
+	assert.False(t, waitSignal(context.Background(), nil, 10*time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.False(t, waitSignal(ctx, hub.signal("nft_events:stage_1_init"), time.Minute))
+}
+
+func isClosed(c <-chan struct{}) bool {
+	select {
+	case <-c:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,447 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"time"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	seriesRegistry "github.com/bitmark-inc/feralfile-exhibition-smart-contract/go-binding/series-registry"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	indexer "github.com/feral-file/ff-indexer"
+)
+
+const DefaultReplayBlockBatchSize = uint64(2000)
+
+// CollectionDiffKind is the kind of difference between a series and its collection
+type CollectionDiffKind string
+
+const (
+	// CollectionDiffMissing means a series has no collection
+	CollectionDiffMissing CollectionDiffKind = "missing"
+	// CollectionDiffOrphaned means a collection remains after its series is deleted
+	CollectionDiffOrphaned CollectionDiffKind = "orphaned"
+	// CollectionDiffCreators means the collection creators are not the series artists
+	CollectionDiffCreators CollectionDiffKind = "creators"
+)
+
+// SeriesRegistryReplayOptions are the options to replay the series registry logs
+type SeriesRegistryReplayOptions struct {
+	FromBlock uint64 `json:"fromBlock"`
+	// ToBlock is the last block to scan. Zero means the latest block.
+	ToBlock   uint64 `json:"toBlock"`
+	BatchSize uint64 `json:"batchSize"`
+	DryRun    bool   `json:"dryRun"`
+}
+
+// CollectionDiff is a difference between a series in the contract and its collection
+type CollectionDiff struct {
+	SeriesID         string             `json:"seriesID"`
+	CollectionID     string             `json:"collectionID"`
+	Kind             CollectionDiffKind `json:"kind"`
+	ExpectedCreators []string           `json:"expectedCreators,omitempty"`
+	ActualCreators   []string           `json:"actualCreators,omitempty"`
+}
+
+// SeriesRegistryReplayReport is the result of a replay
+type SeriesRegistryReplayReport struct {
+	FromBlock uint64                `json:"fromBlock"`
+	ToBlock   uint64                `json:"toBlock"`
+	DryRun    bool                  `json:"dryRun"`
+	Events    []SeriesRegistryEvent `json:"events"`
+	Diffs     []CollectionDiff      `json:"diffs"`
+	// Pushed is the number of the reconcile events which are added to the queue
+	Pushed int `json:"pushed"`
+}
+
+// ReplaySeriesRegistry scans the series registry logs of a block range and reconciles the
+// collections of the series they touch against the current state of the contract. The scanned
+// logs are only reported and never pushed, since applying historical events after the live ones
+// would bring the collections back to a past state. Instead, a synthetic update_series event is
+// pushed for each touched series which is live, or whose collection is missing or has stale
+// creators, and a delete_series event for each collection which is orphaned. A dry run only
+// reports the events and the differences.
+func (e *EventProcessor) ReplaySeriesRegistry(ctx context.Context, opts SeriesRegistryReplayOptions) (*SeriesRegistryReplayReport, error) {
+	if opts.BatchSize == 0 {
+		opts.BatchSize = DefaultReplayBlockBatchSize
+	}
+
+	if opts.ToBlock == 0 {
+		head, err := e.rpcClient.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		opts.ToBlock = head.Number.Uint64()
+	}
+
+	if opts.FromBlock > opts.ToBlock {
+		return nil, fmt.Errorf("invalid block range: %d - %d", opts.FromBlock, opts.ToBlock)
+	}
+
+	report := &SeriesRegistryReplayReport{
+		FromBlock: opts.FromBlock,
+		ToBlock:   opts.ToBlock,
+		DryRun:    opts.DryRun,
+		Events:    []SeriesRegistryEvent{},
+		Diffs:     []CollectionDiff{},
+	}
+
+	events, err := e.scanSeriesRegistryEvents(ctx, opts.FromBlock, opts.ToBlock, opts.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	report.Events = append(report.Events, events...)
+
+	diffs, live, err := e.reconcileCollections(ctx, events)
+	if err != nil {
+		return nil, err
+	}
+	report.Diffs = append(report.Diffs, diffs...)
+
+	if opts.DryRun {
+		return report, nil
+	}
+
+	touched, err := touchedSeries(events)
+	if err != nil {
+		return nil, err
+	}
+
+	// the events of a series are reduced to one reconcile event, which reads the
+	// current state of the series from the contract when it is processed
+	reconciled := map[string]bool{}
+	var reconcileEvents []SeriesRegistryEvent
+	runID := uuid.New().String()
+	for _, diff := range diffs {
+		event, err := reconcileEvent(runID, e.seriesRegistryContract, diff)
+		if err != nil {
+			return nil, err
+		}
+		reconciled[diff.SeriesID] = true
+		reconcileEvents = append(reconcileEvents, event)
+	}
+
+	for _, seriesID := range touched {
+		if reconciled[seriesID] || !live[seriesID] {
+			continue
+		}
+
+		event, err := seriesReconcileEvent(runID, e.seriesRegistryContract, seriesID, SeriesRegistryEventTypeUpdateSeries)
+		if err != nil {
+			return nil, err
+		}
+		reconciled[seriesID] = true
+		reconcileEvents = append(reconcileEvents, event)
+	}
+
+	for _, event := range reconcileEvents {
+		created, err := e.eventQueue.PushSeriesRegistryEvent(ctx, event)
+		if err != nil {
+			return nil, err
+		}
+		if created {
+			report.Pushed++
+		}
+	}
+
+	log.InfoWithContext(ctx, "series registry is replayed",
+		zap.Uint64("fromBlock", opts.FromBlock), zap.Uint64("toBlock", opts.ToBlock),
+		zap.Int("events", len(report.Events)), zap.Int("diffs", len(report.Diffs)), zap.Int("pushed", report.Pushed))
+
+	return report, nil
+}
+
+// scanSeriesRegistryEvents reads the series registry logs of a block range in batches
+func (e *EventProcessor) scanSeriesRegistryEvents(ctx context.Context, fromBlock, toBlock, batchSize uint64) ([]SeriesRegistryEvent, error) {
+	contract, err := e.newSeriesRegistryContract(e.rpcClient)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTimes := map[uint64]time.Time{}
+	var events []SeriesRegistryEvent
+	for from := fromBlock; from <= toBlock; from += batchSize {
+		to := min(from+batchSize-1, toBlock)
+
+		query := indexer.SeriesRegistryQuery(e.seriesRegistryContract)
+		query.FromBlock = new(big.Int).SetUint64(from)
+		query.ToBlock = new(big.Int).SetUint64(to)
+
+		logs, err := e.rpcClient.FilterLogs(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, eLog := range logs {
+			eventType, data, err := indexer.ParseSeriesRegistryLog(contract, eLog)
+			if err != nil {
+				log.WarnWithContext(ctx, "skip a series registry log", zap.Error(err),
+					zap.String("txHash", eLog.TxHash.Hex()), zap.Uint("logIndex", eLog.Index))
+				continue
+			}
+
+			txTime, ok := blockTimes[eLog.BlockNumber]
+			if !ok {
+				header, err := e.rpcClient.HeaderByNumber(ctx, new(big.Int).SetUint64(eLog.BlockNumber))
+				if err != nil {
+					return nil, err
+				}
+				txTime = time.Unix(int64(header.Time), 0) // #nosec G115 -- Ethereum block timestamps are safe to convert
+				blockTimes[eLog.BlockNumber] = txTime
+			}
+
+			rawData, err := json.Marshal(data)
+			if err != nil {
+				return nil, err
+			}
+
+			events = append(events, SeriesRegistryEvent{
+				Type:       eventType,
+				Contract:   indexer.EthereumChecksumAddress(eLog.Address.String()),
+				TxID:       eLog.TxHash.Hex(),
+				EventIndex: eLog.Index,
+				TxTime:     txTime,
+				Data:       rawData,
+				Stage:      SeriesEventStages[SeriesRegistryEventStageInit],
+				Status:     SeriesRegistryEventStatusCreated,
+			})
+		}
+
+		if to == toBlock {
+			break
+		}
+	}
+
+	return events, nil
+}
+
+// reconcileCollections compares the series of the contract and the series of the events
+// with the current collections. It also returns the series which are live in the contract.
+func (e *EventProcessor) reconcileCollections(ctx context.Context, events []SeriesRegistryEvent) ([]CollectionDiff, map[string]bool, error) {
+	contract, err := e.newSeriesRegistryContract(e.rpcClient)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	liveSeriesIDs, err := contract.GetSeriesIDs(nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	live := map[string]bool{}
+	var seriesIDs []string
+	for _, id := range liveSeriesIDs {
+		live[id.Text(10)] = true
+		seriesIDs = append(seriesIDs, id.Text(10))
+	}
+
+	// deleted series are only known from the events
+	touched, err := touchedSeries(events)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, seriesID := range touched {
+		if !live[seriesID] {
+			seriesIDs = append(seriesIDs, seriesID)
+		}
+	}
+
+	diffs, err := e.diffCollections(ctx, contract, seriesIDs, live)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return diffs, live, nil
+}
+
+// touchedSeries returns the series ids of the events in the order they first appear
+func touchedSeries(events []SeriesRegistryEvent) ([]string, error) {
+	seen := map[string]bool{}
+	var seriesIDs []string
+	for _, event := range events {
+		var data map[string]interface{}
+		if err := json.Unmarshal(event.Data, &data); err != nil {
+			return nil, err
+		}
+		if seriesID, ok := data["series_id"].(string); ok && !seen[seriesID] {
+			seen[seriesID] = true
+			seriesIDs = append(seriesIDs, seriesID)
+		}
+	}
+
+	return seriesIDs, nil
+}
+
+// diffCollections compares the series with their collections. Series which are not live
+// should have no collections.
+func (e *EventProcessor) diffCollections(ctx context.Context, contract *seriesRegistry.SeriesRegistry, seriesIDs []string, live map[string]bool) ([]CollectionDiff, error) {
+	diffs := []CollectionDiff{}
+	for _, seriesID := range seriesIDs {
+		collectionID := collectionID(seriesID)
+		collection, err := e.indexerStore.GetCollectionByID(ctx, collectionID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !live[seriesID] {
+			if collection != nil {
+				diffs = append(diffs, CollectionDiff{
+					SeriesID:       seriesID,
+					CollectionID:   collectionID,
+					Kind:           CollectionDiffOrphaned,
+					ActualCreators: collection.Creators,
+				})
+			}
+			continue
+		}
+
+		seriesIDInt, _ := new(big.Int).SetString(seriesID, 10)
+		artists, err := contract.GetSeriesArtistAddresses(nil, seriesIDInt)
+		if err != nil {
+			return nil, err
+		}
+
+		expected := make([]string, 0, len(artists))
+		for _, a := range artists {
+			expected = append(expected, a.Hex())
+		}
+
+		if collection == nil {
+			diffs = append(diffs, CollectionDiff{
+				SeriesID:         seriesID,
+				CollectionID:     collectionID,
+				Kind:             CollectionDiffMissing,
+				ExpectedCreators: expected,
+			})
+			continue
+		}
+
+		if !sameCreators(expected, collection.Creators) {
+			diffs = append(diffs, CollectionDiff{
+				SeriesID:         seriesID,
+				CollectionID:     collectionID,
+				Kind:             CollectionDiffCreators,
+				ExpectedCreators: expected,
+				ActualCreators:   collection.Creators,
+			})
+		}
+	}
+
+	return diffs, nil
+}
+
+// reconcileRevertedSeries removes the events of a reverted series registry log and pushes a
+// synthetic event for each collection which no longer matches its series. The events without
+// a series id, e.g. artist address updates, may change any collection, so all the series are
+// reconciled for them.
+func (e *EventProcessor) reconcileRevertedSeries(ctx context.Context, event *SeriesRegistryEvent) error {
+	if err := e.eventQueue.Store().DeleteRevertedSeriesRegistryEvents(*event); err != nil {
+		log.ErrorWithContext(ctx, errors.New("fail to delete reverted events"), zap.Error(err))
+		return err
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		return err
+	}
+
+	var diffs []CollectionDiff
+	if seriesID, ok := data["series_id"].(string); ok {
+		contract, err := e.newSeriesRegistryContract(e.rpcClient)
+		if err != nil {
+			return err
+		}
+
+		liveSeriesIDs, err := contract.GetSeriesIDs(nil)
+		if err != nil {
+			return err
+		}
+
+		live := map[string]bool{}
+		for _, id := range liveSeriesIDs {
+			live[id.Text(10)] = true
+		}
+
+		diffs, err = e.diffCollections(ctx, contract, []string{seriesID}, live)
+		if err != nil {
+			return err
+		}
+	} else {
+		var err error
+		diffs, _, err = e.reconcileCollections(ctx, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, diff := range diffs {
+		reconcile, err := reconcileEvent(event.ID, e.seriesRegistryContract, diff)
+		if err != nil {
+			return err
+		}
+
+		if _, err := e.eventQueue.PushSeriesRegistryEvent(ctx, reconcile); err != nil {
+			return err
+		}
+	}
+
+	log.InfoWithContext(ctx, "reverted series registry event is reconciled",
+		zap.String("txID", event.TxID), zap.Uint("eventIndex", event.EventIndex), zap.Int("diffs", len(diffs)))
+
+	return nil
+}
+
+// reconcileEvent returns a synthetic event which brings a collection back to the state of its series
+func reconcileEvent(runID, contract string, diff CollectionDiff) (SeriesRegistryEvent, error) {
+	var eventType SeriesRegistryEventType
+	switch diff.Kind {
+	case CollectionDiffMissing, CollectionDiffCreators:
+		eventType = SeriesRegistryEventTypeUpdateSeries
+	case CollectionDiffOrphaned:
+		eventType = SeriesRegistryEventTypeDeleteSeries
+	default:
+		return SeriesRegistryEvent{}, errors.New("unknown collection diff")
+	}
+
+	return seriesReconcileEvent(runID, contract, diff.SeriesID, eventType)
+}
+
+// seriesReconcileEvent returns a synthetic event of a series, which is processed with the
+// current state of the series in the contract
+func seriesReconcileEvent(runID, contract, seriesID string, eventType SeriesRegistryEventType) (SeriesRegistryEvent, error) {
+	data, err := json.Marshal(map[string]interface{}{
+		"series_id": seriesID,
+	})
+	if err != nil {
+		return SeriesRegistryEvent{}, err
+	}
+
+	return SeriesRegistryEvent{
+		Type:     string(eventType),
+		Contract: indexer.EthereumChecksumAddress(contract),
+		TxID:     fmt.Sprintf("reconcile-%s-%s", runID, seriesID),
+		TxTime:   time.Now(),
+		Data:     data,
+		Stage:    SeriesEventStages[SeriesRegistryEventStageInit],
+		Status:   SeriesRegistryEventStatusCreated,
+	}, nil
+}
+
+// sameCreators returns whether two creator lists have the same addresses in any order
+func sameCreators(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a, b = slices.Clone(a), slices.Clone(b)
+	sort.Strings(a)
+	sort.Strings(b)
+	return slices.Equal(a, b)
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// replayJobRetention is how long a finished replay job is kept for its report
+const replayJobRetention = 24 * time.Hour
+
+var ErrReplayJobRunning = errors.New("a series registry replay is running")
+
+type ReplayJobStatus string
+
+const (
+	ReplayJobStatusRunning   ReplayJobStatus = "running"
+	ReplayJobStatusSucceeded ReplayJobStatus = "succeeded"
+	ReplayJobStatusFailed    ReplayJobStatus = "failed"
+)
+
+// SeriesRegistryReplayJob is a series registry replay which runs in the background
+type SeriesRegistryReplayJob struct {
+	ID         string                      `json:"id"`
+	Status     ReplayJobStatus             `json:"status"`
+	Options    SeriesRegistryReplayOptions `json:"options"`
+	Report     *SeriesRegistryReplayReport `json:"report,omitempty"`
+	Error      string                      `json:"error,omitempty"`
+	StartedAt  time.Time                   `json:"startedAt"`
+	FinishedAt *time.Time                  `json:"finishedAt,omitempty"`
+}
+
+// replayJobs runs the series registry replays in the background, one at a time. The jobs
+// are kept in memory, so the report of a job is lost when the service restarts.
+type replayJobs struct {
+	sync.Mutex
+
+	replayer SeriesRegistryReplayer
+	jobs     map[string]*SeriesRegistryReplayJob
+}
+
+func newReplayJobs(replayer SeriesRegistryReplayer) *replayJobs {
+	return &replayJobs{
+		replayer: replayer,
+		jobs:     map[string]*SeriesRegistryReplayJob{},
+	}
+}
+
+// start starts a replay in the background and returns the job. ErrReplayJobRunning is
+// returned when another replay is running.
+func (r *replayJobs) start(ctx context.Context, opts SeriesRegistryReplayOptions) (SeriesRegistryReplayJob, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	for id, job := range r.jobs {
+		if job.Status == ReplayJobStatusRunning {
+			return SeriesRegistryReplayJob{}, ErrReplayJobRunning
+		}
+		if time.Since(*job.FinishedAt) > replayJobRetention {
+			delete(r.jobs, id)
+		}
+	}
+
+	job := &SeriesRegistryReplayJob{
+		ID:        uuid.New().String(),
+		Status:    ReplayJobStatusRunning,
+		Options:   opts,
+		StartedAt: time.Now(),
+	}
+	r.jobs[job.ID] = job
+
+	go r.run(ctx, job.ID, opts)
+
+	return *job, nil
+}
+
+func (r *replayJobs) run(ctx context.Context, id string, opts SeriesRegistryReplayOptions) {
+	report, err := r.replayer.ReplaySeriesRegistry(ctx, opts)
+	if err != nil {
+		log.ErrorWithContext(ctx, errors.New("fail to replay series registry"), zap.String("jobID", id), zap.Error(err))
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	job := r.jobs[id]
+	finishedAt := time.Now()
+	job.FinishedAt = &finishedAt
+	if err != nil {
+		job.Status = ReplayJobStatusFailed
+		job.Error = err.Error()
+		return
+	}
+
+	job.Status = ReplayJobStatusSucceeded
+	job.Report = report
+}
+
+// get returns a job by its id
+func (r *replayJobs) get(id string) (SeriesRegistryReplayJob, bool) {
+	r.Lock()
+	defer r.Unlock()
+
+	job, ok := r.jobs[id]
+	if !ok {
+		return SeriesRegistryReplayJob{}, false
+	}
+	return *job, true
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	"github.com/stretchr/testify/assert"
+)
+
+// testReplayer replays once it is released
+type testReplayer struct {
+	release chan error
+}
+
+func (r *testReplayer) ReplaySeriesRegistry(_ context.Context, opts SeriesRegistryReplayOptions) (*SeriesRegistryReplayReport, error) {
+	if err := <-r.release; err != nil {
+		return nil, err
+	}
+	return &SeriesRegistryReplayReport{FromBlock: opts.FromBlock, Pushed: 1}, nil
+}
+
+func TestReplayJobs(t *testing.T) {
+	if err := log.Initialize(false, nil); err != nil {
+		panic(err)
+	}
+
+	replayer := &testReplayer{release: make(chan error)}
+	jobs := newReplayJobs(replayer)
+
+	job, err := jobs.start(context.Background(), SeriesRegistryReplayOptions{FromBlock: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, ReplayJobStatusRunning, job.Status)
+
+	_, err = jobs.start(context.Background(), SeriesRegistryReplayOptions{})
+	assert.ErrorIs(t, err, ErrReplayJobRunning)
+
+	replayer.release <- nil
+	assert.Eventually(t, func() bool {
+		job, _ := jobs.get(job.ID)
+		return job.Status == ReplayJobStatusSucceeded
+	}, time.Second, 5*time.Millisecond)
+
+	job, ok := jobs.get(job.ID)
+	assert.True(t, ok)
+	assert.Equal(t, &SeriesRegistryReplayReport{FromBlock: 10, Pushed: 1}, job.Report)
+	assert.NotNil(t, job.FinishedAt)
+
+	failed, err := jobs.start(context.Background(), SeriesRegistryReplayOptions{})
+	assert.NoError(t, err)
+	replayer.release <- errors.New("rpc error")
+	assert.Eventually(t, func() bool {
+		job, _ := jobs.get(failed.ID)
+		return job.Status == ReplayJobStatusFailed && job.Error == "rpc error"
+	}, time.Second, 5*time.Millisecond)
+
+	_, ok = jobs.get("unknown")
+	assert.False(t, ok)
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	"github.com/stretchr/testify/assert"
+
+	indexer "github.com/feral-file/ff-indexer"
+)
+
+// testReplayQueue records the pushed series registry events and skips the events of the same log
+type testReplayQueue struct {
+	EventQueue
+
+	events []SeriesRegistryEvent
+}
+
+func (q *testReplayQueue) PushSeriesRegistryEvent(_ context.Context, event SeriesRegistryEvent) (bool, error) {
+	for _, e := range q.events {
+		if e.Type == event.Type && e.Contract == event.Contract && e.TxID == event.TxID && e.EventIndex == event.EventIndex {
+			return false, nil
+		}
+	}
+
+	q.events = append(q.events, event)
+	return true, nil
+}
+
+func TestReplaySeriesRegistry(t *testing.T) {
+	if err := log.Initialize(false, nil); err != nil {
+		panic(err)
+	}
+
+	r := newTestSeriesRegistry(t, 3)
+	syncedSeriesID := r.registerSeries(1)
+	staleSeriesID := r.registerSeries(1)
+	missingSeriesID := r.registerSeries(1)
+	deletedSeriesID := r.registerSeries(2)
+	deletedSeriesIDInt, _ := new(big.Int).SetString(deletedSeriesID, 10)
+	r.transact(r.contract.DeleteSeries(r.auth(2), deletedSeriesIDInt))
+
+	store := &testCollectionStore{collections: map[string]*indexer.Collection{
+		collectionID(syncedSeriesID):  {ID: collectionID(syncedSeriesID), Creators: []string{r.account(1).Hex()}},
+		collectionID(staleSeriesID):   {ID: collectionID(staleSeriesID), Creators: []string{r.account(2).Hex()}},
+		collectionID(deletedSeriesID): {ID: collectionID(deletedSeriesID), Creators: []string{r.account(2).Hex()}},
+	}}
+	queue := &testReplayQueue{}
+	e := newTestSeriesRegistryProcessor(r, store)
+	e.eventQueue = queue
+
+	report, err := e.ReplaySeriesRegistry(context.Background(), SeriesRegistryReplayOptions{BatchSize: 2, DryRun: true})
+	assert.NoError(t, err)
+	assert.Empty(t, queue.events)
+
+	var eventTypes []string
+	for _, event := range report.Events {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	assert.Equal(t, []string{
+		string(SeriesRegistryEventTypeRegisterSeries),
+		string(SeriesRegistryEventTypeRegisterSeries),
+		string(SeriesRegistryEventTypeRegisterSeries),
+		string(SeriesRegistryEventTypeRegisterSeries),
+		string(SeriesRegistryEventTypeDeleteSeries),
+	}, eventTypes)
+
+	assert.ElementsMatch(t, []CollectionDiff{
+		{
+			SeriesID:         staleSeriesID,
+			CollectionID:     collectionID(staleSeriesID),
+			Kind:             CollectionDiffCreators,
+			ExpectedCreators: []string{r.account(1).Hex()},
+			ActualCreators:   []string{r.account(2).Hex()},
+		},
+		{
+			SeriesID:         missingSeriesID,
+			CollectionID:     collectionID(missingSeriesID),
+			Kind:             CollectionDiffMissing,
+			ExpectedCreators: []string{r.account(1).Hex()},
+		},
+		{
+			SeriesID:       deletedSeriesID,
+			CollectionID:   collectionID(deletedSeriesID),
+			Kind:           CollectionDiffOrphaned,
+			ActualCreators: []string{r.account(2).Hex()},
+		},
+	}, report.Diffs)
+
+	// the scanned events are not pushed, each touched series gets one reconcile event
+	report, err = e.ReplaySeriesRegistry(context.Background(), SeriesRegistryReplayOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, report.Pushed)
+	assert.Len(t, queue.events, 4)
+
+	reconciled := map[string]string{}
+	for _, event := range queue.events {
+		assert.Equal(t, SeriesRegistryEventStatusCreated, event.Status)
+		assert.Zero(t, event.EventIndex)
+		reconciled[string(event.Data)] = event.Type
+	}
+	assert.Equal(t, map[string]string{
+		`{"series_id":"` + syncedSeriesID + `"}`:  string(SeriesRegistryEventTypeUpdateSeries),
+		`{"series_id":"` + staleSeriesID + `"}`:   string(SeriesRegistryEventTypeUpdateSeries),
+		`{"series_id":"` + missingSeriesID + `"}`: string(SeriesRegistryEventTypeUpdateSeries),
+		`{"series_id":"` + deletedSeriesID + `"}`: string(SeriesRegistryEventTypeDeleteSeries),
+	}, reconciled)
+
+	// every replay reconciles against the current state, so the events of a new run are added
+	report, err = e.ReplaySeriesRegistry(context.Background(), SeriesRegistryReplayOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, report.Pushed)
+	assert.Len(t, queue.events, 8)
+}
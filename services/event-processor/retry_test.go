@@ -0,0 +1,26 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	policy := NewRetryPolicy(4, 10*time.Second, time.Minute)
+
+	assert.Equal(t, 10*time.Second, policy.Backoff(1))
+	assert.Equal(t, 20*time.Second, policy.Backoff(2))
+	assert.Equal(t, 40*time.Second, policy.Backoff(3))
+	assert.Equal(t, time.Minute, policy.Backoff(4))
+	assert.Equal(t, time.Minute, policy.Backoff(100))
+
+	assert.False(t, policy.Exhausted(3))
+	assert.True(t, policy.Exhausted(4))
+
+	defaultPolicy := NewRetryPolicy(0, 0, 0)
+	assert.Equal(t, DefaultRetryMaxAttempts, defaultPolicy.MaxAttempts)
+	assert.Equal(t, DefaultRetryBaseDelay, defaultPolicy.Backoff(1))
+	assert.Equal(t, DefaultRetryMaxDelay, defaultPolicy.Backoff(100))
+}
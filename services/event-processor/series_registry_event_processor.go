@@ -6,22 +6,17 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	"time"
 
-	log "github.com/bitmark-inc/autonomy-logger"
-	utils "github.com/bitmark-inc/autonomy-utils"
 	seriesRegistry "github.com/bitmark-inc/feralfile-exhibition-smart-contract/go-binding/series-registry"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/google/uuid"
-	"go.uber.org/cadence/client"
-	"go.uber.org/zap"
 
 	indexer "github.com/feral-file/ff-indexer"
 	indexerWorker "github.com/feral-file/ff-indexer/background/worker"
 )
 
-func (e *EventProcessor) indexCollection(ctx context.Context, event SeriesRegistryEvent) error {
+func (e *EventProcessor) indexCollection(ctx context.Context, event *SeriesRegistryEvent) error {
 	// Unmarshal event data
 	var data map[string]interface{}
 	if err := json.Unmarshal(event.Data, &data); err != nil {
@@ -116,105 +111,21 @@ func (e *EventProcessor) indexCollection(ctx context.Context, event SeriesRegist
 	collection.Items = tokenData.TotalSupply()
 	collection.LastUpdatedTime = event.CreatedAt
 
-	if err := e.indexerStore.IndexCollection(ctx, *collection); err != nil {
-		return err
-	}
-
-	// Index the collection tokens
-	runID := uuid.New().String()
-	collectionAssetMap := make(map[string]indexer.CollectionAsset)
-	var tokenIndexIDs []string
-	var workflowFutures []client.WorkflowRun
-	for contract, tokens := range tokenData.Ethereum.ERC721 {
-		for _, tokenID := range tokens {
-			future, err := indexerWorker.ExecuteIndexTokenWorkflow(ctx, e.worker, "", contract, tokenID, false, false)
-			if err != nil {
-				return err
-			}
-			workflowFutures = append(workflowFutures, future)
-
-			indexID := indexer.TokenIndexID(utils.EthereumBlockchain, contract, tokenID)
-			tokenIndexIDs = append(tokenIndexIDs, indexID)
-			collectionAssetMap[indexID] = indexer.CollectionAsset{
-				CollectionID: collection.ID,
-				TokenIndexID: indexID,
-				RunID:        runID,
-			}
-		}
-	}
-	for contract, tokens := range tokenData.Ethereum.ERC1155 {
-		for _, tokenID := range tokens {
-			future, err := indexerWorker.ExecuteIndexTokenWorkflow(ctx, e.worker, "", contract, tokenID, false, false)
-			if err != nil {
-				return err
-			}
-			workflowFutures = append(workflowFutures, future)
-
-			indexID := indexer.TokenIndexID(utils.EthereumBlockchain, contract, tokenID)
-			tokenIndexIDs = append(tokenIndexIDs, indexID)
-			collectionAssetMap[indexID] = indexer.CollectionAsset{
-				CollectionID: collection.ID,
-				TokenIndexID: indexID,
-				RunID:        runID,
-			}
-		}
-	}
-	for contract, tokens := range tokenData.Tezos.FA2 {
-		for _, tokenID := range tokens {
-			future, err := indexerWorker.ExecuteIndexTokenWorkflow(ctx, e.worker, "", contract, tokenID, false, false)
-			if err != nil {
-				return err
-			}
-			workflowFutures = append(workflowFutures, future)
-
-			indexID := indexer.TokenIndexID(utils.TezosBlockchain, contract, tokenID)
-			tokenIndexIDs = append(tokenIndexIDs, indexID)
-			collectionAssetMap[indexID] = indexer.CollectionAsset{
-				CollectionID: collection.ID,
-				TokenIndexID: indexID,
-				RunID:        runID,
-			}
-		}
-	}
-
-	// Wait until all token indexed
-	for _, f := range workflowFutures {
-		if err := f.Get(ctx, nil); err != nil {
-			return err
-		}
-	}
-
-	// Filter if any of the token is burned
-	liveTokenIndexIDs, err := e.indexerStore.FilterBurnedIndexIDs(ctx, tokenIndexIDs)
+	// Index the collection and its tokens in a workflow
+	workflowID, err := indexerWorker.StartIndexCollectionWorkflow(ctx, e.worker, "event-processor", indexerWorker.IndexCollectionInput{
+		Collection:   *collection,
+		TokenDataURI: tokenDataURI,
+		RunID:        uuid.New().String(),
+	})
 	if err != nil {
 		return err
 	}
 
-	var collectionAssets []indexer.CollectionAsset
-	for _, id := range liveTokenIndexIDs {
-		collectionAssets = append(collectionAssets, collectionAssetMap[id])
-	}
-
-	// Index the collection assets
-	if err := e.indexerStore.IndexCollectionAsset(ctx, collection.ID, collectionAssets); err != nil {
-		return err
-	}
-
-	// Delete the deprecated collection assets
-	if err := e.indexerStore.DeleteDeprecatedCollectionAsset(ctx, collection.ID, runID); err != nil {
-		return err
-	}
-
-	// Update the total supply of collection
-	collection.Items = len(liveTokenIndexIDs)
-	if err := e.indexerStore.IndexCollection(ctx, *collection); err != nil {
-		return err
-	}
-
+	event.WorkflowID = workflowID
 	return nil
 }
 
-func (e *EventProcessor) deleteCollection(ctx context.Context, event SeriesRegistryEvent) error {
+func (e *EventProcessor) deleteCollection(ctx context.Context, event *SeriesRegistryEvent) error {
 	// Unmarshal event data
 	var data map[string]interface{}
 	if err := json.Unmarshal(event.Data, &data); err != nil {
@@ -241,7 +152,7 @@ func (e *EventProcessor) deleteCollection(ctx context.Context, event SeriesRegis
 	return e.indexerStore.DeleteCollection(ctx, collection.ID)
 }
 
-func (e *EventProcessor) replaceCollectionCreator(ctx context.Context, event SeriesRegistryEvent) error {
+func (e *EventProcessor) replaceCollectionCreator(ctx context.Context, event *SeriesRegistryEvent) error {
 	// Unmarshal event data
 	var data map[string]interface{}
 	if err := json.Unmarshal(event.Data, &data); err != nil {
@@ -264,7 +175,37 @@ func (e *EventProcessor) replaceCollectionCreator(ctx context.Context, event Ser
 	return e.indexerStore.ReplaceCollectionCreator(ctx, oldAddress, newAddress)
 }
 
-func (e *EventProcessor) updateCollectionCreators(ctx context.Context, event SeriesRegistryEvent) error {
+func (e *EventProcessor) assignCollection(ctx context.Context, event *SeriesRegistryEvent) error {
+	// Unmarshal event data
+	var data map[string]interface{}
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		return err
+	}
+
+	// Events emitted before the series id is added to the event data
+	// can only replace the creator in all collections
+	seriesID, ok := data["series_id"].(string)
+	if !ok {
+		return e.replaceCollectionCreator(ctx, event)
+	}
+
+	// Get assigner address
+	oldAddress, ok := data["old_address"].(string)
+	if !ok {
+		return errors.New("old_address cannot be found in event data or is not a string")
+	}
+
+	// Get assignee address
+	newAddress, ok := data["new_address"].(string)
+	if !ok {
+		return errors.New("new_address cannot be found in event data or is not a string")
+	}
+
+	// Move the collection from the assigner to the assignee
+	return e.indexerStore.AssignCollectionCreator(ctx, collectionID(seriesID), oldAddress, newAddress)
+}
+
+func (e *EventProcessor) addCollectionCollaborator(ctx context.Context, event *SeriesRegistryEvent) error {
 	// Unmarshal event data
 	var data map[string]interface{}
 	if err := json.Unmarshal(event.Data, &data); err != nil {
@@ -277,6 +218,42 @@ func (e *EventProcessor) updateCollectionCreators(ctx context.Context, event Ser
 		return errors.New("series_id cannot be found in event data or is not a string")
 	}
 
+	// Events emitted before the collaborator is added to the event data
+	// sync the creators from the contract
+	collaborator, ok := data["collaborator_address"].(string)
+	if !ok {
+		return e.syncCollectionCreators(ctx, seriesID)
+	}
+
+	return e.indexerStore.AddCollectionCreator(ctx, collectionID(seriesID), collaborator)
+}
+
+func (e *EventProcessor) removeCollectionCreator(ctx context.Context, event *SeriesRegistryEvent) error {
+	// Unmarshal event data
+	var data map[string]interface{}
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		return err
+	}
+
+	// Get series ID
+	seriesID, ok := data["series_id"].(string)
+	if !ok {
+		return errors.New("series_id cannot be found in event data or is not a string")
+	}
+
+	// Events emitted before the artist is added to the event data
+	// sync the creators from the contract
+	artist, ok := data["artist_address"].(string)
+	if !ok {
+		return e.syncCollectionCreators(ctx, seriesID)
+	}
+
+	// The collection is kept even if the last artist opts out
+	return e.indexerStore.RemoveCollectionCreator(ctx, collectionID(seriesID), artist)
+}
+
+// syncCollectionCreators sets the collection creators to the series artists in the contract
+func (e *EventProcessor) syncCollectionCreators(ctx context.Context, seriesID string) error {
 	// Convert series ID to big int
 	seriesIDInt, ok := new(big.Int).SetString(seriesID, 10)
 	if !ok {
@@ -327,72 +304,54 @@ func (e *EventProcessor) DeleteCollection(ctx context.Context) {
 func (e *EventProcessor) ReplaceCollectionCreator(ctx context.Context) {
 	e.StartSeriesRegistryEventWorker(ctx,
 		SeriesRegistryEventStageInit, SeriesRegistryEventStageDone,
-		[]SeriesRegistryEventType{
-			SeriesRegistryEventTypeUpdateArtistAddress,
-			SeriesRegistryEventTypeAssignSeries,
-		},
+		[]SeriesRegistryEventType{SeriesRegistryEventTypeUpdateArtistAddress},
 		0, 0, e.replaceCollectionCreator,
 	)
 }
 
-func (e *EventProcessor) UpdateCollectionCreators(ctx context.Context) {
+func (e *EventProcessor) AssignCollection(ctx context.Context) {
 	e.StartSeriesRegistryEventWorker(ctx,
 		SeriesRegistryEventStageInit, SeriesRegistryEventStageDone,
-		[]SeriesRegistryEventType{
-			SeriesRegistryEventTypeOptInCollaboration,
-			SeriesRegistryEventTypeOptOutSeries,
-		},
-		0, 0, e.updateCollectionCreators,
+		[]SeriesRegistryEventType{SeriesRegistryEventTypeAssignSeries},
+		0, 0, e.assignCollection,
 	)
 }
 
-func (e *EventProcessor) newSeriesRegistryContract(ec *ethclient.Client) (*seriesRegistry.SeriesRegistry, error) {
-	return seriesRegistry.NewSeriesRegistry(common.HexToAddress(e.seriesRegistryContract), ec)
+func (e *EventProcessor) AddCollectionCollaborator(ctx context.Context) {
+	e.StartSeriesRegistryEventWorker(ctx,
+		SeriesRegistryEventStageInit, SeriesRegistryEventStageDone,
+		[]SeriesRegistryEventType{SeriesRegistryEventTypeOptInCollaboration},
+		0, 0, e.addCollectionCollaborator,
+	)
 }
 
-// ReadDataURI reads the data from the given URI
-func (e *EventProcessor) ReadDataURI(ctx context.Context, uri string) ([]byte, error) {
-	if !validDataURI(uri) {
-		return nil, errors.New("invalid data URI")
-	}
+func (e *EventProcessor) RemoveCollectionCreator(ctx context.Context) {
+	e.StartSeriesRegistryEventWorker(ctx,
+		SeriesRegistryEventStageInit, SeriesRegistryEventStageDone,
+		[]SeriesRegistryEventType{SeriesRegistryEventTypeOptOutSeries},
+		0, 0, e.removeCollectionCreator,
+	)
+}
 
-	const timeout = 30 * time.Second
-	if indexer.IsHTTPSURI(uri) {
-		return indexer.ReadFromURL(ctx, uri, timeout)
-	} else {
-		// If no gateways are configured, return error
-		if len(e.ipfsGateways) == 0 {
-			return nil, errors.New("no IPFS gateways configured")
-		}
+// ReconcileRevertedSeries reconciles the collections which are changed by series registry
+// events from orphaned blocks
+func (e *EventProcessor) ReconcileRevertedSeries(ctx context.Context) {
+	e.StartSeriesRegistryEventWorker(ctx,
+		SeriesRegistryEventStageInit, SeriesRegistryEventStageDone,
+		[]SeriesRegistryEventType{SeriesRegistryEventTypeReverted},
+		0, 0, e.reconcileRevertedSeries,
+	)
+}
 
-		// try to read from the IPFS gateways
-		var lastErr error
-		for _, gateway := range e.ipfsGateways {
-			gatewayURL := indexer.ResolveIPFSURI(gateway, uri)
-			data, err := indexer.ReadFromURL(ctx, gatewayURL, timeout)
-			if err == nil {
-				log.Debug("Successfully read data from IPFS gateway",
-					zap.String("gateway", gateway),
-					zap.String("url", gatewayURL))
-				return data, nil
-			}
-
-			lastErr = err
-			log.WarnWithContext(ctx,
-				"Failed to read data from IPFS gateway",
-				zap.Error(err),
-				zap.String("uri", uri),
-				zap.String("gateway", gateway))
-		}
+func (e *EventProcessor) newSeriesRegistryContract(backend bind.ContractBackend) (*seriesRegistry.SeriesRegistry, error) {
+	return seriesRegistry.NewSeriesRegistry(common.HexToAddress(e.seriesRegistryContract), backend)
+}
 
-		return nil, fmt.Errorf("failed to read data from all %d IPFS gateways; last error: %w", len(e.ipfsGateways), lastErr)
-	}
+// ReadDataURI reads the data from the given URI
+func (e *EventProcessor) ReadDataURI(ctx context.Context, uri string) ([]byte, error) {
+	return indexer.ReadDataURI(ctx, e.ipfsGateways, uri)
 }
 
 func collectionID(seriesID string) string {
 	return fmt.Sprint("series-registry-", seriesID)
 }
-
-func validDataURI(uri string) bool {
-	return indexer.IsIPFSURI(uri) || indexer.IsHTTPSURI(uri)
-}
@@ -0,0 +1,268 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"slices"
+	"testing"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	seriesRegistry "github.com/bitmark-inc/feralfile-exhibition-smart-contract/go-binding/series-registry"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/assert"
+
+	indexer "github.com/feral-file/ff-indexer"
+)
+
+// testCollectionStore keeps the collections in memory
+type testCollectionStore struct {
+	indexer.Store
+
+	collections map[string]*indexer.Collection
+}
+
+func (s *testCollectionStore) GetCollectionByID(_ context.Context, id string) (*indexer.Collection, error) {
+	return s.collections[id], nil
+}
+
+func (s *testCollectionStore) DeleteCollection(_ context.Context, collectionID string) error {
+	delete(s.collections, collectionID)
+	return nil
+}
+
+func (s *testCollectionStore) ReplaceCollectionCreator(_ context.Context, oldCreator, newCreator string) error {
+	for _, c := range s.collections {
+		for i, creator := range c.Creators {
+			if creator == oldCreator {
+				c.Creators[i] = newCreator
+			}
+		}
+	}
+	return nil
+}
+
+func (s *testCollectionStore) UpdateCollectionCreators(_ context.Context, collectionID string, creators []string) error {
+	if c, ok := s.collections[collectionID]; ok {
+		c.Creators = creators
+	}
+	return nil
+}
+
+func (s *testCollectionStore) AddCollectionCreator(_ context.Context, collectionID, creator string) error {
+	if c, ok := s.collections[collectionID]; ok && !slices.Contains(c.Creators, creator) {
+		c.Creators = append(c.Creators, creator)
+	}
+	return nil
+}
+
+func (s *testCollectionStore) RemoveCollectionCreator(_ context.Context, collectionID, creator string) error {
+	if c, ok := s.collections[collectionID]; ok {
+		c.Creators = slices.DeleteFunc(c.Creators, func(a string) bool { return a == creator })
+	}
+	return nil
+}
+
+func (s *testCollectionStore) AssignCollectionCreator(ctx context.Context, collectionID, oldCreator, newCreator string) error {
+	c, ok := s.collections[collectionID]
+	if !ok {
+		return nil
+	}
+
+	i := slices.Index(c.Creators, oldCreator)
+	if i < 0 || slices.Contains(c.Creators, newCreator) {
+		return s.RemoveCollectionCreator(ctx, collectionID, oldCreator)
+	}
+
+	c.Creators[i] = newCreator
+	return nil
+}
+
+// testSeriesRegistry is a series registry contract deployed on a simulated backend
+type testSeriesRegistry struct {
+	t        *testing.T
+	backend  *simulated.Backend
+	contract *seriesRegistry.SeriesRegistry
+	address  common.Address
+	keys     []*ecdsa.PrivateKey
+}
+
+func newTestSeriesRegistry(t *testing.T, accounts int) *testSeriesRegistry {
+	alloc := types.GenesisAlloc{}
+	keys := make([]*ecdsa.PrivateKey, accounts)
+	for i := range keys {
+		key, err := crypto.GenerateKey()
+		assert.NoError(t, err)
+		keys[i] = key
+		alloc[crypto.PubkeyToAddress(key.PublicKey)] = types.Account{Balance: big.NewInt(1e18)}
+	}
+
+	backend := simulated.NewBackend(alloc)
+	t.Cleanup(func() { _ = backend.Close() })
+
+	r := &testSeriesRegistry{t: t, backend: backend, keys: keys}
+	address, _, contract, err := seriesRegistry.DeploySeriesRegistry(r.auth(0), backend.Client())
+	assert.NoError(t, err)
+	backend.Commit()
+
+	r.address = address
+	r.contract = contract
+	return r
+}
+
+func (r *testSeriesRegistry) account(i int) common.Address {
+	return crypto.PubkeyToAddress(r.keys[i].PublicKey)
+}
+
+func (r *testSeriesRegistry) auth(i int) *bind.TransactOpts {
+	auth, err := bind.NewKeyedTransactorWithChainID(r.keys[i], big.NewInt(1337))
+	assert.NoError(r.t, err)
+	return auth
+}
+
+// transact commits a transaction and returns its logs
+func (r *testSeriesRegistry) transact(tx *types.Transaction, err error) []*types.Log {
+	if !assert.NoError(r.t, err) {
+		r.t.FailNow()
+	}
+	r.backend.Commit()
+
+	receipt, err := r.backend.Client().TransactionReceipt(context.Background(), tx.Hash())
+	assert.NoError(r.t, err)
+	assert.Equal(r.t, types.ReceiptStatusSuccessful, receipt.Status)
+	return receipt.Logs
+}
+
+// event converts a series registry log to the event which the emitter pushes
+func (r *testSeriesRegistry) event(eLog *types.Log) *SeriesRegistryEvent {
+	eventType, data, err := indexer.ParseSeriesRegistryLog(r.contract, *eLog)
+	if err != nil {
+		return nil
+	}
+
+	rawData, err := json.Marshal(data)
+	assert.NoError(r.t, err)
+
+	return &SeriesRegistryEvent{
+		Type:       eventType,
+		Contract:   r.address.Hex(),
+		TxID:       eLog.TxHash.Hex(),
+		EventIndex: eLog.Index,
+		Data:       rawData,
+	}
+}
+
+// registerSeries registers a series of the artist and returns its id
+func (r *testSeriesRegistry) registerSeries(artist int) string {
+	logs := r.transact(r.contract.RegisterSeries(r.auth(artist), r.account(artist), "ipfs://metadata", "ipfs://token-data"))
+	for _, l := range logs {
+		if ev, err := r.contract.ParseRegisterSeries(*l); err == nil {
+			return ev.SeriesID.Text(10)
+		}
+	}
+
+	r.t.Fatal("register series event is not found")
+	return ""
+}
+
+func newTestSeriesRegistryProcessor(r *testSeriesRegistry, store indexer.Store) *EventProcessor {
+	return &EventProcessor{
+		seriesRegistryContract: r.address.Hex(),
+		indexerStore:           store,
+		rpcClient:              r.backend.Client(),
+	}
+}
+
+// processSeriesRegistryLogs handles the series registry events of the logs with the processor of each event type
+func processSeriesRegistryLogs(t *testing.T, e *EventProcessor, r *testSeriesRegistry, logs []*types.Log) {
+	processors := map[SeriesRegistryEventType]seriesRegistryEventProcessorFunc{
+		SeriesRegistryEventTypeDeleteSeries:        e.deleteCollection,
+		SeriesRegistryEventTypeUpdateArtistAddress: e.replaceCollectionCreator,
+		SeriesRegistryEventTypeAssignSeries:        e.assignCollection,
+		SeriesRegistryEventTypeOptInCollaboration:  e.addCollectionCollaborator,
+		SeriesRegistryEventTypeOptOutSeries:        e.removeCollectionCreator,
+	}
+
+	for _, l := range logs {
+		event := r.event(l)
+		if event == nil {
+			continue
+		}
+		assert.NoError(t, processors[SeriesRegistryEventType(event.Type)](context.Background(), event))
+	}
+}
+
+func TestSeriesRegistryCollaboration(t *testing.T) {
+	if err := log.Initialize(false, nil); err != nil {
+		panic(err)
+	}
+
+	r := newTestSeriesRegistry(t, 3)
+	artist, collaborator := r.account(1), r.account(2)
+	seriesID := r.registerSeries(1)
+	seriesIDInt, _ := new(big.Int).SetString(seriesID, 10)
+
+	store := &testCollectionStore{collections: map[string]*indexer.Collection{
+		collectionID(seriesID): {ID: collectionID(seriesID), Creators: []string{artist.Hex()}},
+	}}
+	e := newTestSeriesRegistryProcessor(r, store)
+
+	r.transact(r.contract.InviteCollaborator(r.auth(1), seriesIDInt, collaborator))
+	processSeriesRegistryLogs(t, e, r, r.transact(r.contract.OptInCollaboration(r.auth(2), seriesIDInt)))
+	assert.Equal(t, []string{artist.Hex(), collaborator.Hex()}, store.collections[collectionID(seriesID)].Creators)
+
+	// opting out removes the artist and keeps the collection
+	processSeriesRegistryLogs(t, e, r, r.transact(r.contract.OptOutSeries(r.auth(1), seriesIDInt)))
+	assert.Equal(t, []string{collaborator.Hex()}, store.collections[collectionID(seriesID)].Creators)
+
+	// an event without the collaborator syncs the creators from the contract
+	store.collections[collectionID(seriesID)].Creators = nil
+	legacy := &SeriesRegistryEvent{
+		Type: string(SeriesRegistryEventTypeOptInCollaboration),
+		Data: []byte(`{"series_id":"` + seriesID + `"}`),
+	}
+	assert.NoError(t, e.addCollectionCollaborator(context.Background(), legacy))
+	assert.Equal(t, []string{collaborator.Hex()}, store.collections[collectionID(seriesID)].Creators)
+}
+
+func TestSeriesRegistryAssignSeries(t *testing.T) {
+	if err := log.Initialize(false, nil); err != nil {
+		panic(err)
+	}
+
+	r := newTestSeriesRegistry(t, 4)
+	assigner, assignee := r.account(1), r.account(2)
+	assignedSeriesID := r.registerSeries(1)
+	otherSeriesID := r.registerSeries(1)
+	assignedSeriesIDInt, _ := new(big.Int).SetString(assignedSeriesID, 10)
+	otherSeriesIDInt, _ := new(big.Int).SetString(otherSeriesID, 10)
+
+	store := &testCollectionStore{collections: map[string]*indexer.Collection{
+		collectionID(assignedSeriesID): {ID: collectionID(assignedSeriesID), Creators: []string{assigner.Hex()}},
+		collectionID(otherSeriesID):    {ID: collectionID(otherSeriesID), Creators: []string{assigner.Hex()}},
+	}}
+	e := newTestSeriesRegistryProcessor(r, store)
+
+	// only the assigned series moves to the assignee
+	processSeriesRegistryLogs(t, e, r, r.transact(r.contract.AssignSeries(r.auth(1), assignedSeriesIDInt, assignee)))
+	assert.Equal(t, []string{assignee.Hex()}, store.collections[collectionID(assignedSeriesID)].Creators)
+	assert.Equal(t, []string{assigner.Hex()}, store.collections[collectionID(otherSeriesID)].Creators)
+
+	artists, err := r.contract.GetSeriesArtistAddresses(nil, assignedSeriesIDInt)
+	assert.NoError(t, err)
+	assert.Equal(t, []common.Address{assignee}, artists)
+
+	// updating the artist address replaces the artist in all collections
+	processSeriesRegistryLogs(t, e, r, r.transact(r.contract.UpdateArtistAddress(r.auth(1), r.account(3))))
+	assert.Equal(t, []string{r.account(3).Hex()}, store.collections[collectionID(otherSeriesID)].Creators)
+
+	// deleting a series deletes the collection
+	processSeriesRegistryLogs(t, e, r, r.transact(r.contract.DeleteSeries(r.auth(3), otherSeriesIDInt)))
+	assert.NotContains(t, store.collections, collectionID(otherSeriesID))
+	assert.Contains(t, store.collections, collectionID(assignedSeriesID))
+}
@@ -6,7 +6,8 @@ import (
 	"time"
 
 	log "github.com/bitmark-inc/autonomy-logger"
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
@@ -22,13 +23,17 @@ type EventProcessor struct {
 	ipfsGateways           []string
 	defaultCheckInterval   time.Duration
 	eventExpiryDuration    time.Duration
+	retryPolicy            RetryPolicy
+	workerPool             WorkerPoolConfig
+	metricsAddress         string
 
 	grpcServer   *GRPCServer
-	eventQueue   *EventQueue
+	adminServer  *AdminServer
+	eventQueue   EventQueue
 	grpcGateway  *grpcGatewaySDK.GRPCClient
 	worker       *cadence.WorkerClient
 	indexerStore indexer.Store
-	rpcClient    *ethclient.Client
+	rpcClient    bind.ContractBackend
 }
 
 func NewEventProcessor(
@@ -37,23 +42,30 @@ func NewEventProcessor(
 	ipfsGateways []string,
 	defaultCheckInterval time.Duration,
 	eventExpiryDuration time.Duration,
+	retryPolicy RetryPolicy,
+	workerPool WorkerPoolConfig,
 	network string,
 	address string,
-	store EventStore,
+	adminAddress string,
+	adminAPIToken string,
+	metricsAddress string,
+	queue EventQueue,
 	grpcGateway *grpcGatewaySDK.GRPCClient,
 	worker *cadence.WorkerClient,
 	indexerStore indexer.Store,
-	rpcClient *ethclient.Client,
+	rpcClient bind.ContractBackend,
 ) *EventProcessor {
-	queue := NewEventQueue(store)
 	grpcServer := NewGRPCServer(network, address, queue)
 
-	return &EventProcessor{
+	e := &EventProcessor{
 		environment:            environment,
 		seriesRegistryContract: seriesRegistryContract,
 		ipfsGateways:           ipfsGateways,
 		defaultCheckInterval:   defaultCheckInterval,
 		eventExpiryDuration:    eventExpiryDuration,
+		retryPolicy:            retryPolicy,
+		workerPool:             workerPool,
+		metricsAddress:         metricsAddress,
 
 		grpcServer:   grpcServer,
 		eventQueue:   queue,
@@ -62,16 +74,19 @@ func NewEventProcessor(
 		indexerStore: indexerStore,
 		rpcClient:    rpcClient,
 	}
+	e.adminServer = NewAdminServer(adminAddress, adminAPIToken, queue.Store(), worker, e)
+
+	return e
 }
 
 // removeDeprecatedNftEvents removes expired archived events
 func (e *EventProcessor) removeDeprecatedNftEvents() error {
-	return e.eventQueue.store.DeleteNftEvents(e.eventExpiryDuration)
+	return e.eventQueue.Store().DeleteNftEvents(e.eventExpiryDuration)
 }
 
 // removeDeprecatedSeriesRegistryEvents removes expired archived series registry events
 func (e *EventProcessor) removeDeprecatedSeriesRegistryEvents() error {
-	return e.eventQueue.store.DeleteSeriesRegistryEvents(e.eventExpiryDuration)
+	return e.eventQueue.Store().DeleteSeriesRegistryEvents(e.eventExpiryDuration)
 }
 
 // PruneDeprecatedEventsCronjob runs the cron job in a goroutine to remove expired events
@@ -108,6 +123,15 @@ func (e *EventProcessor) Run(ctx context.Context) {
 
 	e.ProcessEvents(ctx)
 
+	go e.ServeMetrics(ctx)
+	go e.ReportBacklogMetrics(ctx)
+
+	go func() {
+		if err := e.adminServer.Run(ctx); err != nil {
+			log.ErrorWithContext(ctx, errors.New("admin server stopped with error"), zap.Error(err))
+		}
+	}()
+
 	if err := e.grpcServer.Run(); err != nil {
 		log.ErrorWithContext(ctx, errors.New("gRPC stopped with error"), zap.Error(err))
 	}
@@ -123,65 +147,127 @@ func (e *EventProcessor) StartNftEventWorker(ctx context.Context, currentStage,
 		checkInterval = time.Second * time.Duration(checkIntervalSecond)
 	}
 
-	go func() {
-		for {
-			select {
-			case <-ctx.Done():
-				log.InfoWithContext(ctx, "process stopped")
-				return
-			default:
-				e.logStageEvent(ctx, currentStage, "query event")
+	workers := e.workerPool.WorkersOf(NftEventStages[currentStage])
+	for i := 0; i < workers; i++ {
+		go e.runNftEventWorker(ctx, uuid.NewString(), currentStage, nextStage, types, checkInterval, deferSecond, processor)
+	}
+}
 
-				filters := []FilterOption{
-					Filter("type = ANY(?)", pq.Array(types)),
-					Filter("status = ANY(?)", pq.Array([]NftEventStatus{NftEventStatusCreated, NftEventStatusProcessing})),
-					Filter("stage = ?", NftEventStages[currentStage]),
-				}
+// runNftEventWorker leases batches of events of a stage and processes them one by one
+func (e *EventProcessor) runNftEventWorker(ctx context.Context, workerID string, currentStage, nextStage Stage,
+	types []NftEventType, checkInterval time.Duration, deferSecond int64, processor nftEventProcessorFunc) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.InfoWithContext(ctx, "process stopped")
+			return
+		default:
+			e.logStageEvent(ctx, currentStage, "query event")
+
+			filters := []FilterOption{
+				Filter("type = ANY(?)", pq.Array(types)),
+				Filter("status = ANY(?)", pq.Array([]NftEventStatus{NftEventStatusCreated, NftEventStatusProcessing, NftEventStatusFailed})),
+				Filter("stage = ?", NftEventStages[currentStage]),
+				Filter("next_attempt_at <= ?", time.Now()),
+			}
 
-				if deferSecond > 0 {
-					filters = append(filters, Filter("created_at < ?", time.Now().Add(-time.Duration(deferSecond)*time.Second)))
-				}
+			if deferSecond > 0 {
+				filters = append(filters, Filter("created_at < ?", time.Now().Add(-time.Duration(deferSecond)*time.Second)))
+			}
 
-				eventTx, err := e.eventQueue.GetNftEventTransaction(ctx, filters...)
-				if err != nil {
-					if errors.Is(err, gorm.ErrRecordNotFound) {
-						log.InfoWithContext(ctx, "No new events")
-					} else {
-						log.WarnWithContext(ctx, "Fail to get a event db transaction", zap.Error(err))
-					}
-					time.Sleep(checkInterval)
-					continue
-				}
-				e.logStartStage(ctx, eventTx.NftEvent.ID, currentStage)
-				if err := processor(ctx, eventTx.NftEvent); err != nil {
-					log.ErrorWithContext(ctx, errors.New("stage processing failed"), zap.Error(err))
-					if err := eventTx.UpdateNftEvent("", string(NftEventStatusFailed)); err != nil {
-						log.ErrorWithContext(ctx, errors.New("fail to update event"), zap.Error(err))
-						eventTx.Rollback()
-					}
-				}
+			// the signal is taken before leasing, so new events pushed meanwhile wake up the worker
+			signal := e.eventQueue.NftEventsSignal(NftEventStages[currentStage])
+			events, err := e.eventQueue.LeaseNftEvents(ctx, workerID, e.workerPool.LeaseDuration, e.workerPool.BatchSize, filters...)
+			if err != nil {
+				log.WarnWithContext(ctx, "Fail to lease events", zap.Error(err))
+				time.Sleep(checkInterval)
+				continue
+			}
 
-				// stage starts from 1. stage zero means there is no next stage.
-				if nextStage == NftEventStageDone {
-					if err := eventTx.ArchiveNFTEvent(); err != nil {
-						log.ErrorWithContext(ctx, errors.New("fail to archive event"), zap.Error(err))
-						eventTx.Rollback()
-					}
-				} else {
-					if err := eventTx.UpdateNftEvent(NftEventStages[nextStage], ""); err != nil {
-						log.ErrorWithContext(ctx, errors.New("fail to update event"), zap.Error(err))
-						eventTx.Rollback()
-					}
-				}
+			if len(events) == 0 {
+				log.InfoWithContext(ctx, "No new events")
+				waitSignal(ctx, signal, checkInterval)
+				continue
+			}
 
-				eventTx.Commit()
-				e.logEndStage(ctx, eventTx.NftEvent.ID, currentStage)
+			for _, event := range events {
+				e.processNftEvent(ctx, event, currentStage, nextStage, processor)
 			}
 		}
-	}()
+	}
+}
+
+// processNftEvent processes a leased event and moves it to the next stage. A failed
+// event is released for a later attempt. If the result can not be saved, the event
+// is processed again once its lease expires.
+func (e *EventProcessor) processNftEvent(ctx context.Context, event NFTEvent,
+	currentStage, nextStage Stage, processor nftEventProcessorFunc) {
+	e.logStartStage(ctx, event.ID, currentStage)
+
+	startedAt := time.Now()
+	processErr := processor(ctx, event)
+	observeStage(metricsQueueNft, NftEventStages[currentStage], event.Type, startedAt, processErr)
+
+	eventTx, err := e.eventQueue.BeginNftEventTx(ctx, event)
+	if err != nil {
+		log.ErrorWithContext(ctx, errors.New("fail to begin an event db transaction"), zap.Error(err))
+		return
+	}
+
+	if processErr != nil {
+		log.ErrorWithContext(ctx, errors.New("stage processing failed"), zap.Error(processErr))
+		status, err := eventTx.FailNftEvent(e.retryPolicy, processErr)
+		if err != nil {
+			logNftEventUpdateError(ctx, event, "fail to update event", err)
+			eventTx.Rollback()
+			return
+		}
+
+		if status == NftEventStatusDeadLetter {
+			log.WarnWithContext(ctx, "event is moved to the dead letter queue",
+				zap.String("eventID", event.ID), zap.Int8("stage", int8(currentStage)))
+		}
+
+		eventTx.Commit()
+		return
+	}
+
+	// stage starts from 1. stage zero means there is no next stage.
+	if nextStage == NftEventStageDone {
+		if err := eventTx.ArchiveNFTEvent(); err != nil {
+			logNftEventUpdateError(ctx, event, "fail to archive event", err)
+			eventTx.Rollback()
+			return
+		}
+	} else {
+		if err := eventTx.UpdateNftEvent(NftEventStages[nextStage], ""); err != nil {
+			logNftEventUpdateError(ctx, event, "fail to update event", err)
+			eventTx.Rollback()
+			return
+		}
+	}
+
+	eventTx.Commit()
+	if nextStage != NftEventStageDone {
+		e.eventQueue.NotifyNftEvents(ctx, NftEventStages[nextStage])
+	}
+	e.logEndStage(ctx, event.ID, currentStage)
 }
 
-type seriesRegistryEventProcessorFunc func(ctx context.Context, event SeriesRegistryEvent) error
+// logNftEventUpdateError logs an error of saving the result of an event. A lost lease is expected
+// when the processing outlives the lease, and the event is processed again by its new lease owner.
+func logNftEventUpdateError(ctx context.Context, event NFTEvent, message string, err error) {
+	if errors.Is(err, ErrNftEventLeaseLost) {
+		log.WarnWithContext(ctx, "lease of the event is lost, the result is discarded", zap.String("eventID", event.ID))
+		return
+	}
+
+	log.ErrorWithContext(ctx, errors.New(message), zap.Error(err))
+}
+
+// seriesRegistryEventProcessorFunc processes a series registry event. A processor which starts
+// a workflow sets the WorkflowID of the event and it is saved along with the stage.
+type seriesRegistryEventProcessorFunc func(ctx context.Context, event *SeriesRegistryEvent) error
 
 func (e *EventProcessor) StartSeriesRegistryEventWorker(ctx context.Context, currentStage, nextStage Stage,
 	types []SeriesRegistryEventType, checkIntervalSecond, deferSecond int64, processor seriesRegistryEventProcessorFunc) {
@@ -202,38 +288,62 @@ func (e *EventProcessor) StartSeriesRegistryEventWorker(ctx context.Context, cur
 
 				filters := []FilterOption{
 					Filter("type = ANY(?)", pq.Array(types)),
-					Filter("status = ANY(?)", pq.Array([]SeriesRegistryEventStatus{SeriesRegistryEventStatusCreated, SeriesRegistryEventStatusProcessing})),
+					Filter("status = ANY(?)", pq.Array([]SeriesRegistryEventStatus{SeriesRegistryEventStatusCreated, SeriesRegistryEventStatusProcessing, SeriesRegistryEventStatusFailed})),
 					Filter("stage = ?", SeriesEventStages[currentStage]),
+					Filter("next_attempt_at <= ?", time.Now()),
 				}
 
 				if deferSecond > 0 {
 					filters = append(filters, Filter("created_at < ?", time.Now().Add(-time.Duration(deferSecond)*time.Second)))
 				}
 
+				signal := e.eventQueue.SeriesRegistryEventsSignal(SeriesEventStages[currentStage])
 				eventTx, err := e.eventQueue.GetSeriesRegistryEventTransaction(ctx, filters...)
 				if err != nil {
 					if errors.Is(err, gorm.ErrRecordNotFound) {
 						log.InfoWithContext(ctx, "No new events")
+						waitSignal(ctx, signal, checkInterval)
 					} else {
 						log.WarnWithContext(ctx, "Fail to get a event db transaction", zap.Error(err))
+						time.Sleep(checkInterval)
 					}
-					time.Sleep(checkInterval)
 					continue
 				}
 				e.logStartStage(ctx, eventTx.Event.ID, currentStage)
 				if err := eventTx.UpdateSeriesRegistryEvent("", string(SeriesRegistryEventStatusProcessing)); err != nil {
 					log.ErrorWithContext(ctx, errors.New("fail to update series event status processing"), zap.Error(err))
 					eventTx.Rollback()
+					continue
 				}
-				if err := processor(ctx, eventTx.Event); err != nil {
+				startedAt := time.Now()
+				err = processor(ctx, &eventTx.Event)
+				observeStage(metricsQueueSeriesRegistry, SeriesEventStages[currentStage], eventTx.Event.Type, startedAt, err)
+				if err != nil {
 					log.ErrorWithContext(ctx, errors.New("stage processing failed"), zap.Error(err))
-					if err := eventTx.UpdateSeriesRegistryEvent("", string(SeriesRegistryEventStatusFailed)); err != nil {
+					status, err := eventTx.FailSeriesRegistryEvent(e.retryPolicy, err)
+					if err != nil {
 						log.ErrorWithContext(ctx, errors.New("fail to update series event status failed"), zap.Error(err))
 						eventTx.Rollback()
+						continue
+					}
+
+					if status == SeriesRegistryEventStatusDeadLetter {
+						log.WarnWithContext(ctx, "event is moved to the dead letter queue",
+							zap.String("eventID", eventTx.Event.ID), zap.Int8("stage", int8(currentStage)))
 					}
+
+					eventTx.Commit()
 					continue
 				}
 
+				if eventTx.Event.WorkflowID != "" {
+					if err := eventTx.SetSeriesRegistryEventWorkflowID(eventTx.Event.WorkflowID); err != nil {
+						log.ErrorWithContext(ctx, errors.New("fail to save event workflow id"), zap.Error(err))
+						eventTx.Rollback()
+						continue
+					}
+				}
+
 				// stage starts from 1. stage zero means there is no next stage.
 				if nextStage == SeriesRegistryEventStageDone {
 					if err := eventTx.UpdateSeriesRegistryEvent("", string(SeriesRegistryEventStatusProcessed)); err != nil {
@@ -279,6 +389,9 @@ func (e *EventProcessor) ProcessEvents(ctx context.Context) {
 	//stage 5: index token sale
 	e.IndexTokenSale(ctx)
 
+	// revert the changes of events from orphaned blocks
+	e.RevertOwnerAndProvenance(ctx)
+
 	//--------------------------------------
 	//---Series Registry Event Processing---
 	//--------------------------------------
@@ -288,5 +401,10 @@ func (e *EventProcessor) ProcessEvents(ctx context.Context) {
 	e.IndexCollection(ctx)
 	e.DeleteCollection(ctx)
 	e.ReplaceCollectionCreator(ctx)
-	e.UpdateCollectionCreators(ctx)
+	e.AssignCollection(ctx)
+	e.AddCollectionCollaborator(ctx)
+	e.RemoveCollectionCreator(ctx)
+
+	// reconcile the collections changed by events from orphaned blocks
+	e.ReconcileRevertedSeries(ctx)
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/fatih/structs"
@@ -21,6 +23,7 @@ const (
 	NftEventTypeBurned       NftEventType = "burned"
 	NftEventTypeTransfer     NftEventType = "transfer"
 	NftEventTypeTokenUpdated NftEventType = "token_updated"
+	NftEventTypeReverted     NftEventType = "reverted"
 )
 
 type NftEventStatus string
@@ -30,6 +33,7 @@ const (
 	NftEventStatusProcessing NftEventStatus = "processing"
 	NftEventStatusProcessed  NftEventStatus = "processed"
 	NftEventStatusFailed     NftEventStatus = "failed"
+	NftEventStatusDeadLetter NftEventStatus = "dead_letter"
 )
 
 type SeriesRegistryEventType string
@@ -42,6 +46,9 @@ const (
 	SeriesRegistryEventTypeOptInCollaboration  SeriesRegistryEventType = "opt_in_collaboration"
 	SeriesRegistryEventTypeOptOutSeries        SeriesRegistryEventType = "opt_out_series"
 	SeriesRegistryEventTypeAssignSeries        SeriesRegistryEventType = "assign_series"
+	// SeriesRegistryEventTypeReverted is the type of compensating events for series
+	// registry events which were emitted from orphaned blocks
+	SeriesRegistryEventTypeReverted SeriesRegistryEventType = "reverted"
 )
 
 type SeriesRegistryEventStatus string
@@ -51,6 +58,7 @@ const (
 	SeriesRegistryEventStatusProcessing SeriesRegistryEventStatus = "processing"
 	SeriesRegistryEventStatusProcessed  SeriesRegistryEventStatus = "processed"
 	SeriesRegistryEventStatusFailed     SeriesRegistryEventStatus = "failed"
+	SeriesRegistryEventStatusDeadLetter SeriesRegistryEventStatus = "dead_letter"
 )
 
 // NFTEvent is the model for processed token events
@@ -64,6 +72,8 @@ type ArchivedNFTEvent struct {
 	To         string         `gorm:"index"`
 	TXID       string         `gorm:"index"`
 	EventIndex uint           `gorm:"index"`
+	BatchIndex uint           `gorm:"NOT NULL;default:0"`
+	Amount     string         `gorm:"default:'1'"`
 	TXTime     time.Time      `gorm:"index"`
 	Stage      string         `gorm:"index"`
 	Status     NftEventStatus `gorm:"index"`
@@ -78,19 +88,28 @@ func (ArchivedNFTEvent) TableName() string {
 // NFTEvent is the model for token events
 type NFTEvent struct {
 	ID         string         `gorm:"primaryKey;size:255;default:uuid_generate_v4()"`
-	Type       string         `gorm:"index:idx_event,unique"`
-	Blockchain string         `gorm:"index:idx_event,unique"`
-	Contract   string         `gorm:"index:idx_event,unique"`
-	TokenID    string         `gorm:"index:idx_event,unique"`
-	From       string         `gorm:"index:idx_event,unique"`
-	To         string         `gorm:"index:idx_event,unique"`
-	TXID       string         `gorm:"index:idx_event,unique"`
-	EventIndex uint           `gorm:"index:idx_event,unique"`
-	TXTime     time.Time      `gorm:"index:idx_event,unique"`
+	Type       string         `gorm:"index:idx_nft_event,unique"`
+	Blockchain string         `gorm:"index:idx_nft_event,unique;index:idx_nft_event_token,priority:1"`
+	Contract   string         `gorm:"index:idx_nft_event,unique;index:idx_nft_event_token,priority:2"`
+	TokenID    string         `gorm:"index:idx_nft_event,unique;index:idx_nft_event_token,priority:3"`
+	From       string         `gorm:"index:idx_nft_event,unique"`
+	To         string         `gorm:"index:idx_nft_event,unique"`
+	TXID       string         `gorm:"index:idx_nft_event,unique"`
+	EventIndex uint           `gorm:"index:idx_nft_event,unique"`
+	BatchIndex uint           `gorm:"index:idx_nft_event,unique;NOT NULL;default:0"`
+	Amount     string         `gorm:"default:'1'"`
+	TXTime     time.Time      `gorm:"index:idx_nft_event,unique"`
 	Stage      string         `gorm:"index"`
 	Status     NftEventStatus `gorm:"index"`
-	CreatedAt  time.Time      `gorm:"default:now()"`
+	CreatedAt  time.Time      `gorm:"default:now();index:idx_nft_event_token,priority:4"`
 	UpdatedAt  time.Time      `gorm:"default:now()"`
+
+	Attempts      int       `gorm:"NOT NULL;default:0"`
+	NextAttemptAt time.Time `gorm:"index;default:now()"`
+	LastError     string
+
+	LeaseOwner     *string
+	LeaseExpiresAt *time.Time `gorm:"index"`
 }
 
 func (NFTEvent) TableName() string {
@@ -110,12 +129,23 @@ type SeriesRegistryEvent struct {
 	Status     SeriesRegistryEventStatus `gorm:"index"`
 	CreatedAt  time.Time                 `gorm:"default:now()"`
 	UpdatedAt  time.Time                 `gorm:"default:now()"`
+
+	Attempts      int       `gorm:"NOT NULL;default:0"`
+	NextAttemptAt time.Time `gorm:"index;default:now()"`
+	LastError     string
+
+	// WorkflowID is the id of the cadence workflow which is started by the event
+	WorkflowID string
 }
 
 func (SeriesRegistryEvent) TableName() string {
 	return "series_registry_events"
 }
 
+// ErrNftEventLeaseLost is returned when a leased nft event is updated after its lease
+// has expired or it has been leased by another worker
+var ErrNftEventLeaseLost = errors.New("nft event lease lost")
+
 // NftEventTx is an transaction object with nft event values
 type NftEventTx struct {
 	*gorm.DB
@@ -144,7 +174,30 @@ func (tx *NftEventTx) UpdateNftEvent(stage, status string) error {
 		return fmt.Errorf("nothing for update to a nft event")
 	}
 
-	return tx.DB.Model(&NFTEvent{}).Where("id = ?", tx.NftEvent.ID).Updates(updates).Error
+	// the event is released once it is updated
+	updates["lease_owner"] = nil
+	updates["lease_expires_at"] = nil
+
+	return leaseResult(tx.leased().Updates(updates))
+}
+
+// FailNftEvent records a failed attempt of the nft event. The event is scheduled for
+// another attempt or dead-lettered when the retry policy is exhausted.
+func (tx *NftEventTx) FailNftEvent(policy RetryPolicy, cause error) (NftEventStatus, error) {
+	attempts := tx.NftEvent.Attempts + 1
+	status := NftEventStatusFailed
+	if policy.Exhausted(attempts) {
+		status = NftEventStatusDeadLetter
+	}
+
+	return status, leaseResult(tx.leased().Updates(map[string]interface{}{
+		"status":           status,
+		"attempts":         attempts,
+		"last_error":       cause.Error(),
+		"next_attempt_at":  time.Now().Add(policy.Backoff(attempts)),
+		"lease_owner":      nil,
+		"lease_expires_at": nil,
+	}))
 }
 
 // ArchiveNFTEvent save an ArchiveNFTEvent and delete the NFTEvent
@@ -157,6 +210,9 @@ func (tx *NftEventTx) ArchiveNFTEvent() error {
 		From:       tx.NftEvent.From,
 		To:         tx.NftEvent.To,
 		TXID:       tx.NftEvent.TXID,
+		EventIndex: tx.NftEvent.EventIndex,
+		BatchIndex: tx.NftEvent.BatchIndex,
+		Amount:     tx.NftEvent.Amount,
 		TXTime:     tx.NftEvent.TXTime,
 		CreatedAt:  tx.NftEvent.CreatedAt,
 		Status:     NftEventStatusProcessed,
@@ -166,7 +222,30 @@ func (tx *NftEventTx) ArchiveNFTEvent() error {
 		return err
 	}
 
-	return tx.DB.Where("id = ?", tx.NftEvent.ID).Delete(&NFTEvent{}).Error
+	return leaseResult(tx.leased().Delete(&NFTEvent{}))
+}
+
+// leased scopes a query to the event while it is still leased by the worker of the transaction
+func (tx *NftEventTx) leased() *gorm.DB {
+	owner := ""
+	if tx.NftEvent.LeaseOwner != nil {
+		owner = *tx.NftEvent.LeaseOwner
+	}
+
+	return tx.DB.Model(&NFTEvent{}).
+		Where("id = ? AND lease_owner = ? AND lease_expires_at > now()", tx.NftEvent.ID, owner)
+}
+
+// leaseResult returns ErrNftEventLeaseLost when a leased query changes nothing
+func leaseResult(result *gorm.DB) error {
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNftEventLeaseLost
+	}
+
+	return nil
 }
 
 // SeriesRegistryEventTx is an transaction object with series registry event values
@@ -200,6 +279,28 @@ func (tx *SeriesRegistryEventTx) UpdateSeriesRegistryEvent(stage, status string)
 	return tx.DB.Model(&SeriesRegistryEvent{}).Where("id = ?", tx.Event.ID).Updates(updates).Error
 }
 
+// SetSeriesRegistryEventWorkflowID records the workflow which is started by the event
+func (tx *SeriesRegistryEventTx) SetSeriesRegistryEventWorkflowID(workflowID string) error {
+	return tx.DB.Model(&SeriesRegistryEvent{}).Where("id = ?", tx.Event.ID).Update("workflow_id", workflowID).Error
+}
+
+// FailSeriesRegistryEvent records a failed attempt of the series registry event. The event is
+// scheduled for another attempt or dead-lettered when the retry policy is exhausted.
+func (tx *SeriesRegistryEventTx) FailSeriesRegistryEvent(policy RetryPolicy, cause error) (SeriesRegistryEventStatus, error) {
+	attempts := tx.Event.Attempts + 1
+	status := SeriesRegistryEventStatusFailed
+	if policy.Exhausted(attempts) {
+		status = SeriesRegistryEventStatusDeadLetter
+	}
+
+	return status, tx.DB.Model(&SeriesRegistryEvent{}).Where("id = ?", tx.Event.ID).Updates(map[string]interface{}{
+		"status":          status,
+		"attempts":        attempts,
+		"last_error":      cause.Error(),
+		"next_attempt_at": time.Now().Add(policy.Backoff(attempts)),
+	}).Error
+}
+
 // FilterOption is an abstraction to help filtering events with
 // specific conditions
 type FilterOption struct {
@@ -231,12 +332,23 @@ func (p *Pagination) Apply(tx *gorm.DB) *gorm.DB {
 
 type EventStore interface {
 	CreateNftEvent(event NFTEvent) error
-	GetNftEventTransaction(ctx context.Context, filters ...FilterOption) (*NftEventTx, error)
+	LeaseNftEvents(ctx context.Context, owner string, duration time.Duration, size int, filters ...FilterOption) ([]NFTEvent, error)
+	BeginNftEventTx(ctx context.Context, event NFTEvent) (*NftEventTx, error)
 	DeleteNftEvents(duration time.Duration) error
+	DeleteRevertedNftEvents(event NFTEvent) error
+	GetNftEvent(ctx context.Context, id string) (*NFTEvent, error)
+	GetNftEventsByStatus(ctx context.Context, status NftEventStatus, pagination Pagination) ([]NFTEvent, error)
+	RequeueNftEvent(ctx context.Context, id string) error
+	GetNftEventBacklog(ctx context.Context) ([]EventBacklog, error)
 
-	CreateSeriesRegistryEvent(event SeriesRegistryEvent) error
+	CreateSeriesRegistryEvent(event SeriesRegistryEvent) (bool, error)
 	GetSeriesRegistryEventTransaction(ctx context.Context, filters ...FilterOption) (*SeriesRegistryEventTx, error)
 	DeleteSeriesRegistryEvents(duration time.Duration) error
+	DeleteRevertedSeriesRegistryEvents(event SeriesRegistryEvent) error
+	GetSeriesRegistryEvent(ctx context.Context, id string) (*SeriesRegistryEvent, error)
+	GetSeriesRegistryEventsByStatus(ctx context.Context, status SeriesRegistryEventStatus, pagination Pagination) ([]SeriesRegistryEvent, error)
+	RequeueSeriesRegistryEvent(ctx context.Context, id string) error
+	GetSeriesRegistryEventBacklog(ctx context.Context) ([]EventBacklog, error)
 }
 
 type PostgresEventStore struct {
@@ -256,10 +368,11 @@ func NewPostgresEventStore(db *gorm.DB) *PostgresEventStore {
 // CreateNftEvent add a new event into nft event store.
 func (s *PostgresEventStore) CreateNftEvent(event NFTEvent) error {
 	err := s.db.Exec(`
-	INSERT INTO new_nft_events("type","blockchain","contract","token_id","from","to","tx_id","event_index","tx_time","stage","status")
-	SELECT @Type, @Blockchain, @Contract, @TokenID, @From, @To, @TXID, @EventIndex, @TXTime, @Stage, @Status
+	INSERT INTO new_nft_events("type","blockchain","contract","token_id","from","to","tx_id","event_index","batch_index","amount","tx_time","stage","status")
+	SELECT @Type, @Blockchain, @Contract, @TokenID, @From, @To, @TXID, @EventIndex, @BatchIndex, @Amount, @TXTime, @Stage, @Status
 	WHERE NOT EXISTS (SELECT * FROM nft_events WHERE "type"=@Type AND "blockchain"=@Blockchain AND "contract"=@Contract
-		AND "token_id"=@TokenID AND "from"=@From AND "to"=@To AND "tx_id"=@TXID AND "event_index"=@EventIndex)`, structs.Map(event)).Error
+		AND "token_id"=@TokenID AND "from"=@From AND "to"=@To AND "tx_id"=@TXID AND "event_index"=@EventIndex
+		AND "batch_index"=@BatchIndex)`, structs.Map(event)).Error
 
 	var pgError *pgconn.PgError
 	if err != nil && errors.As(err, &pgError) {
@@ -271,24 +384,90 @@ func (s *PostgresEventStore) CreateNftEvent(event NFTEvent) error {
 	return err
 }
 
-// GetNftEventTransaction returns an NftEventTx
-func (s *PostgresEventStore) GetNftEventTransaction(ctx context.Context, filters ...FilterOption) (*NftEventTx, error) {
-	var event NFTEvent
+// LeaseNftEvents leases a batch of nft events to a worker until the lease expires.
+// Events with an expired lease can be leased again. An event is held back by the older
+// unfinished events of its token which are in the same stage or an earlier one, so the
+// events of a token go through every stage in the created order. The older events which
+// wait for a retry, are dead-lettered or are in a later stage, e.g. the double sync of
+// a token update, do not hold back the later events of their token.
+func (s *PostgresEventStore) LeaseNftEvents(ctx context.Context, owner string, duration time.Duration, size int, filters ...FilterOption) ([]NFTEvent, error) {
+	var leased []NFTEvent
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		q := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+		for _, filter := range filters {
+			q = filter.Apply(q)
+		}
 
-	tx := s.db.WithContext(ctx).Begin()
-	if err := tx.Error; err != nil {
+		var events []NFTEvent
+		err := q.
+			Where("(lease_expires_at IS NULL OR lease_expires_at < ?)", now).
+			Where(`NOT EXISTS (SELECT 1 FROM new_nft_events l WHERE l.blockchain = new_nft_events.blockchain
+				AND l.contract = new_nft_events.contract AND l.token_id = new_nft_events.token_id
+				AND l.status <> ? AND l.next_attempt_at <= ?
+				AND `+nftEventStageOrder("l.stage")+` <= `+nftEventStageOrder("new_nft_events.stage")+`
+				AND (l.created_at < new_nft_events.created_at
+					OR (l.created_at = new_nft_events.created_at AND l.id < new_nft_events.id)))`,
+				NftEventStatusDeadLetter, now).
+			Order("created_at asc, id asc").
+			Limit(size).
+			Find(&events).Error
+		if err != nil {
+			return err
+		}
+
+		now = time.Now()
+		leaseExpiresAt := now.Add(duration)
+		ids := []string{}
+		for _, event := range firstEventPerToken(events, size) {
+			event.LeaseOwner = &owner
+			event.LeaseExpiresAt = &leaseExpiresAt
+			event.Status = NftEventStatusProcessing
+			leased = append(leased, event)
+			ids = append(ids, event.ID)
+		}
+
+		if len(ids) == 0 {
+			return nil
+		}
+
+		return tx.Model(&NFTEvent{}).Where("id IN ?", ids).Updates(map[string]interface{}{
+			"lease_owner":      owner,
+			"lease_expires_at": leaseExpiresAt,
+			"status":           NftEventStatusProcessing,
+		}).Error
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	q := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
-	for _, filter := range filters {
-		q = filter.Apply(q)
+	return leased, nil
+}
+
+// nftEventStageOrder returns the SQL expression of the order of a stage column in the pipeline.
+// It is NULL for an unknown stage.
+func nftEventStageOrder(column string) string {
+	stages := make([]Stage, 0, len(NftEventStages))
+	for stage := range NftEventStages {
+		stages = append(stages, stage)
 	}
+	slices.Sort(stages)
 
-	// final query
-	q = q.Order("created_at asc").First(&event)
-	if err := q.Error; err != nil {
-		tx.Rollback()
+	var expr strings.Builder
+	expr.WriteString("CASE " + column)
+	for _, stage := range stages {
+		fmt.Fprintf(&expr, " WHEN '%s' THEN %d", NftEventStages[stage], stage)
+	}
+	expr.WriteString(" END")
+
+	return expr.String()
+}
+
+// BeginNftEventTx starts a transaction to update a leased nft event
+func (s *PostgresEventStore) BeginNftEventTx(ctx context.Context, event NFTEvent) (*NftEventTx, error) {
+	tx := s.db.WithContext(ctx).Begin()
+	if err := tx.Error; err != nil {
 		return nil, err
 	}
 
@@ -299,22 +478,93 @@ func (s *PostgresEventStore) DeleteNftEvents(duration time.Duration) error {
 	return s.db.Where("created_at < ?", time.Now().Add(-duration)).Delete(&ArchivedNFTEvent{}).Error
 }
 
-// CreateSeriesRegistryEvent add a new event into series registry event store.
-func (s *PostgresEventStore) CreateSeriesRegistryEvent(event SeriesRegistryEvent) error {
-	err := s.db.Exec(`
+// DeleteRevertedNftEvents removes the queued and archived events which are reverted by
+// a given reverted event. Removing the archived ones allows the same transfer to be
+// indexed again once it is included in the canonical chain.
+func (s *PostgresEventStore) DeleteRevertedNftEvents(event NFTEvent) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where(`"id" <> ? AND "blockchain" = ? AND "contract" = ? AND "token_id" = ? AND "tx_id" = ? AND "event_index" = ? AND "batch_index" = ?`,
+			event.ID, event.Blockchain, event.Contract, event.TokenID, event.TXID, event.EventIndex, event.BatchIndex).
+			Delete(&NFTEvent{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where(`"type" <> ? AND "blockchain" = ? AND "contract" = ? AND "token_id" = ? AND "tx_id" = ? AND "event_index" = ? AND "batch_index" = ?`,
+			NftEventTypeReverted, event.Blockchain, event.Contract, event.TokenID, event.TXID, event.EventIndex, event.BatchIndex).
+			Delete(&ArchivedNFTEvent{}).Error
+	})
+}
+
+// GetNftEvent returns a queued nft event by id
+func (s *PostgresEventStore) GetNftEvent(ctx context.Context, id string) (*NFTEvent, error) {
+	var event NFTEvent
+	if err := s.db.WithContext(ctx).Where("id = ?", id).First(&event).Error; err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// GetNftEventsByStatus returns queued nft events of a status ordered by the latest update
+func (s *PostgresEventStore) GetNftEventsByStatus(ctx context.Context, status NftEventStatus, pagination Pagination) ([]NFTEvent, error) {
+	var events []NFTEvent
+	err := pagination.Apply(s.db.WithContext(ctx)).
+		Where("status = ?", status).
+		Order("updated_at desc").
+		Find(&events).Error
+
+	return events, err
+}
+
+// RequeueNftEvent moves a dead-lettered nft event back to the queue with a fresh retry budget.
+// The event continues from the stage where it failed.
+func (s *PostgresEventStore) RequeueNftEvent(ctx context.Context, id string) error {
+	r := s.db.WithContext(ctx).Model(&NFTEvent{}).
+		Where("id = ? AND status = ?", id, NftEventStatusDeadLetter).
+		Updates(map[string]interface{}{
+			"status":          NftEventStatusCreated,
+			"attempts":        0,
+			"next_attempt_at": time.Now(),
+			"updated_at":      time.Now(),
+		})
+	if r.Error != nil {
+		return r.Error
+	}
+
+	if r.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// CreateSeriesRegistryEvent add a new event into series registry event store. It returns
+// whether the event is added, since an event of the same log is skipped.
+func (s *PostgresEventStore) CreateSeriesRegistryEvent(event SeriesRegistryEvent) (bool, error) {
+	result := s.db.Exec(`
 	INSERT INTO series_registry_events("type","contract","data","tx_id","event_index","tx_time","stage","status")
 	SELECT @Type, @Contract, @Data, @TxID, @EventIndex, @TxTime, @Stage, @Status
 	WHERE NOT EXISTS (SELECT * FROM series_registry_events WHERE "type"=@Type AND "contract"=@Contract
-		AND "tx_id"=@TxID AND "event_index"=@EventIndex)`, structs.Map(event)).Error
+		AND "tx_id"=@TxID AND "event_index"=@EventIndex)`, structs.Map(event))
 
 	var pgError *pgconn.PgError
-	if err != nil && errors.As(err, &pgError) {
-		if pgError.Code == "23505" { // Unique violation error code
-			return nil
+	if err := result.Error; err != nil {
+		if errors.As(err, &pgError) && pgError.Code == "23505" { // Unique violation error code
+			return false, nil
 		}
+		return false, err
 	}
 
-	return err
+	return result.RowsAffected > 0, nil
+}
+
+// DeleteRevertedSeriesRegistryEvents removes the events of the log which is reverted by a given
+// reverted event, so that a queued one is not applied and the same log can be indexed again
+// once it is included in the canonical chain.
+func (s *PostgresEventStore) DeleteRevertedSeriesRegistryEvents(event SeriesRegistryEvent) error {
+	return s.db.Where(`"type" <> ? AND "contract" = ? AND "tx_id" = ? AND "event_index" = ?`,
+		SeriesRegistryEventTypeReverted, event.Contract, event.TxID, event.EventIndex).
+		Delete(&SeriesRegistryEvent{}).Error
 }
 
 // GetSeriesRegistryEventTransaction returns an SeriesRegistryEventTx
@@ -341,9 +591,54 @@ func (s *PostgresEventStore) GetSeriesRegistryEventTransaction(ctx context.Conte
 	return NewSeriesRegistryEventTx(tx, event), nil
 }
 
-// DeleteSeriesRegistryEvents deletes series registry events older than the given duration
+// DeleteSeriesRegistryEvents deletes series registry events older than the given duration.
+// Dead-lettered events are kept until they are replayed.
 func (s *PostgresEventStore) DeleteSeriesRegistryEvents(duration time.Duration) error {
-	return s.db.Where("created_at < ?", time.Now().Add(-duration)).Delete(&SeriesRegistryEvent{}).Error
+	return s.db.Where("created_at < ? AND status <> ?", time.Now().Add(-duration), SeriesRegistryEventStatusDeadLetter).
+		Delete(&SeriesRegistryEvent{}).Error
+}
+
+// GetSeriesRegistryEvent returns a series registry event by id
+func (s *PostgresEventStore) GetSeriesRegistryEvent(ctx context.Context, id string) (*SeriesRegistryEvent, error) {
+	var event SeriesRegistryEvent
+	if err := s.db.WithContext(ctx).Where("id = ?", id).First(&event).Error; err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// GetSeriesRegistryEventsByStatus returns series registry events of a status ordered by the latest update
+func (s *PostgresEventStore) GetSeriesRegistryEventsByStatus(ctx context.Context, status SeriesRegistryEventStatus, pagination Pagination) ([]SeriesRegistryEvent, error) {
+	var events []SeriesRegistryEvent
+	err := pagination.Apply(s.db.WithContext(ctx)).
+		Where("status = ?", status).
+		Order("updated_at desc").
+		Find(&events).Error
+
+	return events, err
+}
+
+// RequeueSeriesRegistryEvent moves a dead-lettered series registry event back to the queue
+// with a fresh retry budget
+func (s *PostgresEventStore) RequeueSeriesRegistryEvent(ctx context.Context, id string) error {
+	r := s.db.WithContext(ctx).Model(&SeriesRegistryEvent{}).
+		Where("id = ? AND status = ?", id, SeriesRegistryEventStatusDeadLetter).
+		Updates(map[string]interface{}{
+			"status":          SeriesRegistryEventStatusCreated,
+			"attempts":        0,
+			"next_attempt_at": time.Now(),
+			"updated_at":      time.Now(),
+		})
+	if r.Error != nil {
+		return r.Error
+	}
+
+	if r.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
 }
 
 // AutoMigrate is a help function that update db when the schema changed.
@@ -351,6 +646,12 @@ func (s *PostgresEventStore) AutoMigrate() error {
 	if err := s.db.AutoMigrate(&ArchivedNFTEvent{}); err != nil {
 		return err
 	}
+	// the unique index of the nft events is replaced by idx_nft_event which includes the batch index
+	if s.db.Migrator().HasIndex(&NFTEvent{}, "idx_event") {
+		if err := s.db.Migrator().DropIndex(&NFTEvent{}, "idx_event"); err != nil {
+			return err
+		}
+	}
 	if err := s.db.AutoMigrate(&NFTEvent{}); err != nil {
 		return err
 	}
@@ -359,3 +660,25 @@ func (s *PostgresEventStore) AutoMigrate() error {
 	}
 	return nil
 }
+
+// GetNftEventBacklog returns the number of queued nft events by stage and status
+func (s *PostgresEventStore) GetNftEventBacklog(ctx context.Context) ([]EventBacklog, error) {
+	var backlog []EventBacklog
+	err := s.db.WithContext(ctx).Model(&NFTEvent{}).
+		Select("stage, status, count(*) AS count, min(created_at) AS oldest_created_at").
+		Group("stage, status").
+		Scan(&backlog).Error
+
+	return backlog, err
+}
+
+// GetSeriesRegistryEventBacklog returns the number of queued series registry events by stage and status
+func (s *PostgresEventStore) GetSeriesRegistryEventBacklog(ctx context.Context) ([]EventBacklog, error) {
+	var backlog []EventBacklog
+	err := s.db.WithContext(ctx).Model(&SeriesRegistryEvent{}).
+		Select("stage, status, count(*) AS count, min(created_at) AS oldest_created_at").
+		Group("stage, status").
+		Scan(&backlog).Error
+
+	return backlog, err
+}
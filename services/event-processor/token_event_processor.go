@@ -3,11 +3,14 @@ package main
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math/big"
 	"time"
 
 	log "github.com/bitmark-inc/autonomy-logger"
 	utils "github.com/bitmark-inc/autonomy-utils"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	indexer "github.com/feral-file/ff-indexer"
@@ -24,7 +27,7 @@ func (e *EventProcessor) updateLatestOwner(ctx context.Context, event NFTEvent)
 	indexID := indexer.TokenIndexID(blockchain, contract, tokenID)
 
 	switch event.Type {
-	case string(NftEventTypeTransfer):
+	case string(NftEventTypeTransfer), string(NftEventTypeMint), string(NftEventTypeBurned):
 		token, err := e.grpcGateway.GetTokenByIndexID(ctx, indexID)
 		if err != nil {
 			if grpcError, ok := status.FromError(err); !ok || grpcError.Message() != "token does not exist" {
@@ -33,51 +36,51 @@ func (e *EventProcessor) updateLatestOwner(ctx context.Context, event NFTEvent)
 			}
 		}
 
-		if token != nil {
-			if !token.Fungible {
-				err := e.grpcGateway.PushProvenance(ctx, indexID, token.LastRefreshedTime, indexer.Provenance{
-					Type:        eventType,
-					FormerOwner: &event.From,
-					Owner:       to,
-					Blockchain:  blockchain,
-					Timestamp:   event.TXTime,
-					TxID:        event.TXID,
-					TxURL:       indexer.TxURL(event.Blockchain, e.environment, event.TXID),
-				})
-
-				if err != nil {
-					log.ErrorWithContext(ctx, errors.New("fail to push provenance"), zap.Error(err))
-
-					err = e.grpcGateway.UpdateOwner(ctx, indexID, to, event.CreatedAt)
-					if err != nil {
-						log.ErrorWithContext(ctx, errors.New("fail to update owner"), zap.Error(err))
-						return err
-					}
-				}
-
-				accountToken := indexer.AccountToken{
-					BaseTokenInfo:     token.BaseTokenInfo,
-					IndexID:           indexID,
-					OwnerAccount:      to,
-					Balance:           int64(1),
-					LastActivityTime:  event.CreatedAt,
-					LastRefreshedTime: time.Now(),
-				}
-
-				if err := e.grpcGateway.IndexAccountTokens(ctx, to, []indexer.AccountToken{accountToken}); err != nil {
-					log.ErrorWithContext(ctx, errors.New("fail to index account token"), zap.Error(err))
-					return err
-				}
-			} else {
-				// err := e.indexerGRPC.UpdateOwnerForFungibleToken(ctx, indexID, token.LastRefreshedTime, event.To, 1)
-				// if err != nil {
-				// 	log.Error("fail to update owner for fungible token", zap.Error(err))
-				// 	return err
-				// }
-				log.Debug("ignore instant updates for fungible tokens", zap.String("indexID", indexID))
-			}
-		} else {
+		if token == nil {
 			log.Debug("token not found", zap.String("indexID", indexID))
+			return nil
+		}
+
+		if token.Fungible {
+			return e.updateFungibleTokenBalances(ctx, token, event)
+		}
+
+		if event.Type != string(NftEventTypeTransfer) {
+			return nil
+		}
+
+		err = e.grpcGateway.PushProvenance(ctx, indexID, token.LastRefreshedTime, indexer.Provenance{
+			Type:        eventType,
+			FormerOwner: &event.From,
+			Owner:       to,
+			Blockchain:  blockchain,
+			Timestamp:   event.TXTime,
+			TxID:        event.TXID,
+			TxURL:       indexer.TxURL(event.Blockchain, e.environment, event.TXID),
+		})
+
+		if err != nil {
+			log.ErrorWithContext(ctx, errors.New("fail to push provenance"), zap.Error(err))
+
+			err = e.grpcGateway.UpdateOwner(ctx, indexID, to, event.CreatedAt)
+			if err != nil {
+				log.ErrorWithContext(ctx, errors.New("fail to update owner"), zap.Error(err))
+				return err
+			}
+		}
+
+		accountToken := indexer.AccountToken{
+			BaseTokenInfo:     token.BaseTokenInfo,
+			IndexID:           indexID,
+			OwnerAccount:      to,
+			Balance:           int64(1),
+			LastActivityTime:  event.CreatedAt,
+			LastRefreshedTime: time.Now(),
+		}
+
+		if err := e.grpcGateway.IndexAccountTokens(ctx, to, []indexer.AccountToken{accountToken}); err != nil {
+			log.ErrorWithContext(ctx, errors.New("fail to index account token"), zap.Error(err))
+			return err
 		}
 	default:
 		// do nothing here.
@@ -86,6 +89,70 @@ func (e *EventProcessor) updateLatestOwner(ctx context.Context, event NFTEvent)
 	return nil
 }
 
+// updateFungibleTokenBalances applies the transferred amount of a fungible token as
+// balance deltas of the sender and the receiver. The zero address is never credited or debited.
+// The deltas of an event are applied once, so the event can be processed again safely. A full
+// ownership refreshing is triggered only when the balances drift or the amount exceeds the
+// balances which are kept as int64. Other errors are returned, so the event is retried.
+func (e *EventProcessor) updateFungibleTokenBalances(ctx context.Context, token *indexer.Token, event NFTEvent) error {
+	amount, err := eventAmount(event)
+	if err != nil {
+		log.ErrorWithContext(ctx, errors.New("invalid event amount"), zap.String("amount", event.Amount), zap.Error(err))
+		return err
+	}
+
+	if !amount.IsInt64() {
+		log.WarnWithContext(ctx, "event amount exceeds the token balances, refresh the token ownership",
+			zap.String("indexID", token.IndexID), zap.String("amount", event.Amount))
+		indexerWorker.StartRefreshTokenOwnershipWorkflow(ctx, e.worker, "processor", token.IndexID, 0)
+		return nil
+	}
+
+	deltas := fungibleBalanceDeltas(event.From, event.To, amount.Int64())
+	eventID := indexer.FungibleBalanceEventID(token.IndexID, event.TXID, event.EventIndex, event.BatchIndex)
+	if err := e.grpcGateway.UpdateFungibleTokenBalances(ctx, token.IndexID, eventID, deltas, event.TXTime); err != nil {
+		if status.Code(err) != codes.FailedPrecondition {
+			log.ErrorWithContext(ctx, errors.New("fail to update balances for fungible token"),
+				zap.String("indexID", token.IndexID), zap.Error(err))
+			return err
+		}
+
+		log.WarnWithContext(ctx, "token balances drifted, refresh the token ownership",
+			zap.String("indexID", token.IndexID), zap.String("txID", event.TXID))
+		indexerWorker.StartRefreshTokenOwnershipWorkflow(ctx, e.worker, "processor", token.IndexID, 0)
+	}
+
+	return nil
+}
+
+// fungibleBalanceDeltas returns the balance deltas of a transfer of an amount
+func fungibleBalanceDeltas(from, to string, amount int64) map[string]int64 {
+	deltas := map[string]int64{}
+	if to != "" && to != indexer.EthereumZeroAddress {
+		deltas[to] += amount
+	}
+	if from != "" && from != indexer.EthereumZeroAddress {
+		deltas[from] -= amount
+	}
+
+	return deltas
+}
+
+// eventAmount returns the transferred amount of an event. Events without amount move a single token.
+// The amount of an ERC-1155 transfer is an uint256, so it is not limited to int64.
+func eventAmount(event NFTEvent) (*big.Int, error) {
+	if event.Amount == "" {
+		return big.NewInt(1), nil
+	}
+
+	amount, ok := new(big.Int).SetString(event.Amount, 10)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("invalid amount: %s", event.Amount)
+	}
+
+	return amount, nil
+}
+
 // UpdateLatestOwner is a stage 1 worker.
 func (e *EventProcessor) UpdateLatestOwner(ctx context.Context) {
 	e.StartNftEventWorker(ctx,
@@ -115,9 +182,8 @@ func (e *EventProcessor) updateOwnerAndProvenance(ctx context.Context, event NFT
 	if token != nil {
 		log.Debug("An indexed token found for a corresponded event. Start refreshing the token ownership and provenance", zap.String("indexID", indexID))
 
-		if token.Fungible {
-			indexerWorker.StartRefreshTokenOwnershipWorkflow(ctx, e.worker, "processor", indexID, 0)
-		} else {
+		// the balances of fungible tokens are kept by the balance deltas in the latest owner stage
+		if !token.Fungible {
 			if err := e.grpcGateway.UpdateOwner(ctx, indexID, to, event.CreatedAt); err != nil {
 				log.ErrorWithContext(ctx, errors.New("fail to update the token ownership"),
 					zap.String("indexID", indexID), zap.Error(err),
@@ -125,6 +191,10 @@ func (e *EventProcessor) updateOwnerAndProvenance(ctx context.Context, event NFT
 			}
 			indexerWorker.StartRefreshTokenProvenanceWorkflow(ctx, e.worker, "processor", indexID, 0)
 		}
+
+		if err := e.publishOwnershipChange(ctx, event); err != nil {
+			return err
+		}
 	} else {
 		log.InfoWithContext(ctx, "token has not been indexed yet, skipped.", zap.String("indexID", indexID))
 		// Do nothing here.
@@ -150,6 +220,117 @@ func (e *EventProcessor) UpdateOwnerAndProvenanceForBurnedToken(ctx context.Cont
 	)
 }
 
+// revertOwnerAndProvenance undoes the ownership change of a transfer which was
+// emitted from a block that is no longer canonical.
+func (e *EventProcessor) revertOwnerAndProvenance(ctx context.Context, event NFTEvent) error {
+	if err := e.eventQueue.Store().DeleteRevertedNftEvents(event); err != nil {
+		log.ErrorWithContext(ctx, errors.New("fail to delete reverted events"), zap.Error(err))
+		return err
+	}
+
+	indexID := indexer.TokenIndexID(event.Blockchain, event.Contract, event.TokenID)
+	token, err := e.grpcGateway.GetTokenByIndexID(ctx, indexID)
+	if err != nil {
+		if grpcError, ok := status.FromError(err); !ok || grpcError.Message() != "token does not exist" {
+			log.ErrorWithContext(ctx, errors.New("fail to query token from indexer"), zap.Error(err))
+			return err
+		}
+	}
+
+	if token == nil {
+		log.InfoWithContext(ctx, "token has not been indexed yet, skipped.", zap.String("indexID", indexID))
+		return nil
+	}
+
+	log.InfoWithContext(ctx, "revert the token ownership of an orphaned transfer",
+		zap.String("indexID", indexID), zap.String("txID", event.TXID),
+		zap.String("from", event.From), zap.String("to", event.To))
+
+	if token.Fungible {
+		if err := e.revertFungibleTokenBalances(ctx, token, event); err != nil {
+			return err
+		}
+		return e.publishOwnershipChange(ctx, event)
+	}
+
+	// give the token back to the former owner immediately and let the provenance
+	// refreshing rebuild the token history from the canonical chain.
+	if token.Owner == event.To && event.From != indexer.EthereumZeroAddress && event.From != "" {
+		now := time.Now()
+		if err := e.grpcGateway.UpdateOwner(ctx, indexID, event.From, now); err != nil {
+			log.ErrorWithContext(ctx, errors.New("fail to revert the token ownership"),
+				zap.String("indexID", indexID), zap.Error(err))
+		}
+
+		for owner, balance := range map[string]int64{event.To: 0, event.From: 1} {
+			accountToken := indexer.AccountToken{
+				BaseTokenInfo:     token.BaseTokenInfo,
+				IndexID:           indexID,
+				OwnerAccount:      owner,
+				Balance:           balance,
+				LastActivityTime:  now,
+				LastRefreshedTime: now,
+			}
+
+			if err := e.grpcGateway.IndexAccountTokens(ctx, owner, []indexer.AccountToken{accountToken}); err != nil {
+				log.ErrorWithContext(ctx, errors.New("fail to revert account token"), zap.String("owner", owner), zap.Error(err))
+				return err
+			}
+		}
+	}
+
+	indexerWorker.StartRefreshTokenProvenanceWorkflow(ctx, e.worker, "processor", indexID, 0)
+
+	return e.publishOwnershipChange(ctx, event)
+}
+
+// revertFungibleTokenBalances moves the amount of an orphaned transfer back from the receiver to
+// the sender. Only the deltas which were applied by the transfer are reversed, so a transfer which
+// never reached the latest owner stage changes no balances. A full ownership refreshing is
+// triggered when the balances drift.
+func (e *EventProcessor) revertFungibleTokenBalances(ctx context.Context, token *indexer.Token, event NFTEvent) error {
+	eventID := indexer.FungibleBalanceEventID(token.IndexID, event.TXID, event.EventIndex, event.BatchIndex)
+	if err := e.grpcGateway.RevertFungibleTokenBalances(ctx, token.IndexID, eventID, time.Now()); err != nil {
+		if status.Code(err) != codes.FailedPrecondition {
+			log.ErrorWithContext(ctx, errors.New("fail to revert balances for fungible token"),
+				zap.String("indexID", token.IndexID), zap.Error(err))
+			return err
+		}
+
+		log.WarnWithContext(ctx, "token balances drifted, refresh the token ownership",
+			zap.String("indexID", token.IndexID), zap.String("txID", event.TXID))
+		indexerWorker.StartRefreshTokenOwnershipWorkflow(ctx, e.worker, "processor", token.IndexID, 0)
+	}
+
+	return nil
+}
+
+// publishOwnershipChange notifies the subscribers of the ownership change of an event
+func (e *EventProcessor) publishOwnershipChange(ctx context.Context, event NFTEvent) error {
+	err := e.grpcGateway.PushOwnershipChange(ctx, indexer.OwnershipChange{
+		IndexID:   indexer.TokenIndexID(event.Blockchain, event.Contract, event.TokenID),
+		Type:      event.Type,
+		From:      event.From,
+		To:        event.To,
+		TxID:      event.TXID,
+		Timestamp: event.TXTime,
+	})
+	if err != nil {
+		log.ErrorWithContext(ctx, errors.New("fail to publish the ownership change"), zap.String("eventID", event.ID), zap.Error(err))
+	}
+
+	return err
+}
+
+// RevertOwnerAndProvenance is a stage 1 worker for reverted events.
+func (e *EventProcessor) RevertOwnerAndProvenance(ctx context.Context) {
+	e.StartNftEventWorker(ctx,
+		NftEventStageInit, NftEventStageDone,
+		[]NftEventType{NftEventTypeReverted},
+		0, 0, e.revertOwnerAndProvenance,
+	)
+}
+
 func (e *EventProcessor) IndexTokenSale(ctx context.Context) {
 	e.StartNftEventWorker(
 		ctx,
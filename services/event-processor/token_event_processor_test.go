@@ -0,0 +1,32 @@
+package main
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	indexer "github.com/feral-file/ff-indexer"
+)
+
+func TestEventAmount(t *testing.T) {
+	amount, err := eventAmount(NFTEvent{})
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(1), amount)
+
+	// the amount of an ERC-1155 transfer exceeds int64
+	amount, err = eventAmount(NFTEvent{Amount: "115792089237316195423570985008687907853269984665640564039457584007913129639935"})
+	assert.NoError(t, err)
+	assert.False(t, amount.IsInt64())
+
+	_, err = eventAmount(NFTEvent{Amount: "-1"})
+	assert.Error(t, err)
+	_, err = eventAmount(NFTEvent{Amount: "0x1"})
+	assert.Error(t, err)
+}
+
+func TestFungibleBalanceDeltas(t *testing.T) {
+	assert.Equal(t, map[string]int64{"0xa": -2, "0xb": 2}, fungibleBalanceDeltas("0xa", "0xb", 2))
+	assert.Equal(t, map[string]int64{"0xb": 2}, fungibleBalanceDeltas(indexer.EthereumZeroAddress, "0xb", 2))
+	assert.Equal(t, map[string]int64{"0xa": -2}, fungibleBalanceDeltas("0xa", indexer.EthereumZeroAddress, 2))
+}
@@ -24,6 +24,8 @@ const (
 	Grpc_PushProvenance_FullMethodName                 = "/grpc.Grpc/PushProvenance"
 	Grpc_UpdateOwner_FullMethodName                    = "/grpc.Grpc/UpdateOwner"
 	Grpc_UpdateOwnerForFungibleToken_FullMethodName    = "/grpc.Grpc/UpdateOwnerForFungibleToken"
+	Grpc_UpdateFungibleTokenBalances_FullMethodName    = "/grpc.Grpc/UpdateFungibleTokenBalances"
+	Grpc_RevertFungibleTokenBalances_FullMethodName    = "/grpc.Grpc/RevertFungibleTokenBalances"
 	Grpc_IndexAccountTokens_FullMethodName             = "/grpc.Grpc/IndexAccountTokens"
 	Grpc_GetDetailedToken_FullMethodName               = "/grpc.Grpc/GetDetailedToken"
 	Grpc_GetTotalBalanceOfOwnerAccounts_FullMethodName = "/grpc.Grpc/GetTotalBalanceOfOwnerAccounts"
@@ -38,6 +40,8 @@ const (
 	Grpc_GetHistoricalExchangeRate_FullMethodName      = "/grpc.Grpc/GetHistoricalExchangeRate"
 	Grpc_UpdateAssetsConfiguration_FullMethodName      = "/grpc.Grpc/UpdateAssetsConfiguration"
 	Grpc_CheckAssetCreator_FullMethodName              = "/grpc.Grpc/CheckAssetCreator"
+	Grpc_PushOwnershipChange_FullMethodName            = "/grpc.Grpc/PushOwnershipChange"
+	Grpc_SubscribeOwnershipChanges_FullMethodName      = "/grpc.Grpc/SubscribeOwnershipChanges"
 )
 
 // GrpcClient is the client API for Grpc service.
@@ -48,6 +52,8 @@ type GrpcClient interface {
 	PushProvenance(ctx context.Context, in *PushProvenanceRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
 	UpdateOwner(ctx context.Context, in *UpdateOwnerRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
 	UpdateOwnerForFungibleToken(ctx context.Context, in *UpdateOwnerForFungibleTokenRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	UpdateFungibleTokenBalances(ctx context.Context, in *UpdateFungibleTokenBalancesRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
+	RevertFungibleTokenBalances(ctx context.Context, in *RevertFungibleTokenBalancesRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
 	IndexAccountTokens(ctx context.Context, in *IndexAccountTokensRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
 	GetDetailedToken(ctx context.Context, in *GetDetailedTokenRequest, opts ...grpc.CallOption) (*DetailedToken, error)
 	GetTotalBalanceOfOwnerAccounts(ctx context.Context, in *Addresses, opts ...grpc.CallOption) (*TotalBalance, error)
@@ -62,6 +68,8 @@ type GrpcClient interface {
 	GetHistoricalExchangeRate(ctx context.Context, in *HistoricalExchangeRateFilter, opts ...grpc.CallOption) (*ExchangeRateResponse, error)
 	UpdateAssetsConfiguration(ctx context.Context, in *UpdateAssetsConfigurationRequest, opts ...grpc.CallOption) (*EmptyMessage, error)
 	CheckAssetCreator(ctx context.Context, in *CheckAssetCreatorRequest, opts ...grpc.CallOption) (*CheckAssetCreatorResponse, error)
+	PushOwnershipChange(ctx context.Context, in *OwnershipChange, opts ...grpc.CallOption) (*EmptyMessage, error)
+	SubscribeOwnershipChanges(ctx context.Context, in *SubscribeOwnershipChangesRequest, opts ...grpc.CallOption) (Grpc_SubscribeOwnershipChangesClient, error)
 }
 
 type grpcClient struct {
@@ -108,6 +116,24 @@ func (c *grpcClient) UpdateOwnerForFungibleToken(ctx context.Context, in *Update
 	return out, nil
 }
 
+func (c *grpcClient) UpdateFungibleTokenBalances(ctx context.Context, in *UpdateFungibleTokenBalancesRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, Grpc_UpdateFungibleTokenBalances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcClient) RevertFungibleTokenBalances(ctx context.Context, in *RevertFungibleTokenBalancesRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, Grpc_RevertFungibleTokenBalances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *grpcClient) IndexAccountTokens(ctx context.Context, in *IndexAccountTokensRequest, opts ...grpc.CallOption) (*EmptyMessage, error) {
 	out := new(EmptyMessage)
 	err := c.cc.Invoke(ctx, Grpc_IndexAccountTokens_FullMethodName, in, out, opts...)
@@ -234,6 +260,47 @@ func (c *grpcClient) CheckAssetCreator(ctx context.Context, in *CheckAssetCreato
 	return out, nil
 }
 
+func (c *grpcClient) PushOwnershipChange(ctx context.Context, in *OwnershipChange, opts ...grpc.CallOption) (*EmptyMessage, error) {
+	out := new(EmptyMessage)
+	err := c.cc.Invoke(ctx, Grpc_PushOwnershipChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *grpcClient) SubscribeOwnershipChanges(ctx context.Context, in *SubscribeOwnershipChangesRequest, opts ...grpc.CallOption) (Grpc_SubscribeOwnershipChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Grpc_ServiceDesc.Streams[0], Grpc_SubscribeOwnershipChanges_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpcSubscribeOwnershipChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Grpc_SubscribeOwnershipChangesClient interface {
+	Recv() (*OwnershipChange, error)
+	grpc.ClientStream
+}
+
+type grpcSubscribeOwnershipChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *grpcSubscribeOwnershipChangesClient) Recv() (*OwnershipChange, error) {
+	m := new(OwnershipChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // GrpcServer is the server API for Grpc service.
 // All implementations must embed UnimplementedGrpcServer
 // for forward compatibility
@@ -242,6 +309,8 @@ type GrpcServer interface {
 	PushProvenance(context.Context, *PushProvenanceRequest) (*EmptyMessage, error)
 	UpdateOwner(context.Context, *UpdateOwnerRequest) (*EmptyMessage, error)
 	UpdateOwnerForFungibleToken(context.Context, *UpdateOwnerForFungibleTokenRequest) (*EmptyMessage, error)
+	UpdateFungibleTokenBalances(context.Context, *UpdateFungibleTokenBalancesRequest) (*EmptyMessage, error)
+	RevertFungibleTokenBalances(context.Context, *RevertFungibleTokenBalancesRequest) (*EmptyMessage, error)
 	IndexAccountTokens(context.Context, *IndexAccountTokensRequest) (*EmptyMessage, error)
 	GetDetailedToken(context.Context, *GetDetailedTokenRequest) (*DetailedToken, error)
 	GetTotalBalanceOfOwnerAccounts(context.Context, *Addresses) (*TotalBalance, error)
@@ -256,6 +325,8 @@ type GrpcServer interface {
 	GetHistoricalExchangeRate(context.Context, *HistoricalExchangeRateFilter) (*ExchangeRateResponse, error)
 	UpdateAssetsConfiguration(context.Context, *UpdateAssetsConfigurationRequest) (*EmptyMessage, error)
 	CheckAssetCreator(context.Context, *CheckAssetCreatorRequest) (*CheckAssetCreatorResponse, error)
+	PushOwnershipChange(context.Context, *OwnershipChange) (*EmptyMessage, error)
+	SubscribeOwnershipChanges(*SubscribeOwnershipChangesRequest, Grpc_SubscribeOwnershipChangesServer) error
 	mustEmbedUnimplementedGrpcServer()
 }
 
@@ -275,6 +346,12 @@ func (UnimplementedGrpcServer) UpdateOwner(context.Context, *UpdateOwnerRequest)
 func (UnimplementedGrpcServer) UpdateOwnerForFungibleToken(context.Context, *UpdateOwnerForFungibleTokenRequest) (*EmptyMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOwnerForFungibleToken not implemented")
 }
+func (UnimplementedGrpcServer) UpdateFungibleTokenBalances(context.Context, *UpdateFungibleTokenBalancesRequest) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFungibleTokenBalances not implemented")
+}
+func (UnimplementedGrpcServer) RevertFungibleTokenBalances(context.Context, *RevertFungibleTokenBalancesRequest) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertFungibleTokenBalances not implemented")
+}
 func (UnimplementedGrpcServer) IndexAccountTokens(context.Context, *IndexAccountTokensRequest) (*EmptyMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IndexAccountTokens not implemented")
 }
@@ -317,6 +394,12 @@ func (UnimplementedGrpcServer) UpdateAssetsConfiguration(context.Context, *Updat
 func (UnimplementedGrpcServer) CheckAssetCreator(context.Context, *CheckAssetCreatorRequest) (*CheckAssetCreatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckAssetCreator not implemented")
 }
+func (UnimplementedGrpcServer) PushOwnershipChange(context.Context, *OwnershipChange) (*EmptyMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushOwnershipChange not implemented")
+}
+func (UnimplementedGrpcServer) SubscribeOwnershipChanges(*SubscribeOwnershipChangesRequest, Grpc_SubscribeOwnershipChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeOwnershipChanges not implemented")
+}
 func (UnimplementedGrpcServer) mustEmbedUnimplementedGrpcServer() {}
 
 // UnsafeGrpcServer may be embedded to opt out of forward compatibility for this service.
@@ -402,6 +485,42 @@ func _Grpc_UpdateOwnerForFungibleToken_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Grpc_UpdateFungibleTokenBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFungibleTokenBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcServer).UpdateFungibleTokenBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Grpc_UpdateFungibleTokenBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcServer).UpdateFungibleTokenBalances(ctx, req.(*UpdateFungibleTokenBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Grpc_RevertFungibleTokenBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertFungibleTokenBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcServer).RevertFungibleTokenBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Grpc_RevertFungibleTokenBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcServer).RevertFungibleTokenBalances(ctx, req.(*RevertFungibleTokenBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Grpc_IndexAccountTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IndexAccountTokensRequest)
 	if err := dec(in); err != nil {
@@ -654,6 +773,45 @@ func _Grpc_CheckAssetCreator_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Grpc_PushOwnershipChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OwnershipChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcServer).PushOwnershipChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Grpc_PushOwnershipChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcServer).PushOwnershipChange(ctx, req.(*OwnershipChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Grpc_SubscribeOwnershipChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeOwnershipChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GrpcServer).SubscribeOwnershipChanges(m, &grpcSubscribeOwnershipChangesServer{stream})
+}
+
+type Grpc_SubscribeOwnershipChangesServer interface {
+	Send(*OwnershipChange) error
+	grpc.ServerStream
+}
+
+type grpcSubscribeOwnershipChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *grpcSubscribeOwnershipChangesServer) Send(m *OwnershipChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Grpc_ServiceDesc is the grpc.ServiceDesc for Grpc service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -677,6 +835,14 @@ var Grpc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOwnerForFungibleToken",
 			Handler:    _Grpc_UpdateOwnerForFungibleToken_Handler,
 		},
+		{
+			MethodName: "UpdateFungibleTokenBalances",
+			Handler:    _Grpc_UpdateFungibleTokenBalances_Handler,
+		},
+		{
+			MethodName: "RevertFungibleTokenBalances",
+			Handler:    _Grpc_RevertFungibleTokenBalances_Handler,
+		},
 		{
 			MethodName: "IndexAccountTokens",
 			Handler:    _Grpc_IndexAccountTokens_Handler,
@@ -733,7 +899,17 @@ var Grpc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckAssetCreator",
 			Handler:    _Grpc_CheckAssetCreator_Handler,
 		},
+		{
+			MethodName: "PushOwnershipChange",
+			Handler:    _Grpc_PushOwnershipChange_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeOwnershipChanges",
+			Handler:       _Grpc_SubscribeOwnershipChanges_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "gateway.proto",
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"time"
@@ -9,6 +10,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	indexer "github.com/feral-file/ff-indexer"
 	"github.com/feral-file/ff-indexer/cache"
@@ -144,6 +147,44 @@ func (i *Server) UpdateOwnerForFungibleToken(ctx context.Context, in *pb.UpdateO
 	return &pb.EmptyMessage{}, nil
 }
 
+// UpdateFungibleTokenBalances applies the balance deltas of an event to the owners of a fungible
+// token. A drifted balance is returned as a FailedPrecondition status.
+func (i *Server) UpdateFungibleTokenBalances(ctx context.Context, in *pb.UpdateFungibleTokenBalancesRequest) (*pb.EmptyMessage, error) {
+	activityTime, err := sdk.ParseTime(in.ActivityTime)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.indexerStore.UpdateFungibleTokenBalances(ctx, in.IndexID, in.EventID, in.Deltas, activityTime)
+	if err != nil {
+		if errors.Is(err, indexer.ErrBalanceDrifted) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+
+	return &pb.EmptyMessage{}, nil
+}
+
+// RevertFungibleTokenBalances reverses the balance deltas of an event which were applied to a
+// fungible token. A drifted balance is returned as a FailedPrecondition status.
+func (i *Server) RevertFungibleTokenBalances(ctx context.Context, in *pb.RevertFungibleTokenBalancesRequest) (*pb.EmptyMessage, error) {
+	activityTime, err := sdk.ParseTime(in.ActivityTime)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.indexerStore.RevertFungibleTokenBalances(ctx, in.IndexID, in.EventID, activityTime)
+	if err != nil {
+		if errors.Is(err, indexer.ErrBalanceDrifted) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+
+	return &pb.EmptyMessage{}, nil
+}
+
 // IndexAccountTokens indexes the Account tokens of an account
 func (i *Server) IndexAccountTokens(ctx context.Context, in *pb.IndexAccountTokensRequest) (*pb.EmptyMessage, error) {
 	accountTokens, err := i.mapper.MapGRPCAccountTokensToIndexerAccountTokens(in.AccountTokens)
@@ -335,3 +376,25 @@ func (i *Server) CheckAssetCreator(ctx context.Context, request *pb.CheckAssetCr
 	}
 	return &pb.CheckAssetCreatorResponse{Result: result}, nil
 }
+
+// PushOwnershipChange adds an ownership change for the subscribers
+func (i *Server) PushOwnershipChange(ctx context.Context, in *pb.OwnershipChange) (*pb.EmptyMessage, error) {
+	if err := i.indexerStore.AddOwnershipChange(ctx, i.mapper.MapGrpcOwnershipChangeToIndexerOwnershipChange(in)); err != nil {
+		return nil, err
+	}
+
+	return &pb.EmptyMessage{}, nil
+}
+
+// SubscribeOwnershipChanges streams the ownership changes of the owners or the tokens. A client
+// reconnects with the resume token of the last received change to continue from it.
+func (i *Server) SubscribeOwnershipChanges(in *pb.SubscribeOwnershipChangesRequest, stream pb.Grpc_SubscribeOwnershipChangesServer) error {
+	if len(in.Owners) == 0 && len(in.IndexIDs) == 0 {
+		return fmt.Errorf("either owners or indexIDs is required")
+	}
+
+	return i.indexerStore.WatchOwnershipChanges(stream.Context(), in.Owners, in.IndexIDs, in.ResumeToken,
+		func(change indexer.OwnershipChange) error {
+			return stream.Send(i.mapper.MapIndexerOwnershipChangeToGrpcOwnershipChange(change))
+		})
+}
@@ -2,9 +2,14 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/chromedp/cdproto/page"
 	"github.com/chromedp/chromedp"
+
+	indexer "github.com/feral-file/ff-indexer"
 )
 
 const CropImageTimeout = 5 * time.Second
@@ -38,3 +43,152 @@ func ScreenShoot(url string, selector string) ([]byte, error) {
 
 	return buf, nil
 }
+
+const (
+	CaptureSignalFxhash     = "fxhash"
+	CaptureSignalExpression = "expression"
+	CaptureSignalNone       = "none"
+
+	// SnapshotLoadTimeout is the time for loading an artwork before waiting for its capture signal
+	SnapshotLoadTimeout = 30 * time.Second
+
+	// captureReadyExpression is set by the hook of the fxhash capture signal
+	captureReadyExpression = "window.__ffCaptureReady === true"
+)
+
+// fxhashPreviewHook wraps `$fx.preview()` and the legacy `fxpreview()` of the fxhash snippet
+// to set a flag once an artwork is ready to capture
+const fxhashPreviewHook = `(() => {
+  window.__ffCaptureReady = false;
+  const hook = (preview) => function () {
+    window.__ffCaptureReady = true;
+    return preview.apply(this, arguments);
+  };
+
+  let fx;
+  Object.defineProperty(window, '$fx', {
+    configurable: true,
+    get: () => fx,
+    set: (v) => {
+      if (v && typeof v.preview === 'function') {
+        v.preview = hook(v.preview);
+      }
+      fx = v;
+    },
+  });
+
+  let fxpreview;
+  Object.defineProperty(window, 'fxpreview', {
+    configurable: true,
+    get: () => fxpreview,
+    set: (v) => {
+      fxpreview = typeof v === 'function' ? hook(v) : v;
+    },
+  });
+})();`
+
+// SnapshotViewport is the viewport size of an artwork snapshot
+type SnapshotViewport struct {
+	Name   string
+	Width  int64
+	Height int64
+}
+
+var (
+	SnapshotViewportLandscape = SnapshotViewport{Name: "landscape", Width: 1920, Height: 1080}
+	SnapshotViewportPortrait  = SnapshotViewport{Name: "portrait", Width: 1080, Height: 1920}
+)
+
+// CaptureSignal is how an artwork tells that it is ready to capture
+type CaptureSignal struct {
+	// Script is evaluated before an artwork is loaded to hook the signal
+	Script string
+	// ReadyExpression is a javascript expression which is true once the artwork is ready to capture
+	ReadyExpression string
+	// Timeout is the max time for waiting the signal
+	Timeout time.Duration
+	// Required tells whether a snapshot fails if the signal is not sent in time. Otherwise,
+	// the snapshot is captured once the timeout is reached.
+	Required bool
+}
+
+// NewCaptureSignal returns the capture signal of a type. The expression is only used by the expression type.
+func NewCaptureSignal(signalType, readyExpression string, timeout time.Duration, required bool) (CaptureSignal, error) {
+	signal := CaptureSignal{
+		Timeout:  timeout,
+		Required: required,
+	}
+
+	switch signalType {
+	case "", CaptureSignalFxhash:
+		signal.Script = fxhashPreviewHook
+		signal.ReadyExpression = captureReadyExpression
+	case CaptureSignalExpression:
+		if readyExpression == "" {
+			return signal, fmt.Errorf("ready expression is required for the expression capture signal")
+		}
+		signal.ReadyExpression = readyExpression
+	case CaptureSignalNone:
+		// the snapshot is captured after waiting for the timeout
+	default:
+		return signal, fmt.Errorf("unsupported capture signal: %s", signalType)
+	}
+
+	return signal, nil
+}
+
+// RenderSnapshot loads an artwork in a viewport and takes a screenshot once the capture signal is sent
+func RenderSnapshot(ctx context.Context, url string, viewport SnapshotViewport, signal CaptureSignal) ([]byte, error) {
+	var buf []byte
+
+	ctx, cancel := chromedp.NewContext(ctx)
+	defer cancel()
+
+	ctx, cancel = context.WithTimeout(ctx, SnapshotLoadTimeout+signal.Timeout)
+	defer cancel()
+
+	loadTask := chromedp.Tasks{
+		chromedp.EmulateViewport(viewport.Width, viewport.Height),
+	}
+
+	if signal.Script != "" {
+		loadTask = append(loadTask, chromedp.ActionFunc(func(ctx context.Context) error {
+			_, err := page.AddScriptToEvaluateOnNewDocument(signal.Script).Do(ctx)
+			return err
+		}))
+	}
+
+	loadTask = append(loadTask, chromedp.Navigate(url))
+
+	if err := chromedp.Run(ctx, loadTask); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, NewSnapshotRenderingError(ReasonSnapshotTimeout)
+		}
+		return nil, NewSnapshotRenderingError(ReasonSnapshotNavigationFailed)
+	}
+
+	if signal.ReadyExpression != "" {
+		if err := chromedp.Run(ctx, chromedp.Poll(signal.ReadyExpression, nil,
+			chromedp.WithPollingInterval(250*time.Millisecond),
+			chromedp.WithPollingTimeout(signal.Timeout))); err != nil {
+			if !errors.Is(err, chromedp.ErrPollingTimeout) {
+				return nil, NewSnapshotRenderingError(ReasonSnapshotNavigationFailed)
+			}
+
+			if signal.Required {
+				return nil, NewSnapshotRenderingError(ReasonSnapshotSignalTimeout)
+			}
+		}
+	} else if done := indexer.SleepWithContext(ctx, signal.Timeout); done {
+		return nil, NewSnapshotRenderingError(ReasonSnapshotTimeout)
+	}
+
+	if err := chromedp.Run(ctx, chromedp.CaptureScreenshot(&buf)); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, NewSnapshotRenderingError(ReasonSnapshotTimeout)
+		}
+		return nil, NewSnapshotRenderingError(ReasonSnapshotNavigationFailed)
+	}
+
+	return buf, nil
+}
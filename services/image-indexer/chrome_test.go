@@ -0,0 +1,59 @@
+package main
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCaptureSignal(t *testing.T) {
+	signal, err := NewCaptureSignal("", "", 10*time.Second, false)
+	assert.NoError(t, err)
+	assert.Equal(t, fxhashPreviewHook, signal.Script)
+	assert.Equal(t, captureReadyExpression, signal.ReadyExpression)
+
+	signal, err = NewCaptureSignal(CaptureSignalExpression, "window.ready", 10*time.Second, true)
+	assert.NoError(t, err)
+	assert.Empty(t, signal.Script)
+	assert.Equal(t, "window.ready", signal.ReadyExpression)
+	assert.True(t, signal.Required)
+
+	_, err = NewCaptureSignal(CaptureSignalExpression, "", 10*time.Second, false)
+	assert.Error(t, err)
+
+	_, err = NewCaptureSignal("unknown", "", 10*time.Second, false)
+	assert.Error(t, err)
+}
+
+func TestSnapshotRenderingErrorRetryable(t *testing.T) {
+	var rerr *SnapshotRenderingError
+
+	assert.True(t, errors.As(NewSnapshotRenderingError(ReasonSnapshotTimeout), &rerr))
+	assert.True(t, rerr.Retryable())
+
+	assert.True(t, errors.As(NewSnapshotRenderingError(ReasonSnapshotUnsupportedURL), &rerr))
+	assert.False(t, rerr.Retryable())
+}
+
+func TestSnapshotFailureReason(t *testing.T) {
+	reason, failed := snapshotFailureReason(NewSnapshotRenderingError(ReasonSnapshotTimeout), 1, 3)
+	assert.False(t, failed)
+	assert.Empty(t, reason)
+
+	reason, failed = snapshotFailureReason(NewSnapshotRenderingError(ReasonSnapshotTimeout), 3, 3)
+	assert.True(t, failed)
+	assert.Equal(t, ReasonSnapshotTimeout, reason)
+
+	reason, failed = snapshotFailureReason(NewSnapshotRenderingError(ReasonSnapshotUnsupportedURL), 1, 3)
+	assert.True(t, failed)
+	assert.Equal(t, ReasonSnapshotUnsupportedURL, reason)
+
+	_, failed = snapshotFailureReason(errors.New("connection reset"), 2, 3)
+	assert.False(t, failed)
+
+	reason, failed = snapshotFailureReason(errors.New("connection reset"), 3, 3)
+	assert.True(t, failed)
+	assert.Equal(t, ReasonSnapshotFailed, reason)
+}
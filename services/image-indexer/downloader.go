@@ -46,6 +46,12 @@ func validateURL(rawURL string) error {
 
 // DownloadFile downloads a file from a given url and returns a file reader and its mime type
 func DownloadFile(url string) (io.Reader, string, int, error) {
+	return DownloadFileWithinSize(url, 0)
+}
+
+// DownloadFileWithinSize downloads at most maxSize bytes of a file from a given url if maxSize is
+// positive. A larger file is truncated, so a caller rejects it by a size limit under maxSize.
+func DownloadFileWithinSize(url string, maxSize int64) (io.Reader, string, int, error) {
 	// Validate URL to prevent SSRF attacks
 	if err := validateURL(url); err != nil {
 		return nil, "", 0, fmt.Errorf("invalid URL: %w", err)
@@ -63,8 +69,13 @@ func DownloadFile(url string) (io.Reader, string, int, error) {
 		return nil, "", 0, fmt.Errorf("status code: %d", resp.StatusCode)
 	}
 
+	var body io.Reader = resp.Body
+	if maxSize > 0 {
+		body = io.LimitReader(resp.Body, maxSize)
+	}
+
 	fileHeader := make([]byte, 512)
-	if n, err := resp.Body.Read(fileHeader); err != nil {
+	if n, err := body.Read(fileHeader); err != nil {
 		if errors.Is(err, io.EOF) {
 			// the file size is smaller than the sample bytes (512)
 			fileHeader = fileHeader[:n]
@@ -75,7 +86,7 @@ func DownloadFile(url string) (io.Reader, string, int, error) {
 	mimeType := mimetype.Detect(fileHeader).String()
 
 	file := bytes.NewBuffer(fileHeader)
-	if _, err := io.Copy(file, resp.Body); err != nil {
+	if _, err := io.Copy(file, body); err != nil {
 		return nil, "", 0, err
 	}
 
@@ -11,6 +11,8 @@ import (
 	"go.uber.org/zap"
 
 	log "github.com/bitmark-inc/autonomy-logger"
+
+	imageStore "github.com/feral-file/ff-indexer/services/image-indexer/store"
 )
 
 var screenshotSupportedSVGTags = []string{
@@ -82,3 +84,20 @@ func (d *URLImageReader) Read() (file io.Reader, mimeType string, fileSize int,
 
 	return
 }
+
+type URLVideoReader struct {
+	url string
+}
+
+func NewURLVideoReader(url string) *URLVideoReader {
+	return &URLVideoReader{
+		url: url,
+	}
+}
+
+func (d *URLVideoReader) Read() (file io.Reader, mimeType string, fileSize int, err error) {
+	log.Debug("download video from source", zap.String("sourceURL", d.url))
+
+	// a video over the threshold is read one byte over it to be rejected without loading it all
+	return DownloadFileWithinSize(d.url, imageStore.VideoSizeThreshold+1)
+}
@@ -31,11 +31,18 @@ func main() {
 		panic(fmt.Errorf("fail to initialize logger with error: %s", err.Error()))
 	}
 
-	store := imageStore.New(
-		viper.GetString("image_db.dsn"),
-		viper.GetString("cloudflare.account_hash"),
-		viper.GetString("cloudflare.account_id"),
-		viper.GetString("cloudflare.api_token"))
+	imageBackend, err := imageStore.NewImageBackend()
+	if err != nil {
+		panic(err)
+	}
+
+	store := imageStore.New(viper.GetString("image_db.dsn"), imageBackend)
+	if viper.GetBool("video.preview_clips") {
+		if err := store.EnablePreviewClips(); err != nil {
+			panic(fmt.Errorf("fail to enable the preview clips of the %q image backend: %w",
+				viper.GetString("image_backend.type"), err))
+		}
+	}
 	if err := store.AutoMigrate(); err != nil {
 		panic(err)
 	}
@@ -51,6 +58,7 @@ func main() {
 	tokenCollection := db.Collection("tokens")
 	accountTokenCollection := db.Collection("account_tokens")
 	collectionsCollection := db.Collection("collections")
+	staticPreviewURLCollection := db.Collection("asset_static_preview_url")
 
 	ctx, stop := signal.NotifyContext(mainCtx, os.Interrupt, syscall.SIGTERM)
 	defer stop()
@@ -71,8 +79,71 @@ func main() {
 		zap.Duration("retry", thumbnailCacheRetryInterval),
 	)
 
+	// the prefix of the image urls, e.g. the images route of the api gateway for the s3 and the filesystem backends
+	imageURLPrefix := viper.GetString("image_backend.url_prefix")
+	if imageURLPrefix == "" {
+		imageURLPrefix = viper.GetString("cloudflare.url_prefix")
+	}
+
+	snapshotConfig := SnapshotConfig{
+		Enabled:     viper.GetBool("snapshot.enabled"),
+		Workers:     viper.GetInt("snapshot.workers"),
+		RateLimit:   viper.GetFloat64("snapshot.rate_limit"),
+		MaxAttempts: viper.GetInt("snapshot.max_attempts"),
+	}
+	if snapshotConfig.Workers <= 0 {
+		snapshotConfig.Workers = 1
+	}
+	if snapshotConfig.MaxAttempts <= 0 {
+		snapshotConfig.MaxAttempts = 3
+	}
+	snapshotConfig.RetryInterval, err = time.ParseDuration(viper.GetString("snapshot.retry_interval"))
+	if err != nil {
+		log.ErrorWithContext(ctx, errors.New("invalid duration. use default value 1h"), zap.Error(err))
+		snapshotConfig.RetryInterval = time.Hour
+	}
+	signalTimeout, err := time.ParseDuration(viper.GetString("snapshot.capture_signal.timeout"))
+	if err != nil {
+		log.ErrorWithContext(ctx, errors.New("invalid duration. use default value 15s"), zap.Error(err))
+		signalTimeout = 15 * time.Second
+	}
+	snapshotConfig.Signal, err = NewCaptureSignal(
+		viper.GetString("snapshot.capture_signal.type"),
+		viper.GetString("snapshot.capture_signal.ready_expression"),
+		signalTimeout,
+		viper.GetBool("snapshot.capture_signal.required"))
+	if err != nil {
+		panic(err)
+	}
+
+	thumbnailQueueConfig := ThumbnailQueueConfig{
+		MaxAttempts:      viper.GetInt("thumbnail.max_attempts"),
+		DefaultRateLimit: viper.GetFloat64("thumbnail.default_rate_limit"),
+		RateLimits:       map[string]float64{},
+	}
+	if thumbnailQueueConfig.MaxAttempts <= 0 {
+		thumbnailQueueConfig.MaxAttempts = imageStore.DefaultThumbnailJobMaxAttempts
+	}
+	if err := viper.UnmarshalKey("thumbnail.rate_limits", &thumbnailQueueConfig.RateLimits); err != nil {
+		panic(err)
+	}
+	thumbnailQueueConfig.LeaseDuration, err = time.ParseDuration(viper.GetString("thumbnail.lease_duration"))
+	if err != nil {
+		log.ErrorWithContext(ctx, errors.New("invalid duration. use default value 10m"), zap.Error(err))
+		thumbnailQueueConfig.LeaseDuration = 10 * time.Minute
+	}
+
 	imageIndexer := NewNFTContentIndexer(store, assetCollection, tokenCollection, accountTokenCollection, collectionsCollection,
-		thumbnailCachePeriod, thumbnailCacheRetryInterval, viper.GetString("cloudflare.url_prefix"))
+		staticPreviewURLCollection, thumbnailCachePeriod, thumbnailCacheRetryInterval, imageURLPrefix, snapshotConfig,
+		thumbnailQueueConfig)
+
+	server := NewServer(viper.GetString("server.address"), viper.GetString("server.api_token"), imageIndexer)
+	go func() {
+		if err := server.Run(); err != nil {
+			log.ErrorWithContext(ctx, errors.New("thumbnail job server stopped with error"), zap.Error(err))
+		}
+	}()
+
 	imageIndexer.Start(ctx)
 
 	log.InfoWithContext(ctx, "Content indexer terminated")
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	"github.com/gin-gonic/gin"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	imageStore "github.com/feral-file/ff-indexer/services/image-indexer/store"
+)
+
+// ThumbnailJobEnqueuer queues the thumbnail jobs of the assets
+type ThumbnailJobEnqueuer interface {
+	EnqueueAssetThumbnail(ctx context.Context, indexID string, priority imageStore.ThumbnailJobPriority, force bool) (bool, error)
+}
+
+// Server serves the endpoints to request the thumbnails of the assets
+type Server struct {
+	address  string
+	apiToken string
+	enqueuer ThumbnailJobEnqueuer
+	route    *gin.Engine
+}
+
+func NewServer(address, apiToken string, enqueuer ThumbnailJobEnqueuer) *Server {
+	s := &Server{
+		address:  address,
+		apiToken: apiToken,
+		enqueuer: enqueuer,
+		route:    gin.New(),
+	}
+	s.setupRoute()
+
+	return s
+}
+
+func (s *Server) setupRoute() {
+	v1 := s.route.Group("/v1", tokenAuthenticate("API-TOKEN", s.apiToken))
+
+	v1.POST("/thumbnail-jobs", s.EnqueueThumbnailJob)
+}
+
+// Run starts the server. It is disabled when the address or the api token is not set.
+func (s *Server) Run() error {
+	if s.address == "" || s.apiToken == "" {
+		log.Info("thumbnail job server is disabled")
+		return nil
+	}
+
+	return s.route.Run(s.address)
+}
+
+func tokenAuthenticate(tokenKey, tokenValue string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if subtle.ConstantTimeCompare([]byte(c.GetHeader(tokenKey)), []byte(tokenValue)) != 1 {
+			abortWithError(c, http.StatusForbidden, "invalid api token", fmt.Errorf("invalid api token"))
+			return
+		}
+		c.Next()
+	}
+}
+
+func abortWithError(c *gin.Context, code int, message string, traceErr error) {
+	if code == http.StatusInternalServerError {
+		log.ErrorWithContext(c, errors.New(message), zap.Error(traceErr))
+	} else {
+		log.WarnWithContext(c, message, zap.Error(traceErr))
+	}
+
+	c.AbortWithStatusJSON(code, gin.H{
+		"message": message,
+	})
+}
+
+type EnqueueThumbnailJobRequest struct {
+	IndexID  string `json:"indexID" binding:"required"`
+	Priority string `json:"priority"`
+	Force    bool   `json:"force"`
+}
+
+// EnqueueThumbnailJob queues the thumbnail job of an asset. The priority is high by default.
+func (s *Server) EnqueueThumbnailJob(c *gin.Context) {
+	req := EnqueueThumbnailJobRequest{
+		Priority: "high",
+	}
+	if err := c.BindJSON(&req); err != nil {
+		abortWithError(c, http.StatusBadRequest, "invalid parameters", err)
+		return
+	}
+
+	priority, ok := imageStore.ThumbnailJobPriorities[req.Priority]
+	if !ok {
+		abortWithError(c, http.StatusBadRequest, "invalid parameters", fmt.Errorf("invalid priority: %s", req.Priority))
+		return
+	}
+
+	queued, err := s.enqueuer.EnqueueAssetThumbnail(c, req.IndexID, priority, req.Force)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			abortWithError(c, http.StatusNotFound, "asset not found", err)
+			return
+		}
+		abortWithError(c, http.StatusInternalServerError, "fail to queue thumbnail job", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"ok":     1,
+		"queued": queued,
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -23,12 +24,28 @@ type Type string
 const (
 	TypeCollection = "collection"
 	TypeAsset      = "asset"
+	// TypeVideo is an asset which thumbnail is the poster frame of its video
+	TypeVideo = "video"
 )
 
 type NFTAsset struct {
-	ID              string                           `bson:"id"`
-	IndexID         string                           `bson:"indexID"`
-	ProjectMetadata indexer.VersionedProjectMetadata `bson:"projectMetadata"`
+	ID                    string                           `bson:"id"`
+	IndexID               string                           `bson:"indexID"`
+	ProjectMetadata       indexer.VersionedProjectMetadata `bson:"projectMetadata"`
+	ThumbnailID           string                           `bson:"thumbnailID"`
+	ThumbnailFailedReason string                           `bson:"thumbnailFailedReason"`
+}
+
+// nftAssetProjection is the fields of an asset to generate its thumbnail
+var nftAssetProjection = bson.M{
+	"id":                                  1,
+	"indexID":                             1,
+	"projectMetadata.latest.thumbnailURL": 1,
+	"projectMetadata.latest.previewURL":   1,
+	"projectMetadata.latest.medium":       1,
+	"projectMetadata.latest.source":       1,
+	"thumbnailID":                         1,
+	"thumbnailFailedReason":               1,
 }
 
 type ThumbnailIndexInfo struct {
@@ -44,180 +61,199 @@ type NFTContentIndexer struct {
 	thumbnailCachePeriod        time.Duration
 	thumbnailCacheRetryInterval time.Duration
 
-	cloudflareURLPrefix string
+	imageURLPrefix string
+
+	snapshot SnapshotConfig
+
+	thumbnailQueue   ThumbnailQueueConfig
+	thumbnailLimiter *sourceRateLimiter
+	thumbnailPools   []*thumbnailWorkerPool
 
 	db               *imageStore.ImageStore
 	nftAssets        *mongo.Collection
 	nftTokens        *mongo.Collection
 	nftAccountTokens *mongo.Collection
 	nftCollections   *mongo.Collection
+	// nftStaticPreviewURLs is the collection of the static previews of the assets
+	nftStaticPreviewURLs *mongo.Collection
 }
 
-func NewNFTContentIndexer(db *imageStore.ImageStore, nftAssets, nftTokens, nftAccountTokens, nftCollections *mongo.Collection,
-	thumbnailCachePeriod, thumbnailCacheRetryInterval time.Duration, cloudflareURLPrefix string) *NFTContentIndexer {
+func NewNFTContentIndexer(db *imageStore.ImageStore, nftAssets, nftTokens, nftAccountTokens, nftCollections, nftStaticPreviewURLs *mongo.Collection,
+	thumbnailCachePeriod, thumbnailCacheRetryInterval time.Duration, imageURLPrefix string, snapshot SnapshotConfig,
+	thumbnailQueue ThumbnailQueueConfig) *NFTContentIndexer {
 	return &NFTContentIndexer{
 		thumbnailCachePeriod:        thumbnailCachePeriod,
 		thumbnailCacheRetryInterval: thumbnailCacheRetryInterval,
 
-		cloudflareURLPrefix: cloudflareURLPrefix,
+		imageURLPrefix: imageURLPrefix,
+
+		snapshot: snapshot,
+
+		thumbnailQueue:   thumbnailQueue,
+		thumbnailLimiter: newSourceRateLimiter(thumbnailQueue.DefaultRateLimit, thumbnailQueue.RateLimits),
+		thumbnailPools: []*thumbnailWorkerPool{
+			newThumbnailWorkerPool([]string{TypeAsset, TypeCollection}, 5),
+			// the videos are processed by fewer workers since extracting previews with ffmpeg is cpu intensive
+			newThumbnailWorkerPool([]string{TypeVideo}, 2),
+		},
 
 		db:               db,
 		nftAssets:        nftAssets,
 		nftTokens:        nftTokens,
 		nftAccountTokens: nftAccountTokens,
 		nftCollections:   nftCollections,
+
+		nftStaticPreviewURLs: nftStaticPreviewURLs,
 	}
 }
 
-// spawnThumbnailWorker spawn worker for generate thumbnails from source images
-func (s *NFTContentIndexer) spawnThumbnailWorker(ctx context.Context, dataChan <-chan ThumbnailIndexInfo, count int) {
-	for i := 0; i < count; i++ {
-		s.wg.Add(1)
-		go func() {
-			defer s.wg.Done()
-			for info := range dataChan {
-				log.InfoWithContext(ctx, "start generating thumbnail cache for an asset", zap.String("indexID", info.ID))
-
-				if _, err := s.db.CreateOrGetImage(ctx, info.ID); err != nil {
-					log.WarnWithContext(ctx, "fail to get or create image record", zap.Error(err))
-					continue
-				}
-
-				uploadImageStartTime := time.Now()
-				img, err := s.db.UploadImage(ctx, info.ID, NewURLImageReader(info.ImageURL),
-					info.Metadata,
-				)
-				if err != nil {
-					var uerr imageStore.UnsupportedImageCachingError
-					if errors.As(err, &uerr) {
-						// add failure to the asset
-						if uerr.Reason() == imageStore.ReasonBrokenImage {
-							log.WarnWithContext(ctx, "broken image",
-								zap.String("id", info.ID),
-								zap.String("type", string(info.Type)),
-								zap.String("thumbnailURL", info.ImageURL))
-						}
-
-						if err := s.markAssetThumbnailFailed(ctx, info.ID, uerr.Reason()); err != nil {
-							log.WarnWithContext(ctx, "add thumbnail failure was failed", zap.String("id", info.ID), zap.Error(err))
-						}
-					}
-
-					log.WarnWithContext(ctx, "fail to upload image", zap.String("id", info.ID), zap.Error(err))
-					continue
-				}
-				log.InfoWithContext(ctx, "thumbnail image uploaded",
-					zap.Duration("duration", time.Since(uploadImageStartTime)),
-					zap.String("id", info.ID))
-
-				// Update the thumbnail by image ID returned from cloudflare, it the whol process is succeed.
-				// Otherwise, it would update to an empty value
-				switch info.Type {
-				case TypeAsset:
-					if err := s.updateAssetThumbnail(ctx, img.AssetID, img.ImageID); err != nil {
-						log.WarnWithContext(ctx, "fail to update token thumbnail back to indexer", zap.Error(err))
-						continue
-					}
-				case TypeCollection:
-					if err := s.updateCollectionThumbnail(ctx, img.AssetID, img.ImageID); err != nil {
-						log.WarnWithContext(ctx, "fail to update token thumbnail back to indexer", zap.Error(err))
-						continue
-					}
-				default:
-					log.WarnWithContext(ctx, "type is not supported", zap.Error(err))
-					continue
-				}
-
-				log.InfoWithContext(ctx, "thumbnail generating process finished", zap.String("indexID", info.ID))
+// generateThumbnail generates the thumbnail of an asset or a collection from its source image and
+// sets it back to the indexer. The failure of an unsupported image is added to the asset.
+func (s *NFTContentIndexer) generateThumbnail(ctx context.Context, info ThumbnailIndexInfo) error {
+	log.InfoWithContext(ctx, "start generating thumbnail cache for an asset", zap.String("indexID", info.ID))
+
+	if _, err := s.db.CreateOrGetImage(ctx, info.ID); err != nil {
+		log.WarnWithContext(ctx, "fail to get or create image record", zap.Error(err))
+		return err
+	}
+
+	uploadImageStartTime := time.Now()
+	var img imageStore.ImageMetadata
+	var err error
+	if info.Type == TypeVideo {
+		img, err = s.db.UploadVideoPreview(ctx, info.ID, NewURLVideoReader(info.ImageURL),
+			info.Metadata,
+		)
+	} else {
+		img, err = s.db.UploadImage(ctx, info.ID, NewURLImageReader(info.ImageURL),
+			info.Metadata,
+		)
+	}
+	if err != nil {
+		var uerr imageStore.UnsupportedImageCachingError
+		if errors.As(err, &uerr) {
+			// add failure to the asset
+			if uerr.Reason() == imageStore.ReasonBrokenImage {
+				log.WarnWithContext(ctx, "broken image",
+					zap.String("id", info.ID),
+					zap.String("type", string(info.Type)),
+					zap.String("thumbnailURL", info.ImageURL))
+			}
+
+			if err := s.markAssetThumbnailFailed(ctx, info.ID, uerr.Reason()); err != nil {
+				log.WarnWithContext(ctx, "add thumbnail failure was failed", zap.String("id", info.ID), zap.Error(err))
 			}
-			log.InfoWithContext(ctx, "ThumbnailWorker stopped")
-		}()
+		}
+
+		log.WarnWithContext(ctx, "fail to upload image", zap.String("id", info.ID), zap.Error(err))
+		return err
 	}
+	log.InfoWithContext(ctx, "thumbnail image uploaded",
+		zap.Duration("duration", time.Since(uploadImageStartTime)),
+		zap.String("id", info.ID))
+
+	// Update the thumbnail by image ID returned from the image backend, it the whol process is succeed.
+	// Otherwise, it would update to an empty value
+	switch info.Type {
+	case TypeAsset, TypeVideo:
+		if err := s.updateAssetThumbnail(ctx, img); err != nil {
+			log.WarnWithContext(ctx, "fail to update token thumbnail back to indexer", zap.Error(err))
+			return err
+		}
+	case TypeCollection:
+		if err := s.updateCollectionThumbnail(ctx, img.AssetID, img.ImageID); err != nil {
+			log.WarnWithContext(ctx, "fail to update token thumbnail back to indexer", zap.Error(err))
+			return err
+		}
+	default:
+		return fmt.Errorf("type is not supported: %s", info.Type)
+	}
+
+	log.InfoWithContext(ctx, "thumbnail generating process finished", zap.String("indexID", info.ID))
+	return nil
 }
 
-// getAssetWithoutThumbnailCached looks up assets without thumbnail cached
-func (s *NFTContentIndexer) getAssetWithoutThumbnailCached(ctx context.Context) (NFTAsset, error) {
-	var asset NFTAsset
+// thumbnailCheckFilter filters the documents which have not been checked in the retry interval
+func (s *NFTContentIndexer) thumbnailCheckFilter() bson.A {
 	ts := time.Now().Add(-s.thumbnailCacheRetryInterval)
-	r := s.nftAssets.FindOneAndUpdate(ctx,
+	return bson.A{
+		bson.M{ // this will be false of any non time values
+			"thumbnailLastCheck": bson.M{"$lt": ts},
+		},
+		bson.M{ // include null and empty string to cover both defaults
+			"thumbnailLastCheck": bson.M{"$in": bson.A{nil, ""}},
+		},
+	}
+}
+
+// getAssetsWithoutThumbnailCached looks up a batch of assets without thumbnail cached and
+// marks them checked
+func (s *NFTContentIndexer) getAssetsWithoutThumbnailCached(ctx context.Context, limit int64) ([]NFTAsset, error) {
+	cursor, err := s.nftAssets.Find(ctx,
 		bson.M{ // This is effectively "$and"
 
 			// filter recent assets which have not been processed or are not timestamped
-			//"thumbnailLastCheck": bson.M{"$lt": time.Now().Add(-s.thumbnailCacheRetryInterval)},
-			"$or": bson.A{
-				bson.M{ // this will be false of any non time values
-					"thumbnailLastCheck": bson.M{"$lt": ts},
-				},
-				bson.M{ // include null and empty string to cover both defaults
-					"thumbnailLastCheck": bson.M{"$in": bson.A{nil, ""}},
-				},
-			},
+			"$or": s.thumbnailCheckFilter(),
 
 			// filter assets which does not have thumbnailID or the thumbnailID is empty
 			"thumbnailID": bson.M{
-				// "$not": bson.M{"$exists": true, "$ne": ""},
 				"$in": bson.A{nil, ""},
 			},
 
 			// filter assets which does not have thumbnailFailure or the thumbnailFailure is empty
 			"thumbnailFailedReason": bson.M{
-				// "$not": bson.M{"$exists": true},
 				"$in": bson.A{nil, ""},
 			},
-			// filter assets which are qualified to generate thumbnails in cloudflare.
-			// "$or": bson.A{
-			// 	// filter all tokens that set SVG as the mime-type and their thumbnail URLs start with https
-			// 	bson.M{
-			// 		"projectMetadata.latest.mimeType":     "image/svg+xml",
-			// 		"projectMetadata.latest.thumbnailURL": bson.M{"$regex": "^https://"},
-			// 	},
-			// 	// For tezos tokens, it parses tokens that starts with `https://ipfs.` which means
-			// 	// all token that is uploaded to IPFS but is not cached by objkt.
-			// 	bson.M{
-			// 		"source":                              "tzkt",
-			// 		"projectMetadata.latest.source":       bson.M{"$nin": []string{"fxhash"}},
-			// 		"projectMetadata.latest.thumbnailURL": bson.M{"$regex": "^https://ipfs"}, // either ipfs.io or ipfs.bitmark
-			// 	},
-			// 	// For opensea tokens, we can only check the mime-type of an asset by its file extension.
-			// 	bson.M{
-			// 		"source": "opensea",
-			// 		"projectMetadata.latest.thumbnailURL": bson.M{
-			// 			"$regex": ".svg$",
-			// 		},
-			// 	},
-			// },
 		},
-		bson.M{"$set": bson.M{"thumbnailLastCheck": time.Now()}},
-		options.FindOneAndUpdate().
-			// SetSort(bson.D{{Key: "projectMetadata.latest.lastUpdatedAt", Value: -1}}).
-			SetProjection(bson.M{"id": 1, "indexID": 1, "projectMetadata.latest.thumbnailURL": 1}),
+		options.Find().
+			SetLimit(limit).
+			SetProjection(nftAssetProjection),
 	)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := r.Err(); err != nil {
-		return asset, err
+	var assets []NFTAsset
+	if err := cursor.All(ctx, &assets); err != nil {
+		return nil, err
 	}
 
-	err := r.Decode(&asset)
+	if len(assets) == 0 {
+		return assets, nil
+	}
+
+	indexIDs := make(bson.A, 0, len(assets))
+	for _, asset := range assets {
+		indexIDs = append(indexIDs, asset.IndexID)
+	}
+
+	_, err = s.nftAssets.UpdateMany(ctx,
+		bson.M{"indexID": bson.M{"$in": indexIDs}},
+		bson.M{"$set": bson.M{"thumbnailLastCheck": time.Now()}},
+	)
+
+	return assets, err
+}
+
+// getAsset returns an asset with the fields to generate its thumbnail
+func (s *NFTContentIndexer) getAsset(ctx context.Context, indexID string) (NFTAsset, error) {
+	var asset NFTAsset
+	err := s.nftAssets.FindOne(ctx,
+		bson.M{"indexID": indexID},
+		options.FindOne().SetProjection(nftAssetProjection),
+	).Decode(&asset)
+
 	return asset, err
 }
 
-// getCollectionWithoutThumbnailCached looks up collection without thumbnail cached
-func (s *NFTContentIndexer) getCollectionWithoutThumbnailCached(ctx context.Context) (indexer.Collection, error) {
-	var collection indexer.Collection
-	ts := time.Now().Add(-s.thumbnailCacheRetryInterval)
-	r := s.nftCollections.FindOneAndUpdate(ctx,
+// getCollectionsWithoutThumbnailCached looks up a batch of collections without thumbnail cached and
+// marks them checked
+func (s *NFTContentIndexer) getCollectionsWithoutThumbnailCached(ctx context.Context, limit int64) ([]indexer.Collection, error) {
+	cursor, err := s.nftCollections.Find(ctx,
 		bson.M{ // This is effectively "$and"
 
 			// filter recent collections which have not been processed or are not timestamped
-			//"thumbnailLastCheck": bson.M{"$lt": time.Now().Add(-s.thumbnailCacheRetryInterval)},
-			"$or": bson.A{
-				bson.M{ // this will be false of any non time values
-					"thumbnailLastCheck": bson.M{"$lt": ts},
-				},
-				bson.M{ // include null and empty string to cover both defaults
-					"thumbnailLastCheck": bson.M{"$in": bson.A{nil, ""}},
-				},
-			},
+			"$or": s.thumbnailCheckFilter(),
 
 			// filter collections which does not have thumbnailURL or the thumbnailURL is empty
 			"thumbnailURL": bson.M{
@@ -229,28 +265,62 @@ func (s *NFTContentIndexer) getCollectionWithoutThumbnailCached(ctx context.Cont
 				"$in": bson.A{nil, ""},
 			},
 		},
-		bson.M{"$set": bson.M{"thumbnailLastCheck": time.Now()}},
-		options.FindOneAndUpdate().
-			SetProjection(bson.M{"id": 1, "imageURL": 1}),
+		options.Find().
+			SetLimit(limit).
+			SetProjection(bson.M{"id": 1, "imageURL": 1, "source": 1}),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	var collections []indexer.Collection
+	if err := cursor.All(ctx, &collections); err != nil {
+		return nil, err
+	}
+
+	if len(collections) == 0 {
+		return collections, nil
+	}
 
-	if err := r.Err(); err != nil {
-		return collection, err
+	ids := make(bson.A, 0, len(collections))
+	for _, col := range collections {
+		ids = append(ids, col.ID)
 	}
 
-	err := r.Decode(&collection)
-	return collection, err
+	_, err = s.nftCollections.UpdateMany(ctx,
+		bson.M{"id": bson.M{"$in": ids}},
+		bson.M{"$set": bson.M{"thumbnailLastCheck": time.Now()}},
+	)
+
+	return collections, err
 }
 
-// updateAssetThumbnail sets the thumbnail id for a specific token
-func (s *NFTContentIndexer) updateAssetThumbnail(ctx context.Context, indexID, thumbnailID string) error {
+// updateAssetThumbnail sets the thumbnail id and the placeholder for a specific token. The preview
+// clip and the duration are set for the videos. The static previews of the asset are set to the
+// thumbnail if they are absent.
+func (s *NFTContentIndexer) updateAssetThumbnail(ctx context.Context, img imageStore.ImageMetadata) error {
+	indexID := img.AssetID
+	updates := bson.D{
+		{Key: "thumbnailID", Value: img.ImageID},
+		{Key: "placeholder", Value: indexer.ImagePlaceholder{
+			Width:          img.Width,
+			Height:         img.Height,
+			AspectRatio:    img.AspectRatio(),
+			Blurhash:       img.Blurhash,
+			DominantColors: img.DominantColors,
+		}},
+		{Key: "lastRefreshedTime", Value: time.Now()},
+	}
+	if img.DurationSeconds > 0 {
+		updates = append(updates,
+			bson.E{Key: "previewClipID", Value: img.PreviewClipID},
+			bson.E{Key: "durationSeconds", Value: img.DurationSeconds})
+	}
+
 	_, err := s.nftAssets.UpdateOne(
 		ctx,
 		bson.M{"indexID": indexID},
-		bson.D{{Key: "$set", Value: bson.D{
-			{Key: "thumbnailID", Value: thumbnailID},
-			{Key: "lastRefreshedTime", Value: time.Now()},
-		}}},
+		bson.D{{Key: "$set", Value: updates}},
 	)
 	if err != nil {
 		log.WarnWithContext(ctx, "update asset thumbnail failed", zap.String("indexID", indexID), zap.Error(err))
@@ -266,6 +336,12 @@ func (s *NFTContentIndexer) updateAssetThumbnail(ctx context.Context, indexID, t
 	}
 	assetID := idSegments[1]
 
+	previewURL := s.imageURL(img.ImageID, imageStore.ImageVariantFull)
+	if err := s.updateAssetStaticPreview(ctx, assetID, previewURL, previewURL, false); err != nil {
+		log.WarnWithContext(ctx, "fail to set asset static preview", zap.String("assetID", assetID), zap.Error(err))
+		return err
+	}
+
 	cursor, err := s.nftTokens.Find(ctx,
 		bson.M{"assetID": assetID},
 		options.Find().SetProjection(bson.M{"indexID": 1}))
@@ -299,7 +375,7 @@ func (s *NFTContentIndexer) updateAssetThumbnail(ctx context.Context, indexID, t
 
 // updateCollectionThumbnail sets the thumbnail id for a specific collection
 func (s *NFTContentIndexer) updateCollectionThumbnail(ctx context.Context, id, thumbnailID string) error {
-	thumbnailURL := fmt.Sprintf("%s%s/%s", s.cloudflareURLPrefix, thumbnailID, "thumbnail")
+	thumbnailURL := s.imageURL(thumbnailID, imageStore.ImageVariantThumbnail)
 	_, err := s.nftCollections.UpdateOne(
 		ctx,
 		bson.M{"id": id},
@@ -316,6 +392,61 @@ func (s *NFTContentIndexer) updateCollectionThumbnail(ctx context.Context, id, t
 	return err
 }
 
+// updateAssetStaticPreview sets the static previews of an asset to the image urls. The static
+// previews which are not generated by the image indexer are kept and the snapshots of an
+// artwork are only replaced by its new snapshots.
+func (s *NFTContentIndexer) updateAssetStaticPreview(ctx context.Context, assetID, landscapeURL, portraitURL string, snapshot bool) error {
+	// the image urls are unknown without the prefix
+	if s.imageURLPrefix == "" {
+		return nil
+	}
+
+	filter := bson.M{
+		"assetID":      assetID,
+		"landscapeURL": bson.M{"$regex": "^" + regexp.QuoteMeta(s.imageURLPrefix)},
+	}
+	if !snapshot {
+		filter["snapshot"] = bson.M{"$ne": true}
+	}
+
+	// replace the previews of the previous image which is removed
+	r, err := s.nftStaticPreviewURLs.UpdateOne(
+		ctx,
+		filter,
+		bson.M{"$set": bson.M{
+			"landscapeURL": landscapeURL,
+			"portraitURL":  portraitURL,
+			"snapshot":     snapshot,
+		}},
+	)
+	if err != nil {
+		return err
+	}
+
+	if r.MatchedCount > 0 {
+		return nil
+	}
+
+	_, err = s.nftStaticPreviewURLs.UpdateOne(
+		ctx,
+		bson.M{"assetID": assetID},
+		bson.M{"$setOnInsert": bson.M{
+			"assetID":      assetID,
+			"landscapeURL": landscapeURL,
+			"portraitURL":  portraitURL,
+			"snapshot":     snapshot,
+		}},
+		options.Update().SetUpsert(true),
+	)
+
+	return err
+}
+
+// imageURL returns the url of a variant of an image
+func (s *NFTContentIndexer) imageURL(imageID, variant string) string {
+	return fmt.Sprintf("%s%s/%s", s.imageURLPrefix, imageID, variant)
+}
+
 // markAssetThumbnailFailed sets thumbnail failure for a specific token
 func (s *NFTContentIndexer) markAssetThumbnailFailed(ctx context.Context, indexID, thumbnailFailedReason string) error {
 	_, err := s.nftAssets.UpdateOne(
@@ -327,85 +458,10 @@ func (s *NFTContentIndexer) markAssetThumbnailFailed(ctx context.Context, indexI
 	return err
 }
 
-func (s *NFTContentIndexer) checkThumbnail(ctx context.Context) {
-	s.wg.Add(1)
-	go func() {
-		defer s.wg.Done()
-
-		dataChan := make(chan ThumbnailIndexInfo, 20)
-		defer close(dataChan)
-
-		s.spawnThumbnailWorker(ctx, dataChan, 5)
-
-		log.InfoWithContext(ctx, "start the loop the get assets without thumbnail cached",
-			zap.Duration("thumbnailCachePeriod", s.thumbnailCachePeriod),
-			zap.Duration("thumbnailCacheRetryInterval", s.thumbnailCacheRetryInterval))
-
-		// Watch collections thumbnail cached
-		s.wg.Add(1)
-		go func() {
-			defer s.wg.Done()
-
-		WATCH_COLLECTION:
-			for {
-				col, err := s.getCollectionWithoutThumbnailCached(ctx)
-				if err != nil {
-					if errors.Is(err, mongo.ErrNoDocuments) {
-						log.InfoWithContext(ctx, "no collection need to be processed")
-					} else {
-						log.WarnWithContext(ctx, "fail to get collection that has no thumbnail cached", zap.Error(err))
-					}
-
-					if done := indexer.SleepWithContext(ctx, 15*time.Second); done {
-						break WATCH_COLLECTION
-					}
-					continue
-				}
-				log.InfoWithContext(ctx, "send collection image to process", zap.String("id", col.ID))
-				dataChan <- ThumbnailIndexInfo{
-					ID:       col.ID,
-					ImageURL: col.ImageURL,
-					Metadata: map[string]interface{}{
-						"source":   col.Source,
-						"file_url": col.ImageURL,
-					},
-					Type: TypeCollection,
-				}
-			}
-		}()
-
-	WATCH_ASSETS:
-		for {
-			asset, err := s.getAssetWithoutThumbnailCached(ctx)
-			if err != nil {
-				if errors.Is(err, mongo.ErrNoDocuments) {
-					log.InfoWithContext(ctx, "no asset need to be processed")
-				} else {
-					log.WarnWithContext(ctx, "fail to get asset that has no thumbnail cached", zap.Error(err))
-				}
-
-				if done := indexer.SleepWithContext(ctx, 15*time.Second); done {
-					break WATCH_ASSETS
-				}
-				continue
-			}
-			log.InfoWithContext(ctx, "send asset image to process", zap.String("indexID", asset.IndexID))
-			dataChan <- ThumbnailIndexInfo{
-				ID:       asset.IndexID,
-				ImageURL: asset.ProjectMetadata.Latest.ThumbnailURL,
-				Metadata: map[string]interface{}{
-					"source":   asset.ProjectMetadata.Latest.Source,
-					"file_url": asset.ProjectMetadata.Latest.ThumbnailURL,
-				},
-				Type: TypeAsset,
-			}
-		}
-		log.InfoWithContext(ctx, "thumbnail checker closed")
-	}()
-
-}
-
 func (s *NFTContentIndexer) Start(ctx context.Context) {
 	s.checkThumbnail(ctx)
+	if s.snapshot.Enabled {
+		s.renderSnapshots(ctx)
+	}
 	s.wg.Wait()
 }
@@ -0,0 +1,267 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	indexer "github.com/feral-file/ff-indexer"
+	imageStore "github.com/feral-file/ff-indexer/services/image-indexer/store"
+)
+
+// SnapshotConfig is the settings of rendering the static snapshots of the software artworks
+type SnapshotConfig struct {
+	Enabled bool
+	Workers int
+	// RateLimit is the max number of the artworks rendered per minute
+	RateLimit float64
+	// MaxAttempts is the max number of rendering an artwork which fails with retryable errors
+	MaxAttempts   int
+	RetryInterval time.Duration
+	Signal        CaptureSignal
+}
+
+type snapshotAsset struct {
+	IndexID          string                           `bson:"indexID"`
+	ProjectMetadata  indexer.VersionedProjectMetadata `bson:"projectMetadata"`
+	SnapshotAttempts int                              `bson:"snapshotAttempts"`
+}
+
+// getAssetWithoutSnapshot looks up a software asset without snapshots rendered and counts the attempt
+func (s *NFTContentIndexer) getAssetWithoutSnapshot(ctx context.Context) (snapshotAsset, error) {
+	var asset snapshotAsset
+	ts := time.Now().Add(-s.snapshot.RetryInterval)
+	r := s.nftAssets.FindOneAndUpdate(ctx,
+		bson.M{
+			"projectMetadata.latest.medium":     indexer.MediumSoftware,
+			"projectMetadata.latest.previewURL": bson.M{"$regex": "^https?://"},
+
+			"$or": bson.A{
+				bson.M{ // this will be false of any non time values
+					"snapshotLastCheck": bson.M{"$lt": ts},
+				},
+				bson.M{ // include null and empty string to cover both defaults
+					"snapshotLastCheck": bson.M{"$in": bson.A{nil, ""}},
+				},
+			},
+
+			"snapshotRenderedAt": bson.M{
+				"$in": bson.A{nil, ""},
+			},
+
+			"snapshotFailedReason": bson.M{
+				"$in": bson.A{nil, ""},
+			},
+		},
+		bson.M{
+			"$set": bson.M{"snapshotLastCheck": time.Now()},
+			"$inc": bson.M{"snapshotAttempts": 1},
+		},
+		options.FindOneAndUpdate().
+			SetReturnDocument(options.After).
+			SetProjection(bson.M{
+				"indexID":                           1,
+				"projectMetadata.latest.previewURL": 1,
+				"projectMetadata.latest.source":     1,
+				"snapshotAttempts":                  1,
+			}),
+	)
+
+	if err := r.Err(); err != nil {
+		return asset, err
+	}
+
+	err := r.Decode(&asset)
+	return asset, err
+}
+
+// renderAssetSnapshots renders the landscape and the portrait snapshots of an asset and uploads them
+func (s *NFTContentIndexer) renderAssetSnapshots(ctx context.Context, asset snapshotAsset) (imageStore.SnapshotMetadata, error) {
+	previewURL := asset.ProjectMetadata.Latest.PreviewURL
+	if err := validateURL(previewURL); err != nil {
+		return imageStore.SnapshotMetadata{}, NewSnapshotRenderingError(ReasonSnapshotUnsupportedURL)
+	}
+
+	snapshots := make(map[string]*bytes.Buffer, 2)
+	for _, viewport := range []SnapshotViewport{SnapshotViewportLandscape, SnapshotViewportPortrait} {
+		buf, err := RenderSnapshot(ctx, previewURL, viewport, s.snapshot.Signal)
+		if err != nil {
+			return imageStore.SnapshotMetadata{}, err
+		}
+		snapshots[viewport.Name] = bytes.NewBuffer(buf)
+	}
+
+	landscape, err := imageStore.DecodeImage(snapshots[SnapshotViewportLandscape.Name])
+	if err != nil {
+		return imageStore.SnapshotMetadata{}, NewSnapshotRenderingError(ReasonSnapshotBlankImage)
+	}
+	portrait, err := imageStore.DecodeImage(snapshots[SnapshotViewportPortrait.Name])
+	if err != nil {
+		return imageStore.SnapshotMetadata{}, NewSnapshotRenderingError(ReasonSnapshotBlankImage)
+	}
+
+	if imageStore.IsBlankImage(landscape) || imageStore.IsBlankImage(portrait) {
+		return imageStore.SnapshotMetadata{}, NewSnapshotRenderingError(ReasonSnapshotBlankImage)
+	}
+
+	snapshot, err := s.db.UploadSnapshots(ctx, asset.IndexID, landscape, portrait, imageStore.Metadata{
+		"source":    asset.ProjectMetadata.Latest.Source,
+		"file_url":  previewURL,
+		"mime_type": "image/png",
+	})
+	if err != nil {
+		var uerr imageStore.UnsupportedImageCachingError
+		if errors.As(err, &uerr) {
+			return snapshot, err
+		}
+
+		log.WarnWithContext(ctx, "fail to upload snapshots", zap.String("indexID", asset.IndexID), zap.Error(err))
+		return snapshot, NewSnapshotRenderingError(ReasonSnapshotUploadFailed)
+	}
+
+	return snapshot, nil
+}
+
+// spawnSnapshotWorker spawns workers which render the snapshots of the software artworks.
+// The renderings of all the workers are limited by the rate limit.
+func (s *NFTContentIndexer) spawnSnapshotWorker(ctx context.Context, assetChan <-chan snapshotAsset, count int) {
+	limit := rate.Inf
+	if s.snapshot.RateLimit > 0 {
+		limit = rate.Limit(s.snapshot.RateLimit / 60)
+	}
+	limiter := rate.NewLimiter(limit, 1)
+
+	for i := 0; i < count; i++ {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			for asset := range assetChan {
+				if err := limiter.Wait(ctx); err != nil {
+					break
+				}
+
+				log.InfoWithContext(ctx, "start rendering snapshots for an asset",
+					zap.String("indexID", asset.IndexID),
+					zap.Int("attempt", asset.SnapshotAttempts))
+
+				renderStartTime := time.Now()
+				snapshot, err := s.renderAssetSnapshots(ctx, asset)
+				if err != nil {
+					log.WarnWithContext(ctx, "fail to render snapshots", zap.String("indexID", asset.IndexID), zap.Error(err))
+
+					reason, failed := snapshotFailureReason(err, asset.SnapshotAttempts, s.snapshot.MaxAttempts)
+					if !failed {
+						// rendered again after the retry interval
+						continue
+					}
+
+					if err := s.markAssetSnapshotFailed(ctx, asset.IndexID, reason); err != nil {
+						log.WarnWithContext(ctx, "add snapshot failure was failed", zap.String("indexID", asset.IndexID), zap.Error(err))
+					}
+					continue
+				}
+
+				log.InfoWithContext(ctx, "snapshots uploaded",
+					zap.Duration("duration", time.Since(renderStartTime)),
+					zap.String("indexID", asset.IndexID))
+
+				if err := s.updateAssetSnapshots(ctx, snapshot); err != nil {
+					log.WarnWithContext(ctx, "fail to update asset snapshots back to indexer", zap.Error(err))
+					continue
+				}
+			}
+			log.InfoWithContext(ctx, "SnapshotWorker stopped")
+		}()
+	}
+}
+
+// updateAssetSnapshots sets the static previews of an asset to its snapshots
+func (s *NFTContentIndexer) updateAssetSnapshots(ctx context.Context, snapshot imageStore.SnapshotMetadata) error {
+	indexID := snapshot.AssetID
+	idSegments := strings.SplitN(indexID, "-", 2)
+	if len(idSegments) != 2 {
+		log.WarnWithContext(ctx, "invalid asset index id",
+			zap.String("indexID", indexID),
+			zap.Int("segments", len(idSegments)))
+		return errors.New("invalid asset index id")
+	}
+
+	if err := s.updateAssetStaticPreview(ctx, idSegments[1],
+		s.imageURL(snapshot.LandscapeImageID, imageStore.ImageVariantFull),
+		s.imageURL(snapshot.PortraitImageID, imageStore.ImageVariantFull),
+		true); err != nil {
+		return err
+	}
+
+	_, err := s.nftAssets.UpdateOne(
+		ctx,
+		bson.M{"indexID": indexID},
+		bson.D{{Key: "$set", Value: bson.D{
+			{Key: "snapshotRenderedAt", Value: time.Now()},
+			{Key: "lastRefreshedTime", Value: time.Now()},
+		}}},
+	)
+
+	return err
+}
+
+// markAssetSnapshotFailed sets the snapshot failure for a specific asset
+func (s *NFTContentIndexer) markAssetSnapshotFailed(ctx context.Context, indexID, reason string) error {
+	_, err := s.nftAssets.UpdateOne(
+		ctx,
+		bson.M{"indexID": indexID},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "snapshotFailedReason", Value: reason}}}},
+	)
+
+	return err
+}
+
+func (s *NFTContentIndexer) renderSnapshots(ctx context.Context) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		assetChan := make(chan snapshotAsset)
+		defer close(assetChan)
+
+		s.spawnSnapshotWorker(ctx, assetChan, s.snapshot.Workers)
+
+		log.InfoWithContext(ctx, "start the loop to render snapshots of software assets",
+			zap.Float64("rateLimit", s.snapshot.RateLimit),
+			zap.Int("maxAttempts", s.snapshot.MaxAttempts),
+			zap.Duration("retryInterval", s.snapshot.RetryInterval))
+
+	WATCH_ASSETS:
+		for {
+			asset, err := s.getAssetWithoutSnapshot(ctx)
+			if err != nil {
+				if errors.Is(err, mongo.ErrNoDocuments) {
+					log.InfoWithContext(ctx, "no asset need to be rendered")
+				} else {
+					log.WarnWithContext(ctx, "fail to get asset that has no snapshot rendered", zap.Error(err))
+				}
+
+				if done := indexer.SleepWithContext(ctx, 15*time.Second); done {
+					break WATCH_ASSETS
+				}
+				continue
+			}
+
+			select {
+			case assetChan <- asset:
+			case <-ctx.Done():
+				break WATCH_ASSETS
+			}
+		}
+		log.InfoWithContext(ctx, "snapshot renderer closed")
+	}()
+}
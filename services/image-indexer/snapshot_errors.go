@@ -0,0 +1,88 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	imageStore "github.com/feral-file/ff-indexer/services/image-indexer/store"
+)
+
+// Reason keys for snapshot rendering errors
+const (
+	ReasonSnapshotUnsupportedURL   = "ErrSnapshotUnsupportedURL"
+	ReasonSnapshotNavigationFailed = "ErrSnapshotNavigationFailed"
+	ReasonSnapshotTimeout          = "ErrSnapshotTimeout"
+	ReasonSnapshotSignalTimeout    = "ErrSnapshotSignalTimeout"
+	ReasonSnapshotBlankImage       = "ErrSnapshotBlankImage"
+	ReasonSnapshotUploadFailed     = "ErrSnapshotUploadFailed"
+	ReasonSnapshotFailed           = "ErrSnapshotFailed"
+)
+
+var SnapshotRenderingErrorReasons = map[string]string{
+	ReasonSnapshotUnsupportedURL:   "unsupported artwork url",
+	ReasonSnapshotNavigationFailed: "fail to load artwork",
+	ReasonSnapshotTimeout:          "artwork rendering timeout",
+	ReasonSnapshotSignalTimeout:    "capture signal is not sent",
+	ReasonSnapshotBlankImage:       "blank snapshot",
+	ReasonSnapshotUploadFailed:     "fail to upload snapshot",
+	ReasonSnapshotFailed:           "fail to render snapshot",
+}
+
+// retryableSnapshotReasons are the reasons of the failures which may not happen on another attempt
+var retryableSnapshotReasons = map[string]bool{
+	ReasonSnapshotNavigationFailed: true,
+	ReasonSnapshotTimeout:          true,
+	ReasonSnapshotSignalTimeout:    true,
+	ReasonSnapshotBlankImage:       true,
+	ReasonSnapshotUploadFailed:     true,
+}
+
+type SnapshotRenderingError struct {
+	reason string
+}
+
+func (e *SnapshotRenderingError) Reason() string {
+	return e.reason
+}
+
+func (e *SnapshotRenderingError) Error() string {
+	return fmt.Sprintf("known snapshot rendering error: %s", SnapshotRenderingErrorReasons[e.Reason()])
+}
+
+// Retryable returns whether a snapshot is worth rendering again after the error
+func (e *SnapshotRenderingError) Retryable() bool {
+	return retryableSnapshotReasons[e.reason]
+}
+
+// NewSnapshotRenderingError returns SnapshotRenderingError if a reason is given.
+// Otherwise, it returns a regular error.
+func NewSnapshotRenderingError(reason string) error {
+	if _, ok := SnapshotRenderingErrorReasons[reason]; !ok {
+		return errors.New(reason)
+	}
+
+	return &SnapshotRenderingError{
+		reason: reason,
+	}
+}
+
+// snapshotFailureReason returns the failure reason of an asset which snapshots are failed to render
+// and whether the asset is marked failed. The retryable and the unknown errors are rendered again
+// until the asset runs out of its attempts.
+func snapshotFailureReason(err error, attempts, maxAttempts int) (string, bool) {
+	var rerr *SnapshotRenderingError
+	var uerr imageStore.UnsupportedImageCachingError
+	switch {
+	case errors.As(err, &rerr):
+		if rerr.Retryable() && attempts < maxAttempts {
+			return "", false
+		}
+		return rerr.Reason(), true
+	case errors.As(err, &uerr):
+		return uerr.Reason(), true
+	case attempts < maxAttempts:
+		return "", false
+	default:
+		return ReasonSnapshotFailed, true
+	}
+}
@@ -0,0 +1,74 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	ImageBackendCloudflare = "cloudflare"
+	ImageBackendS3         = "s3"
+	ImageBackendFilesystem = "filesystem"
+)
+
+var ErrImageNotFound = errors.New("image not found")
+
+// ImageBackend stores the images which are cached by the image indexer
+type ImageBackend interface {
+	// ImageExists returns whether an image exists in the backend
+	ImageExists(ctx context.Context, imageID string) (bool, error)
+	// UploadImage stores a decoded image and returns the image id of it
+	UploadImage(ctx context.Context, name string, img image.Image, metadata Metadata) (string, error)
+	// DeleteImage removes an image and all its variants
+	DeleteImage(ctx context.Context, imageID string) error
+}
+
+// PreviewClipBackend is an image backend which also stores the preview clips of the videos.
+// A preview clip is stored as the PreviewClipVariant of its own id and is removed by DeleteImage.
+type PreviewClipBackend interface {
+	// PreviewClipExists returns whether a preview clip exists in the backend
+	PreviewClipExists(ctx context.Context, clipID string) (bool, error)
+	// UploadPreviewClip stores an MP4 preview clip and returns the clip id of it
+	UploadPreviewClip(ctx context.Context, name string, clip []byte, metadata Metadata) (string, error)
+}
+
+// ImageVariantReader is an image backend which serves the variants of the images by itself
+type ImageVariantReader interface {
+	// GetImageVariant returns the content and the content type of a variant of an image.
+	// It returns ErrImageNotFound if the image or the variant does not exist.
+	GetImageVariant(ctx context.Context, imageID, variant string) (io.ReadCloser, string, error)
+}
+
+// ImageURLResolver is an image backend which serves the variants of the images from its own URLs
+type ImageURLResolver interface {
+	ImageURL(imageID, variant string) string
+}
+
+// NewImageBackend returns the image backend of `image_backend.type`. The default backend is Cloudflare Images.
+func NewImageBackend() (ImageBackend, error) {
+	switch backend := viper.GetString("image_backend.type"); backend {
+	case "", ImageBackendCloudflare:
+		return NewCloudflareBackend(
+			viper.GetString("cloudflare.account_hash"),
+			viper.GetString("cloudflare.account_id"),
+			viper.GetString("cloudflare.api_token"))
+	case ImageBackendS3:
+		return NewS3Backend(S3BackendConfig{
+			Endpoint:        viper.GetString("image_backend.s3.endpoint"),
+			Region:          viper.GetString("image_backend.s3.region"),
+			Bucket:          viper.GetString("image_backend.s3.bucket"),
+			Prefix:          viper.GetString("image_backend.s3.prefix"),
+			AccessKeyID:     viper.GetString("image_backend.s3.access_key_id"),
+			SecretAccessKey: viper.GetString("image_backend.s3.secret_access_key"),
+		})
+	case ImageBackendFilesystem:
+		return NewFilesystemBackend(viper.GetString("image_backend.filesystem.dir"))
+	default:
+		return nil, fmt.Errorf("unsupported image backend: %s", backend)
+	}
+}
@@ -0,0 +1,145 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"net/http"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+const CloudflareImageDeliverURL = "https://imagedelivery.net/%s/%s/%s"
+
+var ErrCloudflareAPINotConfigured = errors.New("cloudflare api token is not configured")
+
+// CloudflareBackend stores the images in Cloudflare Images. The variants are
+// generated and served by Cloudflare.
+type CloudflareBackend struct {
+	accountHash string
+	accountID   *cloudflare.ResourceContainer
+	api         *cloudflare.API
+}
+
+// NewCloudflareBackend returns a Cloudflare Images backend. The API token is only required
+// for uploading and deleting images.
+func NewCloudflareBackend(accountHash, accountID, apiToken string) (*CloudflareBackend, error) {
+	b := &CloudflareBackend{
+		accountHash: accountHash,
+		accountID: &cloudflare.ResourceContainer{
+			Level:      cloudflare.AccountRouteLevel,
+			Identifier: accountID,
+			Type:       cloudflare.AccountType,
+		},
+	}
+
+	if apiToken != "" {
+		api, err := cloudflare.NewWithAPIToken(apiToken,
+			cloudflare.Debug(viper.GetBool("debug")), cloudflare.UsingLogger(log.CloudflareLogger()))
+		if err != nil {
+			return nil, err
+		}
+		b.api = api
+	}
+
+	return b, nil
+}
+
+// ImageURL returns the delivery URL of a variant of an image
+func (b *CloudflareBackend) ImageURL(imageID, variant string) string {
+	return fmt.Sprintf(CloudflareImageDeliverURL, b.accountHash, imageID, variant)
+}
+
+// ImageExists checks if a given image id is presence in cloudflare
+func (b *CloudflareBackend) ImageExists(_ context.Context, imageID string) (bool, error) {
+	// FIXME: not use default client
+	resp, err := http.Head(b.ImageURL(imageID, "public"))
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	}
+
+	return false, fmt.Errorf("incorrect http status(%d) on checking image existence", resp.StatusCode)
+}
+
+// UploadImage uploads an image to cloudflare. The image is encoded within the size limit of cloudflare.
+func (b *CloudflareBackend) UploadImage(ctx context.Context, name string, img image.Image, metadata Metadata) (string, error) {
+	if b.api == nil {
+		return "", ErrCloudflareAPINotConfigured
+	}
+
+	encoded, err := EncodeImageWithinSize(img, ImageSizeThreshold)
+	if err != nil {
+		return "", err
+	}
+
+	uploadRequest := cloudflare.UploadImageParams{
+		File:     io.NopCloser(bytes.NewReader(encoded.Data)),
+		Name:     name,
+		Metadata: metadata,
+	}
+
+	log.Debug("upload image to cloudflare", zap.String("name", name))
+
+	i, err := b.api.UploadImage(ctx, b.accountID, uploadRequest)
+	if err != nil {
+		var ratelimitError *cloudflare.RatelimitError
+		if errors.As(err, &ratelimitError) {
+			log.Debug("caught cloudflare ratelimit error", zap.String("type", string(ratelimitError.Type())))
+			return "", err
+		}
+
+		var requestError *cloudflare.RequestError
+		if errors.As(err, &requestError) {
+			log.Debug("caught cloudflare request error", zap.String("type", string(requestError.Type())),
+				zap.Any("codes", requestError.ErrorCodes()), zap.Any("msg", requestError.ErrorMessages()))
+			for _, code := range requestError.ErrorCodes() {
+				switch code {
+				case 5455: // Unsupported content type
+					return "", NewImageCachingError(ReasonUnsupportedImageType)
+				case 9422:
+					return "", NewImageCachingError(ReasonBrokenImage)
+				case 5443: // The image is too large
+					return "", NewImageCachingError(ReasonFileSizeTooLarge)
+				default:
+					return "", err
+				}
+			}
+			return "", err
+		}
+
+		var serviceError *cloudflare.ServiceError
+		if errors.As(err, &serviceError) {
+			log.Debug("caught cloudflare service error", zap.Any("codes", serviceError.ErrorCodes()), zap.Any("msg", serviceError.ErrorMessages()))
+			return "", err
+		}
+
+		return "", err
+	}
+
+	return i.ID, nil
+}
+
+// DeleteImage deletes an image from cloudflare
+func (b *CloudflareBackend) DeleteImage(ctx context.Context, imageID string) error {
+	if b.api == nil {
+		return ErrCloudflareAPINotConfigured
+	}
+
+	return b.api.DeleteImage(ctx, b.accountID, imageID)
+}
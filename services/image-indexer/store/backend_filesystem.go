@@ -0,0 +1,142 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"image"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+)
+
+// FilesystemBackend stores the variants of the images in a local directory as `<dir>/<imageID>/<variant>`
+type FilesystemBackend struct {
+	dir string
+}
+
+func NewFilesystemBackend(dir string) (*FilesystemBackend, error) {
+	if dir == "" {
+		return nil, errors.New("image directory is required")
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &FilesystemBackend{dir: dir}, nil
+}
+
+// imageDir returns the directory of an image. The image ids are uuids which prevents
+// the paths from escaping the directory of the backend.
+func (b *FilesystemBackend) imageDir(imageID string) (string, bool) {
+	if _, err := uuid.Parse(imageID); err != nil {
+		return "", false
+	}
+	return filepath.Join(b.dir, imageID), true
+}
+
+func (b *FilesystemBackend) ImageExists(_ context.Context, imageID string) (bool, error) {
+	return b.variantExists(imageID, ImageVariantFull)
+}
+
+// PreviewClipExists returns whether the clip variant of a preview clip exists
+func (b *FilesystemBackend) PreviewClipExists(_ context.Context, clipID string) (bool, error) {
+	return b.variantExists(clipID, PreviewClipVariant)
+}
+
+func (b *FilesystemBackend) variantExists(imageID, variant string) (bool, error) {
+	dir, ok := b.imageDir(imageID)
+	if !ok {
+		return false, nil
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, variant)); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// UploadImage writes the variants of an image. The metadata is not kept by the filesystem backend.
+func (b *FilesystemBackend) UploadImage(_ context.Context, _ string, img image.Image, _ Metadata) (string, error) {
+	variants, err := EncodeImageVariants(img)
+	if err != nil {
+		return "", err
+	}
+
+	imageID := uuid.New().String()
+	dir := filepath.Join(b.dir, imageID)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	for _, v := range variants {
+		if err := os.WriteFile(filepath.Join(dir, v.Name), v.Data, 0o644); err != nil {
+			_ = os.RemoveAll(dir)
+			return "", err
+		}
+	}
+
+	return imageID, nil
+}
+
+// UploadPreviewClip writes a preview clip. The metadata is not kept by the filesystem backend.
+func (b *FilesystemBackend) UploadPreviewClip(_ context.Context, _ string, clip []byte, _ Metadata) (string, error) {
+	clipID := uuid.New().String()
+	dir := filepath.Join(b.dir, clipID)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, PreviewClipVariant), clip, 0o644); err != nil {
+		_ = os.RemoveAll(dir)
+		return "", err
+	}
+
+	return clipID, nil
+}
+
+func (b *FilesystemBackend) DeleteImage(_ context.Context, imageID string) error {
+	dir, ok := b.imageDir(imageID)
+	if !ok {
+		return nil
+	}
+
+	return os.RemoveAll(dir)
+}
+
+func (b *FilesystemBackend) GetImageVariant(_ context.Context, imageID, variant string) (io.ReadCloser, string, error) {
+	dir, ok := b.imageDir(imageID)
+	if !ok || !isStoredVariant(variant) {
+		return nil, "", ErrImageNotFound
+	}
+
+	f, err := os.Open(filepath.Join(dir, variant))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, "", ErrImageNotFound
+		}
+		return nil, "", err
+	}
+
+	// the variants are either JPEG, PNG or MP4 which can be told from their headers
+	header := make([]byte, 512)
+	n, err := io.ReadFull(f, header)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		_ = f.Close()
+		return nil, "", err
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		_ = f.Close()
+		return nil, "", err
+	}
+
+	return f, http.DetectContentType(header[:n]), nil
+}
@@ -0,0 +1,53 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilesystemBackend(t *testing.T) {
+	ctx := context.Background()
+
+	backend, err := NewFilesystemBackend(t.TempDir())
+	assert.NoError(t, err)
+
+	img := image.NewRGBA(image.Rect(0, 0, 2000, 1000))
+	for x := 0; x < 2000; x++ {
+		for y := 0; y < 1000; y++ {
+			img.Set(x, y, color.RGBA{R: 255, A: 255})
+		}
+	}
+
+	imageID, err := backend.UploadImage(ctx, "asset", img, nil)
+	assert.NoError(t, err)
+
+	exists, err := backend.ImageExists(ctx, imageID)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	file, contentType, err := backend.GetImageVariant(ctx, imageID, ImageVariantThumbnail)
+	assert.NoError(t, err)
+	assert.Equal(t, "image/jpeg", contentType)
+
+	data, err := io.ReadAll(file)
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+
+	thumbnail, _, err := image.Decode(bytes.NewReader(data))
+	assert.NoError(t, err)
+	assert.Equal(t, image.Pt(320, 160), thumbnail.Bounds().Size())
+
+	_, _, err = backend.GetImageVariant(ctx, "../"+imageID, ImageVariantFull)
+	assert.ErrorIs(t, err, ErrImageNotFound)
+
+	assert.NoError(t, backend.DeleteImage(ctx, imageID))
+	exists, err = backend.ImageExists(ctx, imageID)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
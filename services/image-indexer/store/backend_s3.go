@@ -0,0 +1,189 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/google/uuid"
+)
+
+type S3BackendConfig struct {
+	// Endpoint is the endpoint of an S3 compatible storage like MinIO. It is empty for AWS S3.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	Prefix          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// S3Backend stores the variants of the images in an S3 compatible bucket as `<prefix><imageID>/<variant>`
+type S3Backend struct {
+	client *s3.S3
+	bucket string
+	prefix string
+}
+
+func NewS3Backend(config S3BackendConfig) (*S3Backend, error) {
+	if config.Bucket == "" {
+		return nil, errors.New("s3 bucket is required")
+	}
+
+	awsConfig := aws.Config{
+		Region: aws.String(config.Region),
+	}
+
+	if config.Endpoint != "" {
+		awsConfig.Endpoint = aws.String(config.Endpoint)
+		awsConfig.S3ForcePathStyle = aws.Bool(true)
+	}
+
+	if config.AccessKeyID != "" {
+		awsConfig.Credentials = credentials.NewStaticCredentials(config.AccessKeyID, config.SecretAccessKey, "")
+	}
+
+	sess, err := session.NewSession(&awsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &S3Backend{
+		client: s3.New(sess),
+		bucket: config.Bucket,
+		prefix: config.Prefix,
+	}, nil
+}
+
+func (b *S3Backend) key(imageID, variant string) string {
+	return fmt.Sprintf("%s%s/%s", b.prefix, imageID, variant)
+}
+
+// isS3NotFound returns whether an error is caused by a missing object
+func isS3NotFound(err error) bool {
+	var aerr awserr.Error
+	if errors.As(err, &aerr) {
+		switch aerr.Code() {
+		case s3.ErrCodeNoSuchKey, "NotFound":
+			return true
+		}
+	}
+	return false
+}
+
+// s3Metadata converts the metadata of an image to the user-defined metadata of the objects
+func s3Metadata(metadata Metadata) map[string]*string {
+	objectMetadata := make(map[string]*string, len(metadata))
+	for k, v := range metadata {
+		objectMetadata[k] = aws.String(fmt.Sprint(v))
+	}
+	return objectMetadata
+}
+
+func (b *S3Backend) ImageExists(ctx context.Context, imageID string) (bool, error) {
+	return b.variantExists(ctx, imageID, ImageVariantFull)
+}
+
+// PreviewClipExists returns whether the clip variant of a preview clip exists
+func (b *S3Backend) PreviewClipExists(ctx context.Context, clipID string) (bool, error) {
+	return b.variantExists(ctx, clipID, PreviewClipVariant)
+}
+
+func (b *S3Backend) variantExists(ctx context.Context, imageID, variant string) (bool, error) {
+	_, err := b.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.key(imageID, variant)),
+	})
+	if err != nil {
+		if isS3NotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// UploadImage puts the variants of an image. The metadata is attached to every variant.
+func (b *S3Backend) UploadImage(ctx context.Context, _ string, img image.Image, metadata Metadata) (string, error) {
+	variants, err := EncodeImageVariants(img)
+	if err != nil {
+		return "", err
+	}
+
+	objectMetadata := s3Metadata(metadata)
+	imageID := uuid.New().String()
+	for _, v := range variants {
+		if _, err := b.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+			Bucket:      aws.String(b.bucket),
+			Key:         aws.String(b.key(imageID, v.Name)),
+			Body:        bytes.NewReader(v.Data),
+			ContentType: aws.String(v.ContentType),
+			Metadata:    objectMetadata,
+		}); err != nil {
+			_ = b.DeleteImage(ctx, imageID)
+			return "", err
+		}
+	}
+
+	return imageID, nil
+}
+
+// UploadPreviewClip puts a preview clip with the metadata
+func (b *S3Backend) UploadPreviewClip(ctx context.Context, _ string, clip []byte, metadata Metadata) (string, error) {
+	objectMetadata := s3Metadata(metadata)
+	clipID := uuid.New().String()
+	if _, err := b.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(b.bucket),
+		Key:         aws.String(b.key(clipID, PreviewClipVariant)),
+		Body:        bytes.NewReader(clip),
+		ContentType: aws.String("video/mp4"),
+		Metadata:    objectMetadata,
+	}); err != nil {
+		return "", err
+	}
+
+	return clipID, nil
+}
+
+// DeleteImage removes the variants of an image or a preview clip
+func (b *S3Backend) DeleteImage(ctx context.Context, imageID string) error {
+	objects := make([]*s3.ObjectIdentifier, 0, len(ImageVariants)+1)
+	for _, v := range ImageVariants {
+		objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(b.key(imageID, v.Name))})
+	}
+	objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(b.key(imageID, PreviewClipVariant))})
+
+	_, err := b.client.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+		Bucket: aws.String(b.bucket),
+		Delete: &s3.Delete{Objects: objects, Quiet: aws.Bool(true)},
+	})
+	return err
+}
+
+func (b *S3Backend) GetImageVariant(ctx context.Context, imageID, variant string) (io.ReadCloser, string, error) {
+	if !isStoredVariant(variant) {
+		return nil, "", ErrImageNotFound
+	}
+
+	o, err := b.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(b.key(imageID, variant)),
+	})
+	if err != nil {
+		if isS3NotFound(err) {
+			return nil, "", ErrImageNotFound
+		}
+		return nil, "", err
+	}
+
+	return o.Body, aws.StringValue(o.ContentType), nil
+}
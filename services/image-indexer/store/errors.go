@@ -13,6 +13,8 @@ const (
 	ReasonDownloadFileFailed          = "ErrDownloadFileFailed"
 	ReasonFileSizeTooLarge            = "ErrSizeTooLarge"
 	ReasonUnknownCloudflareAPIFailure = "ErrUnknownCloudflareAPIFailure"
+	ReasonBrokenVideo                 = "ErrBrokenVideo"
+	ReasonUnsupportedVideoType        = "ErrUnsupportedVideoType"
 )
 
 var ImageCachingErrorReasons = map[string]string{ // string string
@@ -22,6 +24,8 @@ var ImageCachingErrorReasons = map[string]string{ // string string
 	ReasonDownloadFileFailed:          "download file error",
 	ReasonFileSizeTooLarge:            "size too large",
 	ReasonUnknownCloudflareAPIFailure: "unknown cloudflare api error",
+	ReasonBrokenVideo:                 "broken video",
+	ReasonUnsupportedVideoType:        "unsupported video type",
 }
 
 type UnsupportedImageCachingError interface {
@@ -0,0 +1,316 @@
+package store
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif" // register the gif decoder
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+	"sort"
+
+	_ "golang.org/x/image/bmp" // register the bmp decoder
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/tiff" // register the tiff decoder
+	_ "golang.org/x/image/webp" // register the webp decoder
+)
+
+const (
+	// analysisImageSize is the size of the image which the placeholders are computed from
+	analysisImageSize = 64
+
+	blurhashXComponents = 4
+	blurhashYComponents = 3
+
+	dominantColorCount = 5
+
+	// minEncodingImageSize is the smallest size an image is scaled down to for fitting a byte size
+	minEncodingImageSize = 16
+)
+
+// jpegQualitySteps are the JPEG qualities which are tried in order to fit a byte size
+var jpegQualitySteps = []int{85, 75, 65, 55, 45}
+
+// ImageAnalysis is the dimensions and the placeholders of an image
+type ImageAnalysis struct {
+	Width          int
+	Height         int
+	Blurhash       string
+	DominantColors []string
+}
+
+// EncodedImage is an image encoded in JPEG or PNG
+type EncodedImage struct {
+	ContentType string
+	Data        []byte
+}
+
+// DecodeImage decodes a PNG, JPEG, GIF, WebP, BMP or TIFF image. Only the first frame of
+// an animated image is kept. The dimensions are read from the header first, so that an image
+// larger than MaxImagePixels is rejected before it is decoded.
+func DecodeImage(file io.Reader) (image.Image, error) {
+	var header bytes.Buffer
+	config, _, err := image.DecodeConfig(io.TeeReader(file, &header))
+	if err != nil {
+		if errors.Is(err, image.ErrFormat) {
+			return nil, NewImageCachingError(ReasonUnsupportedImageType)
+		}
+		return nil, NewImageCachingError(ReasonBrokenImage)
+	}
+
+	if err := checkImagePixels(config.Width, config.Height); err != nil {
+		return nil, err
+	}
+
+	img, _, err := image.Decode(io.MultiReader(&header, file))
+	if err != nil {
+		if errors.Is(err, image.ErrFormat) {
+			return nil, NewImageCachingError(ReasonUnsupportedImageType)
+		}
+		return nil, NewImageCachingError(ReasonBrokenImage)
+	}
+
+	return img, nil
+}
+
+// AnalyzeImage returns the dimensions, the blurhash and the dominant colors of an image
+func AnalyzeImage(img image.Image) ImageAnalysis {
+	b := img.Bounds()
+	small := resizeImage(img, analysisImageSize)
+
+	return ImageAnalysis{
+		Width:          b.Dx(),
+		Height:         b.Dy(),
+		Blurhash:       encodeBlurhash(small, blurhashXComponents, blurhashYComponents),
+		DominantColors: dominantColors(small, dominantColorCount),
+	}
+}
+
+// EncodeImageWithinSize encodes an image in at most maxBytes. The JPEG quality is stepped down
+// before the image is scaled down. Images with transparency are encoded in PNG and only scaled down.
+func EncodeImageWithinSize(img image.Image, maxBytes int) (EncodedImage, error) {
+	for {
+		var buf bytes.Buffer
+
+		if isOpaque(img) {
+			for _, quality := range jpegQualitySteps {
+				buf.Reset()
+				if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality}); err != nil {
+					return EncodedImage{}, err
+				}
+
+				if buf.Len() <= maxBytes {
+					return EncodedImage{ContentType: "image/jpeg", Data: buf.Bytes()}, nil
+				}
+			}
+		} else {
+			if err := png.Encode(&buf, img); err != nil {
+				return EncodedImage{}, err
+			}
+
+			if buf.Len() <= maxBytes {
+				return EncodedImage{ContentType: "image/png", Data: buf.Bytes()}, nil
+			}
+		}
+
+		b := img.Bounds()
+		size := max(b.Dx(), b.Dy()) * 3 / 4
+		if size < minEncodingImageSize {
+			return EncodedImage{}, NewImageCachingError(ReasonFileSizeTooLarge)
+		}
+		img = resizeImage(img, size)
+	}
+}
+
+func isOpaque(img image.Image) bool {
+	o, ok := img.(interface{ Opaque() bool })
+	return ok && o.Opaque()
+}
+
+// resizeImage scales down an image to fit a square of the max size
+func resizeImage(img image.Image, maxSize int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= maxSize && h <= maxSize {
+		return img
+	}
+
+	if w >= h {
+		h = max(1, h*maxSize/w)
+		w = maxSize
+	} else {
+		w = max(1, w*maxSize/h)
+		h = maxSize
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, b, draw.Src, nil)
+	return dst
+}
+
+// dominantColors returns the hex colors of the most common color buckets of an image. The
+// pixels are bucketed by the 4 high bits of each channel and a bucket color is the average
+// of its pixels. The mostly transparent pixels are ignored.
+func dominantColors(img image.Image, count int) []string {
+	type bucket struct {
+		r, g, b, n int
+	}
+
+	buckets := map[int]*bucket{}
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, g, b, a := img.At(x, y).RGBA()
+			if a < 0x8000 {
+				continue
+			}
+
+			// un-premultiply the colors to 8 bits
+			r8, g8, b8 := int(r*0xff/a), int(g*0xff/a), int(b*0xff/a)
+			key := (r8>>4)<<8 | (g8>>4)<<4 | b8>>4
+			if buckets[key] == nil {
+				buckets[key] = &bucket{}
+			}
+			buckets[key].r += r8
+			buckets[key].g += g8
+			buckets[key].b += b8
+			buckets[key].n++
+		}
+	}
+
+	keys := make([]int, 0, len(buckets))
+	for k := range buckets {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if buckets[keys[i]].n != buckets[keys[j]].n {
+			return buckets[keys[i]].n > buckets[keys[j]].n
+		}
+		return keys[i] < keys[j]
+	})
+
+	colors := make([]string, 0, count)
+	for _, k := range keys {
+		if len(colors) == count {
+			break
+		}
+		b := buckets[k]
+		colors = append(colors, fmt.Sprintf("#%02x%02x%02x", b.r/b.n, b.g/b.n, b.b/b.n))
+	}
+
+	return colors
+}
+
+const base83Characters = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+// encodeBlurhash returns the blurhash (https://blurha.sh) of an image with the numbers of components
+func encodeBlurhash(img image.Image, xComponents, yComponents int) string {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	if w == 0 || h == 0 {
+		return ""
+	}
+
+	// convert the pixels to linear rgb once
+	pixels := make([][3]float64, w*h)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			r, g, b, a := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			if a == 0 {
+				continue
+			}
+			pixels[y*w+x] = [3]float64{
+				srgbToLinear(int(r * 0xff / a)),
+				srgbToLinear(int(g * 0xff / a)),
+				srgbToLinear(int(b * 0xff / a)),
+			}
+		}
+	}
+
+	factors := make([][3]float64, 0, xComponents*yComponents)
+	for j := 0; j < yComponents; j++ {
+		for i := 0; i < xComponents; i++ {
+			normalisation := 2.0
+			if i == 0 && j == 0 {
+				normalisation = 1
+			}
+
+			var f [3]float64
+			for y := 0; y < h; y++ {
+				for x := 0; x < w; x++ {
+					basis := normalisation *
+						math.Cos(math.Pi*float64(i)*float64(x)/float64(w)) *
+						math.Cos(math.Pi*float64(j)*float64(y)/float64(h))
+					p := pixels[y*w+x]
+					f[0] += basis * p[0]
+					f[1] += basis * p[1]
+					f[2] += basis * p[2]
+				}
+			}
+
+			scale := 1 / float64(w*h)
+			factors = append(factors, [3]float64{f[0] * scale, f[1] * scale, f[2] * scale})
+		}
+	}
+
+	dc, ac := factors[0], factors[1:]
+
+	hash := encodeBase83((xComponents-1)+(yComponents-1)*9, 1)
+
+	maximumValue := 1.0
+	if len(ac) > 0 {
+		actualMaximumValue := 0.0
+		for _, f := range ac {
+			actualMaximumValue = math.Max(actualMaximumValue, math.Max(math.Abs(f[0]), math.Max(math.Abs(f[1]), math.Abs(f[2]))))
+		}
+
+		quantisedMaximumValue := int(math.Max(0, math.Min(82, math.Floor(actualMaximumValue*166-0.5))))
+		maximumValue = float64(quantisedMaximumValue+1) / 166
+		hash += encodeBase83(quantisedMaximumValue, 1)
+	} else {
+		hash += encodeBase83(0, 1)
+	}
+
+	hash += encodeBase83(linearToSRGB(dc[0])<<16|linearToSRGB(dc[1])<<8|linearToSRGB(dc[2]), 4)
+
+	for _, f := range ac {
+		quantise := func(v float64) int {
+			return int(math.Max(0, math.Min(18, math.Floor(signPow(v/maximumValue, 0.5)*9+9.5))))
+		}
+		hash += encodeBase83(quantise(f[0])*19*19+quantise(f[1])*19+quantise(f[2]), 2)
+	}
+
+	return hash
+}
+
+func encodeBase83(value, length int) string {
+	result := make([]byte, length)
+	for i := 1; i <= length; i++ {
+		digit := (value / int(math.Pow(83, float64(length-i)))) % 83
+		result[i-1] = base83Characters[digit]
+	}
+	return string(result)
+}
+
+func srgbToLinear(value int) float64 {
+	v := float64(value) / 255
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(value float64) int {
+	v := math.Max(0, math.Min(1, value))
+	if v <= 0.0031308 {
+		return int(v*12.92*255 + 0.5)
+	}
+	return int((1.055*math.Pow(v, 1/2.4)-0.055)*255 + 0.5)
+}
+
+func signPow(v, exp float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(v), exp), v)
+}
@@ -0,0 +1,88 @@
+package store
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeImage(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 2))
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, img))
+
+	decoded, err := DecodeImage(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, image.Pt(4, 2), decoded.Bounds().Size())
+
+	_, err = DecodeImage(bytes.NewBufferString("not an image"))
+	var cachingErr *ImageCachingError
+	assert.ErrorAs(t, err, &cachingErr)
+	assert.Equal(t, ReasonUnsupportedImageType, cachingErr.Reason())
+
+	// the header of a 65535x65535 GIF which is rejected without decoding the pixels
+	_, err = DecodeImage(bytes.NewReader([]byte("GIF89a\xff\xff\xff\xff\x00\x00\x00")))
+	assert.ErrorAs(t, err, &cachingErr)
+	assert.Equal(t, ReasonFileSizeTooLarge, cachingErr.Reason())
+}
+
+func TestAnalyzeImage(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 300, 100))
+	for x := 0; x < 300; x++ {
+		for y := 0; y < 100; y++ {
+			if x < 200 {
+				img.Set(x, y, color.RGBA{R: 255, A: 255})
+			} else {
+				img.Set(x, y, color.RGBA{B: 255, A: 255})
+			}
+		}
+	}
+
+	analysis := AnalyzeImage(img)
+	assert.Equal(t, 300, analysis.Width)
+	assert.Equal(t, 100, analysis.Height)
+	assert.Equal(t, "#ff0000", analysis.DominantColors[0])
+	assert.Contains(t, analysis.DominantColors, "#0000ff")
+	// 4x3 components take 6 + 2 * 11 characters
+	assert.Len(t, analysis.Blurhash, 28)
+}
+
+func TestEncodeBlurhashSolidColor(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	for x := 0; x < 8; x++ {
+		for y := 0; y < 8; y++ {
+			img.Set(x, y, color.RGBA{R: 255, A: 255})
+		}
+	}
+
+	hash := encodeBlurhash(img, 1, 1)
+	assert.Equal(t, "00"+encodeBase83(0xff0000, 4), hash)
+}
+
+func TestEncodeImageWithinSize(t *testing.T) {
+	// noise does not compress which makes the image scaled down
+	r := rand.New(rand.NewSource(1))
+	img := image.NewRGBA(image.Rect(0, 0, 512, 512))
+	for i := range img.Pix {
+		if i%4 == 3 {
+			img.Pix[i] = 0xff
+		} else {
+			img.Pix[i] = uint8(r.Intn(256))
+		}
+	}
+
+	encoded, err := EncodeImageWithinSize(img, 50*1024)
+	assert.NoError(t, err)
+	assert.Equal(t, "image/jpeg", encoded.ContentType)
+	assert.LessOrEqual(t, len(encoded.Data), 50*1024)
+
+	_, err = EncodeImageWithinSize(img, 10)
+	var cachingErr *ImageCachingError
+	assert.ErrorAs(t, err, &cachingErr)
+	assert.Equal(t, ReasonFileSizeTooLarge, cachingErr.Reason())
+}
@@ -0,0 +1,183 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	DefaultThumbnailJobMaxAttempts = 5
+
+	thumbnailJobBaseDelay = time.Minute
+	thumbnailJobMaxDelay  = time.Hour
+)
+
+// ErrThumbnailJobLeaseLost is returned when a job is settled after its lease is expired and
+// taken over by another worker
+var ErrThumbnailJobLeaseLost = errors.New("thumbnail job lease lost")
+
+// ThumbnailJobBackoff returns the delay before the next attempt of a failed job. It doubles
+// the base delay for every failed attempt and is capped by the max delay.
+func ThumbnailJobBackoff(attempts int) time.Duration {
+	delay := thumbnailJobBaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= thumbnailJobMaxDelay {
+			return thumbnailJobMaxDelay
+		}
+	}
+
+	return delay
+}
+
+// EnqueueThumbnailJob adds a thumbnail job or updates the job of the same asset by the rules:
+//   - the type, the image url, the source and the metadata are replaced
+//   - a queued job keeps the higher priority and the earlier run time, and its attempts
+//   - a running job keeps its status, its lease and its attempts, and it takes the higher priority.
+//     It is marked as requeue requested, so that it is queued again when it finishes
+//   - a done or failed job is queued again with the new priority and run time and no attempts
+func (s *ImageStore) EnqueueThumbnailJob(ctx context.Context, job ThumbnailJob) error {
+	job.Status = ThumbnailJobStatusQueued
+	job.Attempts = 0
+	if job.RunAfter.IsZero() {
+		job.RunAfter = time.Now()
+	}
+
+	finished := []ThumbnailJobStatus{ThumbnailJobStatusDone, ThumbnailJobStatusFailed}
+
+	return s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "asset_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"type":      gorm.Expr("EXCLUDED.type"),
+			"image_url": gorm.Expr("EXCLUDED.image_url"),
+			"source":    gorm.Expr("EXCLUDED.source"),
+			"metadata":  gorm.Expr("EXCLUDED.metadata"),
+			"priority": gorm.Expr(`CASE WHEN thumbnail_jobs.status IN ? THEN EXCLUDED.priority
+				ELSE GREATEST(thumbnail_jobs.priority, EXCLUDED.priority) END`, finished),
+			"run_after": gorm.Expr(`CASE WHEN thumbnail_jobs.status IN ? THEN EXCLUDED.run_after
+				ELSE LEAST(thumbnail_jobs.run_after, EXCLUDED.run_after) END`, finished),
+			"attempts": gorm.Expr("CASE WHEN thumbnail_jobs.status IN ? THEN 0 ELSE thumbnail_jobs.attempts END", finished),
+			// a running job is not interrupted and its lease is kept
+			"status": gorm.Expr("CASE WHEN thumbnail_jobs.status = ? THEN thumbnail_jobs.status ELSE ? END",
+				ThumbnailJobStatusRunning, ThumbnailJobStatusQueued),
+			"requeue_requested": gorm.Expr("thumbnail_jobs.status = ?", ThumbnailJobStatusRunning),
+			"updated_at":        gorm.Expr("EXCLUDED.updated_at"),
+		}),
+	}).Create(&job).Error
+}
+
+// LeaseThumbnailJob leases the ready job of the highest priority until the lease expires and
+// counts the attempt. Only the jobs of the types are leased and the jobs of the excluded sources,
+// e.g. the sources which reach their rate limits, are skipped. A job with an expired lease is
+// leased again. It returns gorm.ErrRecordNotFound if no job is ready.
+func (s *ImageStore) LeaseThumbnailJob(ctx context.Context, duration time.Duration, types, excludedSources []string) (ThumbnailJob, error) {
+	var job ThumbnailJob
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := readyThumbnailJobs(tx, now, types, excludedSources).Take(&job).Error; err != nil {
+			return err
+		}
+
+		leaseExpiresAt := now.Add(duration)
+		job.Status = ThumbnailJobStatusRunning
+		job.Attempts++
+		job.LeaseExpiresAt = &leaseExpiresAt
+		job.LeaseToken = uuid.NewString()
+
+		return tx.Model(&ThumbnailJob{}).Where("id = ?", job.ID).Updates(map[string]interface{}{
+			"status":           job.Status,
+			"attempts":         job.Attempts,
+			"lease_expires_at": job.LeaseExpiresAt,
+			"lease_token":      job.LeaseToken,
+			// the attempt processes the latest request of the job
+			"requeue_requested": false,
+			"updated_at":        now,
+		}).Error
+	})
+
+	return job, err
+}
+
+// readyThumbnailJobs returns the query of the ready jobs in the order of a descending priority and
+// an ascending run time. The rows are locked with SKIP LOCKED, so that the concurrent workers
+// lease different jobs without waiting for each other.
+func readyThumbnailJobs(tx *gorm.DB, now time.Time, types, excludedSources []string) *gorm.DB {
+	q := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("(status = ? AND run_after <= ?) OR (status = ? AND lease_expires_at < ?)",
+			ThumbnailJobStatusQueued, now, ThumbnailJobStatusRunning, now).
+		Where("type IN ?", types)
+	if len(excludedSources) > 0 {
+		q = q.Where("source NOT IN ?", excludedSources)
+	}
+
+	return q.Order("priority desc").Order("run_after asc")
+}
+
+// CompleteThumbnailJob marks a leased job as done
+func (s *ImageStore) CompleteThumbnailJob(ctx context.Context, job ThumbnailJob) error {
+	return s.finishThumbnailJob(ctx, job, map[string]interface{}{
+		"status":     ThumbnailJobStatusDone,
+		"last_error": "",
+	})
+}
+
+// RetryThumbnailJob releases a leased job after a failed attempt and queues it again at a run time
+func (s *ImageStore) RetryThumbnailJob(ctx context.Context, job ThumbnailJob, lastError string, runAfter time.Time) error {
+	return s.finishThumbnailJob(ctx, job, map[string]interface{}{
+		"status":     ThumbnailJobStatusQueued,
+		"last_error": lastError,
+		"run_after":  runAfter,
+	})
+}
+
+// FailThumbnailJob marks a leased job as failed which is not attempted again
+func (s *ImageStore) FailThumbnailJob(ctx context.Context, job ThumbnailJob, lastError string) error {
+	return s.finishThumbnailJob(ctx, job, map[string]interface{}{
+		"status":     ThumbnailJobStatusFailed,
+		"last_error": lastError,
+	})
+}
+
+// DeferThumbnailJob releases a leased job which is not attempted, e.g. its source reaches the rate
+// limit, and queues it again at a run time
+func (s *ImageStore) DeferThumbnailJob(ctx context.Context, job ThumbnailJob, runAfter time.Time) error {
+	return s.finishThumbnailJob(ctx, job, map[string]interface{}{
+		"status":    ThumbnailJobStatusQueued,
+		"attempts":  gorm.Expr("GREATEST(attempts - 1, 0)"),
+		"run_after": runAfter,
+	})
+}
+
+// finishThumbnailJob releases the lease of a running job with the updates. A job which is enqueued
+// again during its lease is queued again with no attempts instead of being done or failed, since
+// the attempt may have processed the previous image url. It returns ErrThumbnailJobLeaseLost if the
+// job is leased by another worker.
+func (s *ImageStore) finishThumbnailJob(ctx context.Context, job ThumbnailJob, updates map[string]interface{}) error {
+	if status := updates["status"]; status == ThumbnailJobStatusDone || status == ThumbnailJobStatusFailed {
+		updates["status"] = gorm.Expr("CASE WHEN requeue_requested THEN ? ELSE ? END", ThumbnailJobStatusQueued, status)
+		updates["attempts"] = gorm.Expr("CASE WHEN requeue_requested THEN 0 ELSE attempts END")
+	}
+	updates["requeue_requested"] = false
+	updates["lease_expires_at"] = nil
+	updates["lease_token"] = ""
+	updates["updated_at"] = time.Now()
+
+	result := s.db.WithContext(ctx).Model(&ThumbnailJob{}).
+		Where("id = ? AND status = ? AND lease_token = ?", job.ID, ThumbnailJobStatusRunning, job.LeaseToken).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrThumbnailJobLeaseLost
+	}
+
+	return nil
+}
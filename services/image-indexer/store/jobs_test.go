@@ -0,0 +1,49 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// dryRunStore returns a store which builds the statements without a database and records them
+func dryRunStore(t *testing.T) (*ImageStore, *[]string) {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost dbname=test"}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	assert.NoError(t, err)
+
+	statements := []string{}
+	record := func(tx *gorm.DB) {
+		statements = append(statements, tx.Dialector.Explain(tx.Statement.SQL.String(), tx.Statement.Vars...))
+	}
+	assert.NoError(t, db.Callback().Create().After("gorm:create").Register("test:record", record))
+	assert.NoError(t, db.Callback().Query().After("gorm:query").Register("test:record", record))
+	assert.NoError(t, db.Callback().Update().After("gorm:update").Register("test:record", record))
+
+	return &ImageStore{db: db}, &statements
+}
+
+func TestThumbnailJobBackoff(t *testing.T) {
+	assert.Equal(t, time.Minute, ThumbnailJobBackoff(0))
+	assert.Equal(t, time.Minute, ThumbnailJobBackoff(1))
+	assert.Equal(t, 4*time.Minute, ThumbnailJobBackoff(3))
+	assert.Equal(t, time.Hour, ThumbnailJobBackoff(20))
+}
+
+func TestFinishThumbnailJobLeaseLost(t *testing.T) {
+	s, statements := dryRunStore(t)
+
+	// no rows are updated in a dry run, as the lease of the job is taken over
+	err := s.CompleteThumbnailJob(context.Background(), ThumbnailJob{ID: 1, LeaseToken: "token"})
+	assert.ErrorIs(t, err, ErrThumbnailJobLeaseLost)
+	assert.Len(t, *statements, 1)
+	assert.Contains(t, (*statements)[0], `WHERE id = 1 AND status = 'running' AND lease_token = 'token'`)
+	assert.Contains(t, (*statements)[0], `"lease_token"=''`)
+}
@@ -6,6 +6,16 @@ type ImageMetadata struct {
 	AssetID string `json:"assetID" gorm:"index:image_asset_id,unique"`
 	ImageID string `json:"fileID"`
 
+	// the dimensions and the placeholders of the source image
+	Width          int      `json:"width"`
+	Height         int      `json:"height"`
+	Blurhash       string   `json:"blurhash"`
+	DominantColors []string `json:"dominantColors" gorm:"serializer:json"`
+
+	// the preview clip and the duration of a source video
+	PreviewClipID   string  `json:"previewClipID"`
+	DurationSeconds float64 `json:"durationSeconds"`
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -13,3 +23,80 @@ type ImageMetadata struct {
 func (p ImageMetadata) TableName() string {
 	return "image_metadata"
 }
+
+// AspectRatio returns the width to height ratio of the image
+func (p ImageMetadata) AspectRatio() float64 {
+	if p.Height == 0 {
+		return 0
+	}
+	return float64(p.Width) / float64(p.Height)
+}
+
+// SnapshotMetadata is the landscape and the portrait snapshots of a software artwork
+type SnapshotMetadata struct {
+	AssetID          string `json:"assetID" gorm:"index:snapshot_asset_id,unique"`
+	LandscapeImageID string `json:"landscapeImageID"`
+	PortraitImageID  string `json:"portraitImageID"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (p SnapshotMetadata) TableName() string {
+	return "snapshot_metadata"
+}
+
+type ThumbnailJobPriority int
+
+const (
+	ThumbnailJobPriorityLow    ThumbnailJobPriority = 0
+	ThumbnailJobPriorityNormal ThumbnailJobPriority = 10
+	ThumbnailJobPriorityHigh   ThumbnailJobPriority = 20
+)
+
+// ThumbnailJobPriorities are the names of the thumbnail job priorities
+var ThumbnailJobPriorities = map[string]ThumbnailJobPriority{
+	"low":    ThumbnailJobPriorityLow,
+	"normal": ThumbnailJobPriorityNormal,
+	"high":   ThumbnailJobPriorityHigh,
+}
+
+type ThumbnailJobStatus string
+
+const (
+	ThumbnailJobStatusQueued  ThumbnailJobStatus = "queued"
+	ThumbnailJobStatusRunning ThumbnailJobStatus = "running"
+	ThumbnailJobStatusDone    ThumbnailJobStatus = "done"
+	ThumbnailJobStatusFailed  ThumbnailJobStatus = "failed"
+)
+
+// ThumbnailJob is a queued thumbnail generation of an asset or a collection. There is
+// at most one job of an asset which is queued again when the thumbnail is requested again.
+type ThumbnailJob struct {
+	ID       uint64               `json:"id" gorm:"primaryKey"`
+	AssetID  string               `json:"assetID" gorm:"index:thumbnail_job_asset_id,unique"`
+	Type     string               `json:"type"`
+	ImageURL string               `json:"imageURL"`
+	Source   string               `json:"source"`
+	Metadata Metadata             `json:"metadata" gorm:"serializer:json"`
+	Priority ThumbnailJobPriority `json:"priority" gorm:"index:thumbnail_job_lease,priority:2"`
+	Status   ThumbnailJobStatus   `json:"status" gorm:"index:thumbnail_job_lease,priority:1"`
+
+	Attempts       int        `json:"attempts"`
+	LastError      string     `json:"lastError"`
+	RunAfter       time.Time  `json:"runAfter" gorm:"index:thumbnail_job_lease,priority:3"`
+	LeaseExpiresAt *time.Time `json:"leaseExpiresAt"`
+	// LeaseToken identifies the lease of a running job, so that a worker which lease is expired
+	// and taken over can not settle the job
+	LeaseToken string `json:"-"`
+	// RequeueRequested is set when a running job is enqueued again, so that it is queued again
+	// instead of finished when its lease is released
+	RequeueRequested bool `json:"-" gorm:"not null;default:false"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (p ThumbnailJob) TableName() string {
+	return "thumbnail_jobs"
+}
@@ -0,0 +1,105 @@
+package store
+
+import (
+	"context"
+	"image"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// UploadSnapshots creates a db transaction to upload the landscape and the portrait snapshots of
+// an asset to the image backend. The previous snapshots are removed before the new ones are uploaded.
+// It locks a snapshot record for updating which prevents from duplicated rendering process.
+func (s *ImageStore) UploadSnapshots(ctx context.Context, assetID string, landscape, portrait image.Image, metadata Metadata) (SnapshotMetadata, error) {
+	var snapshot SnapshotMetadata
+	var uploadedIDs []string
+
+	if err := s.db.WithContext(ctx).FirstOrCreate(&snapshot, SnapshotMetadata{
+		AssetID: assetID,
+	}).Error; err != nil {
+		return snapshot, err
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{
+			Strength: "UPDATE",
+			Options:  "NOWAIT",
+		}).Where("asset_id = ?", assetID).First(&snapshot).Error; err != nil {
+			// not found, locked or any other errors
+			return err
+		}
+
+		for _, imageID := range []string{snapshot.LandscapeImageID, snapshot.PortraitImageID} {
+			if imageID == "" {
+				continue
+			}
+
+			imageExisted, err := s.backend.ImageExists(ctx, imageID)
+			if err != nil {
+				return err
+			}
+			if imageExisted {
+				if err := s.backend.DeleteImage(ctx, imageID); err != nil {
+					log.WarnWithContext(ctx,
+						"fail to delete snapshot cache",
+						zap.String("assetID", assetID), zap.Error(err))
+					return err
+				}
+			}
+		}
+
+		landscapeImageID, err := s.backend.UploadImage(ctx, assetID, landscape, metadata)
+		if err != nil {
+			return err
+		}
+		uploadedIDs = append(uploadedIDs, landscapeImageID)
+
+		portraitImageID, err := s.backend.UploadImage(ctx, assetID, portrait, metadata)
+		if err != nil {
+			return err
+		}
+		uploadedIDs = append(uploadedIDs, portraitImageID)
+
+		snapshot.LandscapeImageID = landscapeImageID
+		snapshot.PortraitImageID = portraitImageID
+
+		return tx.Where("asset_id = ?", assetID).Save(&snapshot).Error
+	})
+
+	// Clean up uploaded files when a transaction is failed.
+	if err != nil {
+		for _, id := range uploadedIDs {
+			log.WarnWithContext(ctx, "clean uploaded snapshot due to rollback", zap.String("assetID", assetID))
+			if err := s.backend.DeleteImage(ctx, id); err != nil {
+				log.WarnWithContext(ctx, "fail to clean uploaded snapshot", zap.String("imageID", id))
+			}
+		}
+	}
+
+	return snapshot, err
+}
+
+// IsBlankImage returns whether all the pixels of an image are in the same color, e.g. an
+// artwork which is not drawn yet. The pixels are sampled on a grid of analysisImageSize.
+func IsBlankImage(img image.Image) bool {
+	b := img.Bounds()
+	if b.Empty() {
+		return true
+	}
+
+	step := max(1, max(b.Dx(), b.Dy())/analysisImageSize)
+	r0, g0, b0, a0 := img.At(b.Min.X, b.Min.Y).RGBA()
+	for y := b.Min.Y; y < b.Max.Y; y += step {
+		for x := b.Min.X; x < b.Max.X; x += step {
+			r, g, bl, a := img.At(x, y).RGBA()
+			if r != r0 || g != g0 || bl != b0 || a != a0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
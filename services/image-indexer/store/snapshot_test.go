@@ -0,0 +1,26 @@
+package store
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsBlankImage(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 1920, 1080))
+	for x := 0; x < 1920; x++ {
+		for y := 0; y < 1080; y++ {
+			img.Set(x, y, color.RGBA{R: 10, G: 20, B: 30, A: 255})
+		}
+	}
+	assert.True(t, IsBlankImage(img))
+
+	for x := 900; x < 1000; x++ {
+		for y := 500; y < 600; y++ {
+			img.Set(x, y, color.RGBA{R: 255, A: 255})
+		}
+	}
+	assert.False(t, IsBlankImage(img))
+}
@@ -1,18 +1,14 @@
 package store
 
 import (
-	"bytes"
 	"context"
 	"errors"
-	"fmt"
+	stdimage "image"
 	"io"
-	"net/http"
-	"os/exec"
 	"strings"
 	"time"
 
 	log "github.com/bitmark-inc/autonomy-logger"
-	"github.com/cloudflare/cloudflare-go"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 	"gorm.io/driver/postgres"
@@ -21,8 +17,6 @@ import (
 	"gorm.io/gorm/logger"
 )
 
-const CloudflareImageDeilverURL = "https://imagedelivery.net/%s/%s/public"
-
 type Metadata map[string]interface{}
 
 type ImageReader interface {
@@ -36,37 +30,16 @@ func IsSupportedImageType(mimeType string) bool {
 	return strings.HasPrefix(mimeType, "image/")
 }
 
-type ImageStore struct {
-	db *gorm.DB
-
-	// cloudflare
-	cloudflareAccountHash string
-	cloudflareAccountID   *cloudflare.ResourceContainer
-	cloudflareAPI         *cloudflare.API
-}
+var ErrPreviewClipsNotSupported = errors.New("the image backend does not store preview clips, use the s3 or the filesystem backend")
 
-// imageIDExisted checks if a given image id is presence in cloudflare
-func (s *ImageStore) imageIDExisted(imageID string) (bool, error) {
-	// FIXME: not use default client
-	resp, err := http.Head(fmt.Sprintf(CloudflareImageDeilverURL, s.cloudflareAccountHash, imageID))
-	if err != nil {
-		return false, err
-	}
-	defer func() {
-		_ = resp.Body.Close()
-	}()
-
-	switch resp.StatusCode {
-	case http.StatusOK:
-		return true, nil
-	case http.StatusNotFound:
-		return false, nil
-	}
+type ImageStore struct {
+	db      *gorm.DB
+	backend ImageBackend
 
-	return false, fmt.Errorf("incorrect http status(%d) on checking image existence", resp.StatusCode)
+	previewClips bool
 }
 
-func New(dsn string, cloudflareAccountHash, cloudflareAccountID, cloudflareAPIToken string) *ImageStore {
+func New(dsn string, backend ImageBackend) *ImageStore {
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.LogLevel(viper.GetInt("image_db.log_level"))),
 	})
@@ -82,28 +55,25 @@ func New(dsn string, cloudflareAccountHash, cloudflareAccountID, cloudflareAPITo
 	// SetConnMaxLifetime sets the maximum amount of time a connection may be reused.
 	sqldb.SetConnMaxLifetime(time.Hour)
 
-	cloudflareAPI, err := cloudflare.NewWithAPIToken(cloudflareAPIToken,
-		cloudflare.Debug(viper.GetBool("debug")), cloudflare.UsingLogger(log.CloudflareLogger()))
-	if err != nil {
-		panic(err)
+	return &ImageStore{
+		db:      db,
+		backend: backend,
 	}
+}
 
-	accRes := &cloudflare.ResourceContainer{
-		Level:      cloudflare.AccountRouteLevel,
-		Identifier: cloudflareAccountID,
-		Type:       cloudflare.AccountType,
+// EnablePreviewClips makes UploadVideoPreview extract and upload the preview clips of the videos.
+// It returns ErrPreviewClipsNotSupported if the image backend is not a PreviewClipBackend.
+func (s *ImageStore) EnablePreviewClips() error {
+	if _, ok := s.backend.(PreviewClipBackend); !ok {
+		return ErrPreviewClipsNotSupported
 	}
 
-	return &ImageStore{
-		db:                    db,
-		cloudflareAccountHash: cloudflareAccountHash,
-		cloudflareAccountID:   accRes,
-		cloudflareAPI:         cloudflareAPI,
-	}
+	s.previewClips = true
+	return nil
 }
 
 func (s *ImageStore) AutoMigrate() error {
-	return s.db.AutoMigrate(&ImageMetadata{})
+	return s.db.AutoMigrate(&ImageMetadata{}, &SnapshotMetadata{}, &ThumbnailJob{})
 }
 
 // GetImage returns an image metadata object
@@ -128,42 +98,12 @@ func (s *ImageStore) CreateOrGetImage(ctx context.Context, assetID string) (Imag
 	return image, tx.Error
 }
 
-// UploadImage creates a db transaction to download and upload an image to cloudflare.
-// After an image is successfully uploaded to cloudflare, it updates the returned image id into image store.
+// UploadImage creates a db transaction to download and upload an image to the image backend.
+// After an image is successfully uploaded, it updates the returned image id into image store.
 // It locks an image record for updating which prevents from duplicated download precess
-// The additional metadata will be attached to the image file when we upload it to cloudflare.
+// The additional metadata will be attached to the image file when we upload it to the image backend.
 func (s *ImageStore) UploadImage(ctx context.Context, assetID string, imageReader ImageReader, metadata map[string]interface{}) (ImageMetadata, error) {
-	var image ImageMetadata
-	var cloudflareImageID string
-
-	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		if err := tx.Clauses(clause.Locking{
-			Strength: "UPDATE",
-			Options:  "NOWAIT",
-		}).Where("asset_id = ?", assetID).First(&image).Error; err != nil {
-			// not found, locked or any other errors
-			return err
-		}
-
-		if image.ImageID != "" {
-			imageExisted, err := s.imageIDExisted(image.ImageID)
-			if err != nil {
-				return err
-			}
-			log.Debug("check thumbnail cache existent",
-				zap.Bool("imageExisted", imageExisted),
-				zap.String("assetID", assetID))
-			if imageExisted {
-				// remove the existent image before create a new one
-				if err := s.cloudflareAPI.DeleteImage(ctx, s.cloudflareAccountID, image.ImageID); err != nil {
-					log.WarnWithContext(ctx,
-						"fail to delete image cache",
-						zap.String("assetID", assetID), zap.Error(err))
-					return err
-				}
-			}
-		}
-
+	return s.replaceImage(ctx, assetID, func(image *ImageMetadata) error {
 		downloadStartTime := time.Now()
 		file, mimeType, imageSize, err := imageReader.Read()
 		if err != nil {
@@ -189,124 +129,176 @@ func (s *ImageStore) UploadImage(ctx context.Context, assetID string, imageReade
 			metadata["mime_type"] = mimeType
 		}
 
-		if imageSize > ImageSizeThreshold {
-			file, err = compressImage(file)
-			if err != nil {
-				log.WarnWithContext(ctx, "cannot compress the thumbnail with ffmpeg", zap.Error(err))
-			}
-		}
-
-		uploadRequest := cloudflare.UploadImageParams{
-			File:     io.NopCloser(file),
-			Name:     assetID,
-			Metadata: metadata,
+		img, err := DecodeImage(file)
+		if err != nil {
+			return err
 		}
 
-		log.Debug("upload image to cloudflare", zap.String("assetID", assetID))
+		return s.uploadPoster(ctx, assetID, image, img, metadata)
+	})
+}
 
-		i, err := s.cloudflareAPI.UploadImage(ctx, s.cloudflareAccountID, uploadRequest)
+// UploadVideoPreview creates a db transaction to download a video and upload its poster frame and
+// its preview clip to the image backend. The poster frame is the image of the image record. The
+// preview clip is extracted only if the preview clips are enabled by EnablePreviewClips.
+func (s *ImageStore) UploadVideoPreview(ctx context.Context, assetID string, videoReader ImageReader, metadata map[string]interface{}) (ImageMetadata, error) {
+	return s.replaceImage(ctx, assetID, func(image *ImageMetadata) error {
+		downloadStartTime := time.Now()
+		file, mimeType, videoSize, err := videoReader.Read()
 		if err != nil {
-			var ratelimitError *cloudflare.RatelimitError
-			if errors.As(err, &ratelimitError) {
-				log.Debug("caught cloudflare ratelimit error", zap.String("type", string(ratelimitError.Type())))
-				return err
-			}
+			return NewImageCachingError(ReasonDownloadFileFailed)
+		}
+		log.Debug("download video finished",
+			zap.String("mimeType", mimeType),
+			zap.Int("videoSize", videoSize),
+			zap.Duration("duration", time.Since(downloadStartTime)),
+			zap.String("assetID", assetID))
 
-			var requestError *cloudflare.RequestError
-			if errors.As(err, &requestError) {
-				log.Debug("caught cloudflare request error", zap.String("type", string(requestError.Type())),
-					zap.Any("codes", requestError.ErrorCodes()), zap.Any("msg", requestError.ErrorMessages()))
-				for _, code := range requestError.ErrorCodes() {
-					switch code {
-					case 5455: // Unsupported content type
-						return NewImageCachingError(ReasonUnsupportedImageType)
-					case 9422:
-						return NewImageCachingError(ReasonBrokenImage)
-					case 5443: // The image is too large
-						return NewImageCachingError(ReasonFileSizeTooLarge)
-					default:
-						return err
-					}
-				}
-				return err
-			}
+		if !IsSupportedVideoType(mimeType) {
+			return NewImageCachingError(ReasonUnsupportedVideoType)
+		}
 
-			var serviceError *cloudflare.ServiceError
-			if errors.As(err, &serviceError) {
-				log.Debug("caught cloudflare service error", zap.Any("codes", serviceError.ErrorCodes()), zap.Any("msg", serviceError.ErrorMessages()))
-				return err
-			}
+		if videoSize > VideoSizeThreshold {
+			return NewImageCachingError(ReasonFileSizeTooLarge)
+		}
 
+		if metadata == nil {
+			metadata = Metadata{}
+		}
+		metadata["mime_type"] = mimeType
+
+		preview, err := ExtractVideoPreview(ctx, file, s.previewClips)
+		if err != nil {
 			return err
 		}
+		image.DurationSeconds = preview.DurationSeconds
 
-		cloudflareImageID = i.ID
-		image.ImageID = i.ID
+		if err := s.uploadPoster(ctx, assetID, image, preview.Poster, metadata); err != nil {
+			return err
+		}
 
-		return tx.Where("asset_id = ?", assetID).Save(&image).Error
-	})
+		if !s.previewClips {
+			return nil
+		}
 
-	// Clean up uploaded files when a transaction is failed.
-	// It can not 100% ensure the file is cleaned up due to service broken
-	if err != nil && cloudflareImageID != "" {
-		log.WarnWithContext(ctx, "clean uploaded file due to rollback", zap.String("assetID", assetID))
-		err = s.cloudflareAPI.DeleteImage(ctx, s.cloudflareAccountID, cloudflareImageID)
+		clipID, err := s.backend.(PreviewClipBackend).UploadPreviewClip(ctx, assetID, preview.Clip, metadata)
 		if err != nil {
-			log.WarnWithContext(ctx, "fail to clean uploaded file", zap.String("cloudflareImageID", cloudflareImageID))
+			return err
 		}
-	}
+		image.PreviewClipID = clipID
 
-	return image, err
+		return nil
+	})
 }
 
-// compressImage compresses an image by reducing width (iw), height (ih) and quality (compression_level)
-func compressImage(file io.Reader) (io.Reader, error) {
-	resultBuffer := bytes.NewBuffer(make([]byte, 0))
-	buf := &bytes.Buffer{}
-	_, err := io.Copy(buf, file)
+// uploadPoster uploads a decoded image and sets its id, its dimensions and its placeholders to an image record
+func (s *ImageStore) uploadPoster(ctx context.Context, assetID string, image *ImageMetadata, img stdimage.Image, metadata Metadata) error {
+	analysis := AnalyzeImage(img)
+
+	imageID, err := s.backend.UploadImage(ctx, assetID, img, metadata)
 	if err != nil {
-		return file, err
+		return err
 	}
 
-	// Compress the image
-	// If it is a gif, we select the first frame
-	// To make sure the size is less than a threshold, the new image has 90% of the width and height
-	// of the input image
-	// FIXME: adapt the command with various image size to make sure we get the best quality with output size < 10MB
-	cmd := exec.Command("ffmpeg", "-y",
-		"-f", "image2pipe",
-		"-i", "pipe:0",
-		"-vsync", "0",
-		"-vf", "select=eq(n\\,0)",
-		"-vf", "scale=-1:min'(10000,ih*0.9)':force_original_aspect_ratio=decrease",
-		"-q:v", "5",
-		"-update", "1",
-		"-f", "image2", "pipe:1")
-
-	var execErr bytes.Buffer
-	cmd.Stderr = &execErr
-	cmd.Stdout = resultBuffer
-
-	stdin, _ := cmd.StdinPipe()
-	if err = cmd.Start(); err != nil {
-		return file, err
-	}
+	image.ImageID = imageID
+	image.Width = analysis.Width
+	image.Height = analysis.Height
+	image.Blurhash = analysis.Blurhash
+	image.DominantColors = analysis.DominantColors
 
-	if _, err = stdin.Write(buf.Bytes()); err != nil {
-		return file, err
-	}
+	return nil
+}
 
-	if err = stdin.Close(); err != nil {
-		return file, err
-	}
+// replaceImage locks the image record of an asset and saves the record after the upload function
+// fills it with the newly uploaded files. The previously cached files are removed only after the
+// record is saved, so that a failed upload keeps them.
+func (s *ImageStore) replaceImage(ctx context.Context, assetID string, upload func(image *ImageMetadata) error) (ImageMetadata, error) {
+	var image ImageMetadata
+	var uploadedIDs []string
+	var previousImageID, previousClipID string
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{
+			Strength: "UPDATE",
+			Options:  "NOWAIT",
+		}).Where("asset_id = ?", assetID).First(&image).Error; err != nil {
+			// not found, locked or any other errors
+			return err
+		}
+
+		previousImageID = image.ImageID
+		previousClipID = image.PreviewClipID
+
+		image.ImageID = ""
+		image.PreviewClipID = ""
+		image.DurationSeconds = 0
+
+		err := upload(&image)
+		for _, id := range []string{image.ImageID, image.PreviewClipID} {
+			if id != "" {
+				uploadedIDs = append(uploadedIDs, id)
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		return tx.Where("asset_id = ?", assetID).Save(&image).Error
+	})
 
-	if err = cmd.Wait(); err != nil {
-		return file, err
+	// Clean up uploaded files when a transaction is failed.
+	// It can not 100% ensure the file is cleaned up due to service broken
+	if err != nil {
+		for _, id := range uploadedIDs {
+			log.WarnWithContext(ctx, "clean uploaded file due to rollback", zap.String("assetID", assetID))
+			if err := s.backend.DeleteImage(ctx, id); err != nil {
+				log.WarnWithContext(ctx, "fail to clean uploaded file", zap.String("imageID", id))
+			}
+		}
+		return image, err
 	}
 
-	if resultBuffer.Len() > ImageSizeThreshold {
-		return compressImage(resultBuffer)
+	s.deletePreviousFiles(ctx, assetID, previousImageID, previousClipID)
+
+	return image, nil
+}
+
+// deletePreviousFiles removes the replaced image and preview clip of an asset if they exist.
+// The record refers to the new files already, so a failed removal only leaves an orphaned file.
+func (s *ImageStore) deletePreviousFiles(ctx context.Context, assetID, imageID, clipID string) {
+	if imageID != "" {
+		imageExisted, err := s.backend.ImageExists(ctx, imageID)
+		if err != nil {
+			log.WarnWithContext(ctx, "fail to check image cache existent",
+				zap.String("assetID", assetID), zap.Error(err))
+		}
+		log.Debug("check thumbnail cache existent",
+			zap.Bool("imageExisted", imageExisted),
+			zap.String("assetID", assetID))
+		if imageExisted {
+			if err := s.backend.DeleteImage(ctx, imageID); err != nil {
+				log.WarnWithContext(ctx, "fail to delete image cache",
+					zap.String("assetID", assetID), zap.String("imageID", imageID), zap.Error(err))
+			}
+		}
 	}
 
-	return resultBuffer, nil
+	if clipID != "" {
+		clipBackend, ok := s.backend.(PreviewClipBackend)
+		if !ok {
+			return
+		}
+
+		clipExisted, err := clipBackend.PreviewClipExists(ctx, clipID)
+		if err != nil {
+			log.WarnWithContext(ctx, "fail to check preview clip cache existent",
+				zap.String("assetID", assetID), zap.Error(err))
+		}
+		if clipExisted {
+			if err := s.backend.DeleteImage(ctx, clipID); err != nil {
+				log.WarnWithContext(ctx, "fail to delete preview clip cache",
+					zap.String("assetID", assetID), zap.String("clipID", clipID), zap.Error(err))
+			}
+		}
+	}
 }
@@ -1,8 +1,11 @@
 package store
 
 import (
+	"context"
+	"image"
 	"testing"
 
+	log "github.com/bitmark-inc/autonomy-logger"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -12,3 +15,53 @@ func TestIsSupportedImageType(t *testing.T) {
 
 	assert.Equal(t, result, true)
 }
+
+func TestEnablePreviewClips(t *testing.T) {
+	cloudflare, err := NewCloudflareBackend("", "", "")
+	assert.NoError(t, err)
+	s := &ImageStore{backend: cloudflare}
+	assert.ErrorIs(t, s.EnablePreviewClips(), ErrPreviewClipsNotSupported)
+	assert.False(t, s.previewClips)
+
+	filesystem, err := NewFilesystemBackend(t.TempDir())
+	assert.NoError(t, err)
+	s = &ImageStore{backend: filesystem}
+	assert.NoError(t, s.EnablePreviewClips())
+	assert.True(t, s.previewClips)
+}
+
+func TestDeletePreviousFiles(t *testing.T) {
+	if err := log.Initialize(false, nil); err != nil {
+		panic(err)
+	}
+	ctx := context.Background()
+
+	backend, err := NewFilesystemBackend(t.TempDir())
+	assert.NoError(t, err)
+	s := &ImageStore{backend: backend}
+
+	imageID, err := backend.UploadImage(ctx, "asset", image.NewRGBA(image.Rect(0, 0, 10, 10)), nil)
+	assert.NoError(t, err)
+	clipID, err := backend.UploadPreviewClip(ctx, "asset", []byte("clip"), nil)
+	assert.NoError(t, err)
+
+	// a preview clip has no full variant, so it is checked by PreviewClipExists
+	exists, err := backend.ImageExists(ctx, clipID)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	exists, err = backend.PreviewClipExists(ctx, clipID)
+	assert.NoError(t, err)
+	assert.True(t, exists)
+
+	s.deletePreviousFiles(ctx, "asset", imageID, clipID)
+
+	exists, err = backend.ImageExists(ctx, imageID)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	exists, err = backend.PreviewClipExists(ctx, clipID)
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	// the files which were removed already are skipped
+	s.deletePreviousFiles(ctx, "asset", imageID, clipID)
+}
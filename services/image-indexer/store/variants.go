@@ -0,0 +1,81 @@
+package store
+
+import "image"
+
+const (
+	ImageVariantThumbnail = "thumbnail"
+	ImageVariantGallery   = "gallery"
+	ImageVariantFull      = "full"
+
+	// MaxImagePixels is the max width × height of an image which the variants are encoded from.
+	// A decoded image takes 4 or 8 bytes a pixel, so the larger ones are rejected.
+	MaxImagePixels = 8192 * 8192
+)
+
+// ImageVariant is a resized version of an image which longest side is at most MaxSize pixels
+// and which is encoded in at most MaxBytes
+type ImageVariant struct {
+	Name     string
+	MaxSize  int
+	MaxBytes int
+}
+
+// ImageVariants are the variants generated by the backends which serve the images by themselves
+var ImageVariants = []ImageVariant{
+	{Name: ImageVariantThumbnail, MaxSize: 320, MaxBytes: 200 * 1024},
+	{Name: ImageVariantGallery, MaxSize: 1280, MaxBytes: 1024 * 1024},
+	{Name: ImageVariantFull, MaxSize: 4096, MaxBytes: 5 * 1024 * 1024},
+}
+
+// IsImageVariant returns whether a name is one of ImageVariants
+func IsImageVariant(name string) bool {
+	for _, v := range ImageVariants {
+		if v.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// isStoredVariant returns whether a name is one of ImageVariants or the PreviewClipVariant
+func isStoredVariant(name string) bool {
+	return name == PreviewClipVariant || IsImageVariant(name)
+}
+
+// EncodedImageVariant is the encoded content of an image variant
+type EncodedImageVariant struct {
+	EncodedImage
+	Name string
+}
+
+// checkImagePixels returns an error if the dimensions of an image exceed MaxImagePixels
+func checkImagePixels(width, height int) error {
+	if width <= 0 || height <= 0 {
+		return NewImageCachingError(ReasonBrokenImage)
+	}
+
+	if int64(width)*int64(height) > MaxImagePixels {
+		return NewImageCachingError(ReasonFileSizeTooLarge)
+	}
+
+	return nil
+}
+
+// EncodeImageVariants encodes all the variants of an image. An image larger than MaxImagePixels
+// is rejected before the variants are resized from it.
+func EncodeImageVariants(img image.Image) ([]EncodedImageVariant, error) {
+	if err := checkImagePixels(img.Bounds().Dx(), img.Bounds().Dy()); err != nil {
+		return nil, err
+	}
+
+	variants := make([]EncodedImageVariant, 0, len(ImageVariants))
+	for _, v := range ImageVariants {
+		encoded, err := EncodeImageWithinSize(resizeImage(img, v.MaxSize), v.MaxBytes)
+		if err != nil {
+			return nil, err
+		}
+		variants = append(variants, EncodedImageVariant{EncodedImage: encoded, Name: v.Name})
+	}
+
+	return variants, nil
+}
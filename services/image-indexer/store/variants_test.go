@@ -0,0 +1,29 @@
+package store
+
+import (
+	"image"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckImagePixels(t *testing.T) {
+	assert.NoError(t, checkImagePixels(8192, 8192))
+
+	var cachingErr *ImageCachingError
+	assert.ErrorAs(t, checkImagePixels(8193, 8192), &cachingErr)
+	assert.Equal(t, ReasonFileSizeTooLarge, cachingErr.Reason())
+
+	assert.ErrorAs(t, checkImagePixels(0, 10), &cachingErr)
+	assert.Equal(t, ReasonBrokenImage, cachingErr.Reason())
+}
+
+func TestEncodeImageVariants(t *testing.T) {
+	variants, err := EncodeImageVariants(image.NewNRGBA(image.Rect(0, 0, 400, 200)))
+	assert.NoError(t, err)
+	assert.Len(t, variants, len(ImageVariants))
+	assert.Equal(t, ImageVariantThumbnail, variants[0].Name)
+
+	_, err = EncodeImageVariants(image.NewNRGBA(image.Rect(0, 0, 0, 0)))
+	assert.Error(t, err)
+}
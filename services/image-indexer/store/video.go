@@ -0,0 +1,153 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	VideoSizeThreshold = 200 * 1024 * 1024 // 200MB
+
+	// PreviewClipVariant is the variant name of the preview clips of the videos
+	PreviewClipVariant = "clip"
+
+	// previewClipDuration is the length of a preview clip in seconds
+	previewClipDuration = 5
+	// previewClipMaxSize is the max size of the longest side of a preview clip
+	previewClipMaxSize = 480
+	// posterFrameOffset is the time in seconds where a poster frame is taken. It skips the
+	// blank frames which many videos start with.
+	posterFrameOffset = 1
+
+	// videoPreviewTimeout is the max time of ffprobe and ffmpeg to extract the preview of a
+	// video. They run while the image record is locked, so a stuck video must not hold the lock.
+	videoPreviewTimeout = 5 * time.Minute
+)
+
+// IsSupportedVideoType validates if a video is supported
+func IsSupportedVideoType(mimeType string) bool {
+	return strings.HasPrefix(mimeType, "video/")
+}
+
+// VideoPreview is the poster frame, the preview clip and the duration of a video
+type VideoPreview struct {
+	Poster          image.Image
+	Clip            []byte
+	DurationSeconds float64
+}
+
+// ExtractVideoPreview extracts the poster frame, the duration and, if withClip is set, a short
+// looping MP4 preview clip without audio of a video using ffprobe and ffmpeg. The extraction is
+// aborted after videoPreviewTimeout.
+func ExtractVideoPreview(ctx context.Context, file io.Reader, withClip bool) (VideoPreview, error) {
+	var preview VideoPreview
+
+	ctx, cancel := context.WithTimeout(ctx, videoPreviewTimeout)
+	defer cancel()
+
+	dir, err := os.MkdirTemp("", "video-preview-")
+	if err != nil {
+		return preview, err
+	}
+	defer os.RemoveAll(dir)
+
+	// ffmpeg can not seek a piped input which many MP4 files require
+	input := filepath.Join(dir, "input")
+	f, err := os.Create(input)
+	if err != nil {
+		return preview, err
+	}
+	if _, err := io.Copy(f, file); err != nil {
+		_ = f.Close()
+		return preview, err
+	}
+	if err := f.Close(); err != nil {
+		return preview, err
+	}
+
+	out, err := exec.CommandContext(ctx, "ffprobe", "-v", "error",
+		"-show_entries", "format=duration",
+		"-of", "default=noprint_wrappers=1:nokey=1",
+		input).Output()
+	if err != nil {
+		return preview, NewImageCachingError(ReasonBrokenVideo)
+	}
+
+	preview.DurationSeconds, err = parseVideoDuration(string(out))
+	if err != nil {
+		return preview, NewImageCachingError(ReasonBrokenVideo)
+	}
+
+	var poster bytes.Buffer
+	cmd := exec.CommandContext(ctx, "ffmpeg", "-v", "error",
+		"-ss", strconv.FormatFloat(posterFrameTime(preview.DurationSeconds), 'f', 3, 64),
+		"-i", input,
+		"-frames:v", "1",
+		"-f", "image2", "-c:v", "png",
+		"pipe:1")
+	cmd.Stdout = &poster
+	if err := cmd.Run(); err != nil || poster.Len() == 0 {
+		return preview, NewImageCachingError(ReasonBrokenVideo)
+	}
+
+	preview.Poster, err = DecodeImage(&poster)
+	if err != nil {
+		return preview, err
+	}
+
+	if !withClip {
+		return preview, nil
+	}
+
+	clip := filepath.Join(dir, "clip.mp4")
+	if err := exec.CommandContext(ctx, "ffmpeg", "-v", "error",
+		"-i", input,
+		"-t", strconv.Itoa(previewClipDuration),
+		"-an",
+		"-vf", fmt.Sprintf("scale='min(%[1]d,iw)':'min(%[1]d,ih)':force_original_aspect_ratio=decrease:force_divisible_by=2", previewClipMaxSize),
+		"-c:v", "libx264", "-preset", "veryfast", "-crf", "28", "-pix_fmt", "yuv420p",
+		"-movflags", "+faststart",
+		clip).Run(); err != nil {
+		return preview, NewImageCachingError(ReasonBrokenVideo)
+	}
+
+	preview.Clip, err = os.ReadFile(clip)
+	if err != nil {
+		return preview, err
+	}
+
+	return preview, nil
+}
+
+// parseVideoDuration parses the duration in seconds which is printed by ffprobe
+func parseVideoDuration(output string) (float64, error) {
+	duration, err := strconv.ParseFloat(strings.TrimSpace(output), 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if duration <= 0 {
+		return 0, errors.New("invalid video duration")
+	}
+
+	return duration, nil
+}
+
+// posterFrameTime returns the time of the poster frame of a video. The first frame is
+// taken for the videos which are too short to skip the leading frames.
+func posterFrameTime(duration float64) float64 {
+	if duration < 2*posterFrameOffset {
+		return 0
+	}
+	return posterFrameOffset
+}
@@ -0,0 +1,24 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVideoDuration(t *testing.T) {
+	duration, err := parseVideoDuration("12.345000\n")
+	assert.NoError(t, err)
+	assert.Equal(t, 12.345, duration)
+
+	_, err = parseVideoDuration("N/A\n")
+	assert.Error(t, err)
+
+	_, err = parseVideoDuration("0.000000\n")
+	assert.Error(t, err)
+}
+
+func TestPosterFrameTime(t *testing.T) {
+	assert.Equal(t, 0.0, posterFrameTime(1.5))
+	assert.Equal(t, float64(posterFrameOffset), posterFrameTime(30))
+}
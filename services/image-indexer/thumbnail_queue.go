@@ -0,0 +1,361 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	log "github.com/bitmark-inc/autonomy-logger"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+	"gorm.io/gorm"
+
+	indexer "github.com/feral-file/ff-indexer"
+	imageStore "github.com/feral-file/ff-indexer/services/image-indexer/store"
+)
+
+const (
+	// thumbnailCheckBatchSize is the max number of the assets or the collections queued per check
+	thumbnailCheckBatchSize = 100
+	// thumbnailJobPollInterval is the interval of looking up ready jobs when no job is queued
+	thumbnailJobPollInterval = 15 * time.Second
+	// thumbnailJobDeferDelay is the delay of a job which source reaches the rate limit
+	thumbnailJobDeferDelay = 10 * time.Second
+	// thumbnailJobSettleMargin is the part of the lease, i.e. the last 1/10 of it, which is
+	// reserved for settling a job after its thumbnail is generated
+	thumbnailJobSettleMargin = 10
+)
+
+// ThumbnailQueueConfig is the settings of the thumbnail job queue
+type ThumbnailQueueConfig struct {
+	// MaxAttempts is the max number of generating a thumbnail which fails with retryable errors
+	MaxAttempts int
+	// LeaseDuration is the time a worker holds a job before another worker can take it over
+	LeaseDuration time.Duration
+	// DefaultRateLimit is the max number of the thumbnails generated per minute of a source
+	// without its own rate limit. Zero means no limit.
+	DefaultRateLimit float64
+	// RateLimits is the max number of the thumbnails generated per minute of the sources
+	RateLimits map[string]float64
+}
+
+// sourceRateLimiter limits the thumbnails generated per minute of every source
+type sourceRateLimiter struct {
+	sync.Mutex
+
+	defaultLimit float64
+	limits       map[string]float64
+	limiters     map[string]*rate.Limiter
+}
+
+func newSourceRateLimiter(defaultLimit float64, limits map[string]float64) *sourceRateLimiter {
+	return &sourceRateLimiter{
+		defaultLimit: defaultLimit,
+		limits:       limits,
+		limiters:     map[string]*rate.Limiter{},
+	}
+}
+
+// limiter returns the limiter of a source. It must be called with the lock held.
+func (l *sourceRateLimiter) limiter(source string) *rate.Limiter {
+	if limiter, ok := l.limiters[source]; ok {
+		return limiter
+	}
+
+	perMinute, ok := l.limits[source]
+	if !ok {
+		perMinute = l.defaultLimit
+	}
+
+	limit := rate.Inf
+	if perMinute > 0 {
+		limit = rate.Limit(perMinute / 60)
+	}
+
+	limiter := rate.NewLimiter(limit, 1)
+	l.limiters[source] = limiter
+	return limiter
+}
+
+// Allow takes a token of a source and returns false if the source reaches its rate limit
+func (l *sourceRateLimiter) Allow(source string) bool {
+	l.Lock()
+	defer l.Unlock()
+
+	return l.limiter(source).Allow()
+}
+
+// Limited returns the sources which reach their rate limits
+func (l *sourceRateLimiter) Limited() []string {
+	l.Lock()
+	defer l.Unlock()
+
+	var sources []string
+	now := time.Now()
+	for source, limiter := range l.limiters {
+		if limiter.Limit() != rate.Inf && limiter.TokensAt(now) < 1 {
+			sources = append(sources, source)
+		}
+	}
+
+	return sources
+}
+
+// thumbnailWorkerPool is a group of workers which process the jobs of the types
+type thumbnailWorkerPool struct {
+	types   []string
+	workers int
+	notify  chan struct{}
+}
+
+func newThumbnailWorkerPool(types []string, workers int) *thumbnailWorkerPool {
+	return &thumbnailWorkerPool{
+		types:   types,
+		workers: workers,
+		notify:  make(chan struct{}, 1),
+	}
+}
+
+// wake wakes up an idle worker of the pool without blocking
+func (p *thumbnailWorkerPool) wake() {
+	select {
+	case p.notify <- struct{}{}:
+	default:
+	}
+}
+
+// notifyThumbnailWorkers wakes up a worker which processes the jobs of a type
+func (s *NFTContentIndexer) notifyThumbnailWorkers(jobType string) {
+	for _, pool := range s.thumbnailPools {
+		for _, t := range pool.types {
+			if t == jobType {
+				pool.wake()
+				return
+			}
+		}
+	}
+}
+
+// enqueueThumbnailJob queues a thumbnail job and wakes up a worker to process it
+func (s *NFTContentIndexer) enqueueThumbnailJob(ctx context.Context, job imageStore.ThumbnailJob) error {
+	if err := s.db.EnqueueThumbnailJob(ctx, job); err != nil {
+		return err
+	}
+
+	s.notifyThumbnailWorkers(job.Type)
+	return nil
+}
+
+// assetThumbnailJob returns the thumbnail job of an asset. The thumbnail of a video is
+// the poster frame of its preview.
+func assetThumbnailJob(asset NFTAsset, priority imageStore.ThumbnailJobPriority) imageStore.ThumbnailJob {
+	latest := asset.ProjectMetadata.Latest
+
+	jobType, imageURL := TypeAsset, latest.ThumbnailURL
+	if latest.Medium == indexer.MediumVideo && latest.PreviewURL != "" {
+		jobType, imageURL = TypeVideo, latest.PreviewURL
+	}
+
+	return imageStore.ThumbnailJob{
+		AssetID:  asset.IndexID,
+		Type:     jobType,
+		ImageURL: imageURL,
+		Source:   latest.Source,
+		Metadata: imageStore.Metadata{
+			"source":   latest.Source,
+			"file_url": imageURL,
+		},
+		Priority: priority,
+	}
+}
+
+// collectionThumbnailJob returns the thumbnail job of a collection
+func collectionThumbnailJob(col indexer.Collection, priority imageStore.ThumbnailJobPriority) imageStore.ThumbnailJob {
+	return imageStore.ThumbnailJob{
+		AssetID:  col.ID,
+		Type:     TypeCollection,
+		ImageURL: col.ImageURL,
+		Source:   col.Source,
+		Metadata: imageStore.Metadata{
+			"source":   col.Source,
+			"file_url": col.ImageURL,
+		},
+		Priority: priority,
+	}
+}
+
+// EnqueueAssetThumbnail queues a thumbnail job of an asset with a priority. The asset which
+// has a thumbnail or a thumbnail failure is skipped unless it is forced. It returns whether
+// a job is queued.
+func (s *NFTContentIndexer) EnqueueAssetThumbnail(ctx context.Context, indexID string, priority imageStore.ThumbnailJobPriority, force bool) (bool, error) {
+	asset, err := s.getAsset(ctx, indexID)
+	if err != nil {
+		return false, err
+	}
+
+	if !force && (asset.ThumbnailID != "" || asset.ThumbnailFailedReason != "") {
+		return false, nil
+	}
+
+	if err := s.enqueueThumbnailJob(ctx, assetThumbnailJob(asset, priority)); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// processThumbnailJob generates the thumbnail of a leased job and settles the job by the result.
+// A job fails without retries if the image is unsupported or it runs out of attempts. The thumbnail
+// is generated before the lease expires, so that the job is settled before another worker takes
+// it over.
+func (s *NFTContentIndexer) processThumbnailJob(ctx context.Context, job imageStore.ThumbnailJob) error {
+	jobCtx, cancel := context.WithDeadline(ctx, job.LeaseExpiresAt.Add(-s.thumbnailQueue.LeaseDuration/thumbnailJobSettleMargin))
+	defer cancel()
+
+	err := s.generateThumbnail(jobCtx, ThumbnailIndexInfo{
+		ID:       job.AssetID,
+		ImageURL: job.ImageURL,
+		Metadata: job.Metadata,
+		Type:     Type(job.Type),
+	})
+	if err == nil {
+		return s.db.CompleteThumbnailJob(ctx, job)
+	}
+
+	var uerr imageStore.UnsupportedImageCachingError
+	if errors.As(err, &uerr) || job.Attempts >= s.thumbnailQueue.MaxAttempts {
+		return s.db.FailThumbnailJob(ctx, job, err.Error())
+	}
+
+	return s.db.RetryThumbnailJob(ctx, job, err.Error(), time.Now().Add(imageStore.ThumbnailJobBackoff(job.Attempts)))
+}
+
+// spawnThumbnailJobWorkers spawns the workers of a pool which lease the jobs from the queue.
+// The jobs of the sources which reach their rate limits are left in the queue.
+func (s *NFTContentIndexer) spawnThumbnailJobWorkers(ctx context.Context, pool *thumbnailWorkerPool) {
+	for i := 0; i < pool.workers; i++ {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+
+		WATCH_JOBS:
+			for {
+				job, err := s.db.LeaseThumbnailJob(ctx, s.thumbnailQueue.LeaseDuration, pool.types, s.thumbnailLimiter.Limited())
+				if err != nil {
+					if !errors.Is(err, gorm.ErrRecordNotFound) {
+						log.WarnWithContext(ctx, "fail to lease thumbnail job", zap.Error(err))
+					}
+
+					select {
+					case <-pool.notify:
+					case <-time.After(thumbnailJobPollInterval):
+					case <-ctx.Done():
+						break WATCH_JOBS
+					}
+					continue
+				}
+
+				if !s.thumbnailLimiter.Allow(job.Source) {
+					if err := s.db.DeferThumbnailJob(ctx, job, time.Now().Add(thumbnailJobDeferDelay)); err != nil {
+						log.WarnWithContext(ctx, "fail to defer thumbnail job", zap.Uint64("jobID", job.ID), zap.Error(err))
+					}
+					continue
+				}
+
+				log.InfoWithContext(ctx, "start thumbnail job",
+					zap.Uint64("jobID", job.ID),
+					zap.String("id", job.AssetID),
+					zap.Int("priority", int(job.Priority)),
+					zap.Int("attempt", job.Attempts))
+
+				if err := s.processThumbnailJob(ctx, job); err != nil {
+					log.WarnWithContext(ctx, "fail to settle thumbnail job", zap.Uint64("jobID", job.ID), zap.Error(err))
+				}
+			}
+			log.InfoWithContext(ctx, "ThumbnailWorker stopped")
+		}()
+	}
+}
+
+// queueCollectionsWithoutThumbnail queues the thumbnail jobs of the collections without thumbnail cached
+func (s *NFTContentIndexer) queueCollectionsWithoutThumbnail(ctx context.Context) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+	WATCH_COLLECTION:
+		for {
+			collections, err := s.getCollectionsWithoutThumbnailCached(ctx, thumbnailCheckBatchSize)
+			if err != nil {
+				log.WarnWithContext(ctx, "fail to get collections that have no thumbnail cached", zap.Error(err))
+			}
+
+			for _, col := range collections {
+				log.InfoWithContext(ctx, "queue collection image to process", zap.String("id", col.ID))
+				if err := s.enqueueThumbnailJob(ctx, collectionThumbnailJob(col, imageStore.ThumbnailJobPriorityLow)); err != nil {
+					log.WarnWithContext(ctx, "fail to queue collection thumbnail job", zap.String("id", col.ID), zap.Error(err))
+				}
+			}
+
+			if len(collections) < thumbnailCheckBatchSize {
+				if err == nil {
+					log.InfoWithContext(ctx, "no more collection need to be processed")
+				}
+
+				if done := indexer.SleepWithContext(ctx, 15*time.Second); done {
+					break WATCH_COLLECTION
+				}
+			}
+		}
+	}()
+}
+
+// queueAssetsWithoutThumbnail queues the thumbnail jobs of the assets without thumbnail cached
+func (s *NFTContentIndexer) queueAssetsWithoutThumbnail(ctx context.Context) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+	WATCH_ASSETS:
+		for {
+			assets, err := s.getAssetsWithoutThumbnailCached(ctx, thumbnailCheckBatchSize)
+			if err != nil {
+				log.WarnWithContext(ctx, "fail to get assets that have no thumbnail cached", zap.Error(err))
+			}
+
+			for _, asset := range assets {
+				log.InfoWithContext(ctx, "queue asset to process", zap.String("indexID", asset.IndexID))
+				if err := s.enqueueThumbnailJob(ctx, assetThumbnailJob(asset, imageStore.ThumbnailJobPriorityNormal)); err != nil {
+					log.WarnWithContext(ctx, "fail to queue asset thumbnail job", zap.String("indexID", asset.IndexID), zap.Error(err))
+				}
+			}
+
+			if len(assets) < thumbnailCheckBatchSize {
+				if err == nil {
+					log.InfoWithContext(ctx, "no more asset need to be processed")
+				}
+
+				if done := indexer.SleepWithContext(ctx, 15*time.Second); done {
+					break WATCH_ASSETS
+				}
+			}
+		}
+		log.InfoWithContext(ctx, "thumbnail checker closed")
+	}()
+}
+
+func (s *NFTContentIndexer) checkThumbnail(ctx context.Context) {
+	for _, pool := range s.thumbnailPools {
+		s.spawnThumbnailJobWorkers(ctx, pool)
+	}
+
+	log.InfoWithContext(ctx, "start the loop the queue assets without thumbnail cached",
+		zap.Duration("thumbnailCachePeriod", s.thumbnailCachePeriod),
+		zap.Duration("thumbnailCacheRetryInterval", s.thumbnailCacheRetryInterval),
+		zap.Int("maxAttempts", s.thumbnailQueue.MaxAttempts),
+		zap.Float64("defaultRateLimit", s.thumbnailQueue.DefaultRateLimit))
+
+	s.queueCollectionsWithoutThumbnail(ctx)
+	s.queueAssetsWithoutThumbnail(ctx)
+}
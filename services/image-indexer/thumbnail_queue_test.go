@@ -0,0 +1,40 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	indexer "github.com/feral-file/ff-indexer"
+)
+
+func TestSourceRateLimiter(t *testing.T) {
+	limiter := newSourceRateLimiter(0, map[string]float64{"objkt": 1})
+
+	assert.True(t, limiter.Allow("objkt"))
+	assert.False(t, limiter.Allow("objkt"))
+	assert.Equal(t, []string{"objkt"}, limiter.Limited())
+
+	// the sources without rate limits are never limited
+	for i := 0; i < 10; i++ {
+		assert.True(t, limiter.Allow("opensea"))
+	}
+	assert.Equal(t, []string{"objkt"}, limiter.Limited())
+}
+
+func TestAssetThumbnailJob(t *testing.T) {
+	asset := NFTAsset{IndexID: "eth-0x1-1"}
+	asset.ProjectMetadata.Latest.Source = "feralfile"
+	asset.ProjectMetadata.Latest.ThumbnailURL = "https://example.com/thumbnail.png"
+	asset.ProjectMetadata.Latest.PreviewURL = "https://example.com/preview.mp4"
+
+	job := assetThumbnailJob(asset, 0)
+	assert.Equal(t, TypeAsset, job.Type)
+	assert.Equal(t, "https://example.com/thumbnail.png", job.ImageURL)
+	assert.Equal(t, "feralfile", job.Source)
+
+	asset.ProjectMetadata.Latest.Medium = indexer.MediumVideo
+	job = assetThumbnailJob(asset, 0)
+	assert.Equal(t, TypeVideo, job.Type)
+	assert.Equal(t, "https://example.com/preview.mp4", job.ImageURL)
+}
@@ -2,11 +2,13 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	log "github.com/bitmark-inc/autonomy-logger"
+	utils "github.com/bitmark-inc/autonomy-utils"
 	"github.com/bitmark-inc/config-loader"
-	"github.com/bitmark-inc/config-loader/external/aws/ssm"
 	"github.com/bitmark-inc/tzkt-go"
 	"github.com/getsentry/sentry-go"
 	"github.com/spf13/viper"
@@ -14,6 +16,8 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/feral-file/ff-indexer/cache"
+	"github.com/feral-file/ff-indexer/emitter"
 	pb "github.com/feral-file/ff-indexer/services/event-processor/grpc"
 )
 
@@ -30,9 +34,24 @@ func main() {
 
 	ctx := context.Background()
 
-	parameterStore, err := ssm.New(ctx)
+	var cacheStore cache.Store
+	if viper.GetString("checkpoint_store.type") == emitter.CheckpointStoreMongoDB {
+		mongoCacheStore, err := cache.NewMongoDBCacheStore(ctx, viper.GetString("cache_store.db_uri"), viper.GetString("cache_store.db_name"))
+		if err != nil {
+			log.Panic("fail to initiate cache store", zap.Error(err))
+		}
+		cacheStore = mongoCacheStore
+	}
+
+	checkpointStore, err := emitter.NewCheckpointStore(ctx, emitter.CheckpointStoreConfig{
+		Type:       viper.GetString("checkpoint_store.type"),
+		KeyName:    viper.GetString("tzkt.lastBlockKeyName"),
+		DSN:        viper.GetString("checkpoint_store.dsn"),
+		Path:       viper.GetString("checkpoint_store.path"),
+		CacheStore: cacheStore,
+	})
 	if err != nil {
-		log.Panic("can not create new parameter store", zap.Error(err))
+		log.Panic("fail to initiate checkpoint store", zap.Error(err))
 	}
 
 	// connect to the processor
@@ -44,8 +63,20 @@ func main() {
 		_ = conn.Close()
 	}()
 
+	go func() {
+		if err := emitter.ServeMetrics(viper.GetString("metrics.address")); err != nil {
+			log.ErrorWithContext(ctx, errors.New("metrics server stopped with error"), zap.Error(err))
+		}
+	}()
+
+	tzktClient := tzkt.New(viper.GetString("tzkt.network"))
+	go emitter.MonitorCheckpointLag(ctx, viper.GetDuration("metrics.lag_report_interval"), utils.TezosBlockchain,
+		[]string{emitter.SubscriptionNftTransfer}, checkpointStore, func(_ context.Context) (uint64, error) {
+			return tzktClient.GetLevelByTime(time.Now())
+		})
+
 	c := pb.NewEventProcessorClient(conn)
-	tezosEventsEmitter := NewTezosEventsEmitter(ctx, viper.GetString("tzkt.lastBlockKeyName"), parameterStore, c, viper.GetString("tzkt.ws_url"), tzkt.New(viper.GetString("tzkt.network")))
+	tezosEventsEmitter := NewTezosEventsEmitter(ctx, checkpointStore, c, viper.GetString("tzkt.ws_url"), tzktClient)
 	tezosEventsEmitter.Run(ctx)
 
 	log.InfoWithContext(ctx, "Tezos Emitter terminated")
@@ -10,7 +10,6 @@ import (
 
 	log "github.com/bitmark-inc/autonomy-logger"
 	utils "github.com/bitmark-inc/autonomy-utils"
-	"github.com/bitmark-inc/config-loader/external/aws/ssm"
 	"github.com/bitmark-inc/tzkt-go"
 	"github.com/philippseith/signalr"
 	"github.com/spf13/viper"
@@ -28,9 +27,8 @@ var isFirstTransferEventOnConnected = true
 var isFirstBigmapEventOnConnected = true
 
 type TezosEventsEmitter struct {
-	ctx              context.Context
-	lastBlockKeyName string
-	parameterStore   *ssm.ParameterStore
+	ctx             context.Context
+	checkpointStore emitter.CheckpointStore
 
 	grpcClient pb.EventProcessorClient
 	emitter.EventsEmitter
@@ -42,16 +40,14 @@ type TezosEventsEmitter struct {
 
 func NewTezosEventsEmitter(
 	ctx context.Context,
-	lastBlockKeyName string,
-	parameterStore *ssm.ParameterStore,
+	checkpointStore emitter.CheckpointStore,
 	grpcClient pb.EventProcessorClient,
 	tzktWebsocketURL string,
 	tzktClient *tzkt.TZKT,
 ) *TezosEventsEmitter {
 	return &TezosEventsEmitter{
 		ctx:              ctx,
-		lastBlockKeyName: lastBlockKeyName,
-		parameterStore:   parameterStore,
+		checkpointStore:  checkpointStore,
 		grpcClient:       grpcClient,
 		EventsEmitter:    emitter.New(grpcClient),
 		tzktWebsocketURL: tzktWebsocketURL,
@@ -120,6 +116,25 @@ func (e *TezosEventsEmitter) Bigmaps(data json.RawMessage) {
 	}
 }
 
+// Head is a callback function for handling the new blocks from `head` channel. The token transfers
+// and the bigmap updates come only for the blocks which have them, so the head tells the levels
+// which are scanned. See https://api.tzkt.io/#section/SubscribeToHead
+func (e *TezosEventsEmitter) Head(data json.RawMessage) {
+	var res HeadResponse
+
+	err := json.Unmarshal(data, &res)
+	if err != nil {
+		log.Error(errors.New("fail to unmarshal head data"), zap.Error(err))
+		return
+	}
+
+	// the events of the head level may come after the head, so only the previous level is
+	// scanned once the received events are processed
+	if res.State > 0 && len(e.eventChan) == 0 {
+		emitter.ReportScannedBlock(utils.TezosBlockchain, emitter.SubscriptionNftTransfer, uint64(res.State-1))
+	}
+}
+
 type SignalrLogger struct {
 	ctx context.Context
 }
@@ -199,6 +214,11 @@ func (e *TezosEventsEmitter) Run(ctx context.Context) {
 				if result.Error != nil {
 					log.Panic("fail to SubscribeToBigMaps", zap.Error(err))
 				}
+
+				result = <-client.Invoke("SubscribeToHead")
+				if result.Error != nil {
+					log.Panic("fail to SubscribeToHead", zap.Error(err))
+				}
 			case signalr.ClientClosed:
 				log.Panic("client closed", zap.Error(err))
 			}
@@ -213,15 +233,10 @@ func (e *TezosEventsEmitter) Run(ctx context.Context) {
 func (e *TezosEventsEmitter) processSinceLastStoppedLevel(ctx context.Context) {
 	log.InfoWithContext(ctx, "process logs since last stopped block")
 
-	lastStopLevel, err := e.parameterStore.GetString(ctx, e.lastBlockKeyName)
+	// token transfers and token metadata updates are fetched together and share a checkpoint
+	fromLevel, err := e.checkpointStore.GetCheckpoint(ctx, utils.TezosBlockchain, emitter.SubscriptionNftTransfer)
 	if err != nil {
-		log.ErrorWithContext(ctx, errors.New("failed to read last stop block from parameter store"), zap.Error(err), log.SourceTZKT)
-		return
-	}
-
-	fromLevel, err := strconv.ParseUint(lastStopLevel, 10, 64)
-	if err != nil {
-		log.ErrorWithContext(ctx, errors.New("failed to parse last stop block"), zap.Error(err), log.SourceTZKT)
+		log.ErrorWithContext(ctx, errors.New("failed to read last stop block from checkpoint store"), zap.Error(err), log.SourceTZKT)
 		return
 	}
 
@@ -298,21 +313,22 @@ func (e *TezosEventsEmitter) processTokenEvent(event TokenEvent) {
 		zap.String("to", event.To),
 		zap.String("contractAddress", event.ContractAddress),
 		zap.String("tokenID", event.TokenID),
+		zap.String("amount", event.Amount),
 		zap.String("txID", event.TxID),
 		zap.String("txTime", event.TxTime.String()),
 	)
 
 	if err := e.PushNftEvent(e.ctx, string(event.EventType), event.From, event.To,
-		event.ContractAddress, event.Blockchain, event.TokenID,
-		event.TxID, 0, event.TxTime); err != nil {
+		event.ContractAddress, event.Blockchain, event.TokenID, event.Amount,
+		event.TxID, 0, 0, event.TxTime); err != nil {
 		log.ErrorWithContext(e.ctx, errors.New("gRPC request failed"), zap.Error(err), log.SourceGRPC)
 		return
 	}
 
 	if event.Level > lastStoppedBlock {
 		lastStoppedBlock = event.Level
-		if err := e.parameterStore.PutString(e.ctx, e.lastBlockKeyName, strconv.FormatUint(lastStoppedBlock, 10)); err != nil {
-			log.ErrorWithContext(e.ctx, errors.New("error put parameterStore"), zap.Error(err), log.SourceGRPC)
+		if err := e.checkpointStore.SaveCheckpoint(e.ctx, utils.TezosBlockchain, emitter.SubscriptionNftTransfer, lastStoppedBlock); err != nil {
+			log.ErrorWithContext(e.ctx, errors.New("error save checkpoint"), zap.Error(err))
 			return
 		}
 	}
@@ -322,6 +338,11 @@ func (e *TezosEventsEmitter) tokenTransferToEvent(transfer tzkt.TokenTransfer) T
 	var fromAddress string
 	eventType := EventTypeMint
 
+	amount := "1"
+	if transfer.Amount != nil {
+		amount = *transfer.Amount
+	}
+
 	if transfer.From != nil {
 		fromAddress = transfer.From.Address
 		eventType = EventTypeTransfer
@@ -334,6 +355,7 @@ func (e *TezosEventsEmitter) tokenTransferToEvent(transfer tzkt.TokenTransfer) T
 		ContractAddress: transfer.Token.Contract.Address,
 		Blockchain:      utils.TezosBlockchain,
 		TokenID:         transfer.Token.ID.String(),
+		Amount:          amount,
 		TxID:            strconv.FormatUint(transfer.TransactionID, 10),
 		TxTime:          transfer.Timestamp,
 		Level:           transfer.Level,
@@ -27,6 +27,11 @@ type BigmapUpdateResponse struct {
 	State int64               `json:"state"`
 }
 
+type HeadResponse struct {
+	Type  int   `json:"type"`
+	State int64 `json:"state"`
+}
+
 type TokenEvent struct {
 	EventType       EventType
 	From            string
@@ -34,6 +39,7 @@ type TokenEvent struct {
 	ContractAddress string
 	Blockchain      string
 	TokenID         string
+	Amount          string
 	TxID            string
 	TxTime          time.Time
 	Level           uint64
@@ -111,15 +111,32 @@ func main() {
 	workflow.RegisterWithOptions(worker.IndexTezosTokenSaleFromTzktTxID, workflow.RegisterOptions{
 		Name: "IndexTezosTokenSaleFromTzktTxID",
 	})
+	// IndexTezosTokenSale is the former name of IndexTezosObjktTokenSale. It is kept only for
+	// the in-flight workflows and can be removed once they are done. The name registered last
+	// is used to start the workflow.
 	workflow.RegisterWithOptions(worker.IndexTezosObjktTokenSale, workflow.RegisterOptions{
 		Name: "IndexTezosTokenSale",
 	})
+	workflow.RegisterWithOptions(worker.IndexTezosObjktTokenSale, workflow.RegisterOptions{
+		Name: "IndexTezosObjktTokenSale",
+	})
+	workflow.RegisterWithOptions(worker.IndexTezosMarketplaceTokenSale, workflow.RegisterOptions{
+		Name: "IndexTezosMarketplaceTokenSale",
+	})
+	workflow.RegisterWithOptions(worker.IndexTezosTokenSaleFromTime, workflow.RegisterOptions{
+		Name: "IndexTezosTokenSaleFromTime"})
 	workflow.RegisterWithOptions(worker.CrawlHistoricalExchangeRate, workflow.RegisterOptions{
 		Name: "CrawlHistoricalExchangeRate",
 	})
 	workflow.RegisterWithOptions(worker.CrawlExchangeRateByCurrencyPair, workflow.RegisterOptions{
 		Name: "CrawlExchangeRateByCurrencyPair",
 	})
+	workflow.RegisterWithOptions(worker.BackfillHistoricalExchangeRate, workflow.RegisterOptions{
+		Name: "BackfillHistoricalExchangeRate",
+	})
+	workflow.RegisterWithOptions(worker.IndexCollectionWorkflow, workflow.RegisterOptions{
+		Name: "IndexCollectionWorkflow",
+	})
 
 	// all blockchain
 	activity.Register(worker.IndexToken)
@@ -139,6 +156,8 @@ func main() {
 	activity.Register(worker.GetTezosTxHashFromTzktTransactionID)
 	activity.Register(worker.GetObjktSaleTransactionHashes)
 	activity.Register(worker.ParseTezosObjktTokenSale)
+	activity.Register(worker.GetTezosSaleTransactionHashes)
+	activity.Register(worker.ParseTezosTokenSale)
 
 	// index store
 	activity.Register(worker.IndexAsset)
@@ -149,11 +168,21 @@ func main() {
 	activity.Register(worker.IndexedSaleTx)
 	activity.Register(worker.WriteHistoricalExchangeRate)
 	activity.Register(worker.CrawlExchangeRateFromCoinbase)
+	activity.Register(worker.CrawlExchangeRateFromProviders)
+	activity.Register(worker.GetExchangeRateGaps)
+	activity.Register(worker.GetCurrencyPairs)
 	activity.Register(worker.GetExchangeRateLastTime)
 
 	// index account tokens
 	activity.Register(worker.IndexAccountTokens)
 	activity.Register(worker.MarkAccountTokenChanged)
+	activity.Register(worker.EnqueueThumbnailJob)
+
+	// index collections
+	activity.Register(worker.IndexCollection)
+	activity.Register(worker.GetCollectionTokens)
+	activity.Register(worker.IndexCollectionTokens)
+	activity.Register(worker.DeleteDeprecatedCollectionAsset)
 
 	workerServiceClient := cadence.BuildCadenceServiceClient(hostPort, indexerWorker.ClientName, CadenceService)
 
@@ -162,6 +191,9 @@ func main() {
 	if err := indexerWorker.StartIndexExchangeRateCronWorkflow(ctx, cadenceClient); err != nil {
 		panic(err)
 	}
+	if err := indexerWorker.StartBackfillExchangeRateCronWorkflow(ctx, cadenceClient); err != nil {
+		panic(err)
+	}
 
 	cadence.StartWorker(log.Logger(), workerServiceClient, viper.GetString("cadence.domain"), indexerWorker.TaskListName)
 }
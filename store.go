@@ -2,6 +2,7 @@ package indexer
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -39,11 +40,19 @@ const (
 	tokenAssetViewCollectionName          = "token_assets"
 	collectionsCollectionName             = "collections"
 	collectionAssetsCollectionName        = "collection_assets"
-	salesTimeSeriesCollectionName         = "sales_time_series"
+	salesCollectionName                   = "sales"
 	historicalExchangeRatesCollectionName = "historical_exchange_rates"
+	ownershipChangesCollectionName        = "ownership_changes"
+	fungibleBalanceEventsCollectionName   = "fungible_balance_events"
 )
 
 var ErrNoRecordUpdated = fmt.Errorf("no record updated")
+var ErrBalanceDrifted = fmt.Errorf("token balance drifted")
+var ErrInvalidResumeToken = fmt.Errorf("invalid resume token")
+var ErrUnsupportedSalesAnalyticsScope = fmt.Errorf("unsupported sales analytics scope")
+var ErrUnsupportedSalesAnalyticsInterval = fmt.Errorf("unsupported sales analytics interval")
+var ErrInvalidSearchCursor = fmt.Errorf("invalid search cursor")
+var ErrUnboundedTokenSearch = fmt.Errorf("text, artists or collection ids are required")
 
 type Store interface {
 	Healthz(ctx context.Context) error
@@ -60,6 +69,7 @@ type Store interface {
 	GetDetailedTokens(ctx context.Context, filterParameter FilterParameter, offset, size int64) ([]DetailedToken, error)
 	GetDetailedTokensByOwners(ctx context.Context, owner []string, filterParameter FilterParameter, offset, size int64) ([]DetailedToken, error)
 	GetTokensByTextSearch(ctx context.Context, searchText string, offset, size int64) ([]DetailedToken, error)
+	SearchTokens(ctx context.Context, filter TokenSearchFilter) (TokenSearchResult, error)
 	GetIdentity(ctx context.Context, accountNumber string) (AccountIdentity, error)
 	GetIdentities(ctx context.Context, accountNumbers []string) (map[string]AccountIdentity, error)
 	IndexIdentity(ctx context.Context, identity AccountIdentity) error
@@ -70,6 +80,8 @@ type Store interface {
 	IndexDemoTokens(ctx context.Context, owner string, indexIDs []string) error
 	DeleteDemoTokens(ctx context.Context, owner string) error
 	UpdateOwnerForFungibleToken(ctx context.Context, indexID string, lockedTime time.Time, to string, total int64) error
+	UpdateFungibleTokenBalances(ctx context.Context, indexID, eventID string, deltas map[string]int64, activityTime time.Time) error
+	RevertFungibleTokenBalances(ctx context.Context, indexID, eventID string, activityTime time.Time) error
 	GetLatestActivityTimeByIndexIDs(ctx context.Context, indexIDs []string) (map[string]time.Time, error)
 	MarkAccountTokenChanged(ctx context.Context, indexIDs []string) error
 	GetDetailedTokensV2(ctx context.Context, filterParameter FilterParameter, offset, size int64) ([]DetailedTokenV2, error)
@@ -86,6 +98,9 @@ type Store interface {
 	DeleteCollection(ctx context.Context, collectionID string) error
 	ReplaceCollectionCreator(ctx context.Context, oldCreator, newCreator string) error
 	UpdateCollectionCreators(ctx context.Context, collectionID string, creators []string) error
+	AddCollectionCreator(ctx context.Context, collectionID, creator string) error
+	RemoveCollectionCreator(ctx context.Context, collectionID, creator string) error
+	AssignCollectionCreator(ctx context.Context, collectionID, oldCreator, newCreator string) error
 	DeleteDeprecatedCollectionAsset(ctx context.Context, collectionID, runID string) error
 	GetCollectionLastUpdatedTimeForCreator(ctx context.Context, creator string) (time.Time, error)
 	GetCollectionLastUpdatedTime(ctx context.Context, collectionID string) (time.Time, error)
@@ -100,11 +115,16 @@ type Store interface {
 	SaleTimeSeriesDataExists(ctx context.Context, txID, blockchain string) (bool, error)
 	GetSaleTimeSeriesData(ctx context.Context, filter SalesFilterParameter) ([]SaleTimeSeries, error)
 	AggregateSaleRevenues(ctx context.Context, filter SalesFilterParameter) (map[string]primitive.Decimal128, error)
+	GetSalesAnalytics(ctx context.Context, filter SalesAnalyticsFilter) (SalesAnalytics, error)
 	WriteHistoricalExchangeRate(ctx context.Context, exchangeRate []coinbase.HistoricalExchangeRate) error
 	GetHistoricalExchangeRate(ctx context.Context, filter HistoricalExchangeRateFilter) (ExchangeRate, error)
-	GetExchangeRateLastTime(ctx context.Context) (time.Time, error)
+	GetExchangeRateSeries(ctx context.Context, filter ExchangeRateSeriesFilter) ([]ExchangeRateCandle, error)
+	GetExchangeRateGaps(ctx context.Context, currencyPair string, from, to time.Time, granularity time.Duration) ([]ExchangeRateGap, error)
+	GetExchangeRateLastTime(ctx context.Context, currencyPair string) (time.Time, error)
 	UpdateAssetsConfiguration(ctx context.Context, IDs []string, configuration *AssetConfiguration) (int64, error)
 	CheckAssetCreator(ctx context.Context, IDs []string, creatorAddresses []string) (bool, error)
+	AddOwnershipChange(ctx context.Context, change OwnershipChange) error
+	WatchOwnershipChanges(ctx context.Context, owners, indexIDs []string, resumeToken string, handle func(OwnershipChange) error) error
 }
 
 type FilterParameter struct {
@@ -139,6 +159,13 @@ type HistoricalExchangeRateFilter struct {
 	Timestamp    time.Time
 }
 
+type ExchangeRateSeriesFilter struct {
+	CurrencyPair string
+	From         time.Time
+	To           time.Time
+	Interval     time.Duration
+}
+
 func NewMongodbIndexerStore(ctx context.Context, mongodbURI, dbName, environment string) (*MongodbIndexerStore, error) {
 	mongoClient, err := mongo.Connect(ctx, options.Client().ApplyURI(mongodbURI))
 	if err != nil {
@@ -155,8 +182,10 @@ func NewMongodbIndexerStore(ctx context.Context, mongodbURI, dbName, environment
 	tokenAssetCollection := db.Collection(tokenAssetViewCollectionName)
 	collectionsCollection := db.Collection(collectionsCollectionName)
 	collectionAssetsCollection := db.Collection(collectionAssetsCollectionName)
-	salesTimeSeriesCollection := db.Collection(salesTimeSeriesCollectionName)
+	salesCollection := db.Collection(salesCollectionName)
 	historicalExchangeRatesCollection := db.Collection(historicalExchangeRatesCollectionName)
+	ownershipChangesCollection := db.Collection(ownershipChangesCollectionName)
+	fungibleBalanceEventsCollection := db.Collection(fungibleBalanceEventsCollectionName)
 
 	return &MongodbIndexerStore{
 		environment:                       environment,
@@ -171,8 +200,10 @@ func NewMongodbIndexerStore(ctx context.Context, mongodbURI, dbName, environment
 		tokenAssetCollection:              tokenAssetCollection,
 		collectionsCollection:             collectionsCollection,
 		collectionAssetsCollection:        collectionAssetsCollection,
-		salesTimeSeriesCollection:         salesTimeSeriesCollection,
+		salesCollection:                   salesCollection,
 		historicalExchangeRatesCollection: historicalExchangeRatesCollection,
+		ownershipChangesCollection:        ownershipChangesCollection,
+		fungibleBalanceEventsCollection:   fungibleBalanceEventsCollection,
 	}, nil
 }
 
@@ -189,8 +220,10 @@ type MongodbIndexerStore struct {
 	tokenAssetCollection              *mongo.Collection
 	collectionsCollection             *mongo.Collection
 	collectionAssetsCollection        *mongo.Collection
-	salesTimeSeriesCollection         *mongo.Collection
+	salesCollection                   *mongo.Collection
 	historicalExchangeRatesCollection *mongo.Collection
+	ownershipChangesCollection        *mongo.Collection
+	fungibleBalanceEventsCollection   *mongo.Collection
 }
 
 type AssetUpdateSet struct {
@@ -311,12 +344,36 @@ func (s *MongodbIndexerStore) IndexAsset(ctx context.Context, id string, assetUp
 				{Key: "lastRefreshedTime", Value: indexTime},
 			}}}
 
+			unsets := bson.M{}
+
 			// TODO: check whether to remove the thumbnail cache when the thumbnail data is updated.
-			if currentAsset.ProjectMetadata.Latest.ThumbnailURL != assetUpdates.ProjectMetadata.ThumbnailURL {
+			// the thumbnails of the videos are generated from their preview urls
+			if currentAsset.ProjectMetadata.Latest.ThumbnailURL != assetUpdates.ProjectMetadata.ThumbnailURL ||
+				(assetUpdates.ProjectMetadata.Medium == MediumVideo &&
+					currentAsset.ProjectMetadata.Latest.PreviewURL != assetUpdates.ProjectMetadata.PreviewURL) {
 				log.Debug("image cache need to be reset",
 					zap.String("old", currentAsset.ProjectMetadata.Latest.ThumbnailURL),
 					zap.String("new", assetUpdates.ProjectMetadata.ThumbnailURL))
-				updates = append(updates, bson.E{Key: "$unset", Value: bson.M{"thumbnailID": ""}})
+				unsets["thumbnailID"] = ""
+				unsets["placeholder"] = ""
+				unsets["previewClipID"] = ""
+				unsets["durationSeconds"] = ""
+			}
+
+			// the snapshots of the software artworks are rendered from their preview urls
+			if assetUpdates.ProjectMetadata.Medium == MediumSoftware &&
+				currentAsset.ProjectMetadata.Latest.PreviewURL != assetUpdates.ProjectMetadata.PreviewURL {
+				log.Debug("snapshots need to be reset",
+					zap.String("old", currentAsset.ProjectMetadata.Latest.PreviewURL),
+					zap.String("new", assetUpdates.ProjectMetadata.PreviewURL))
+				unsets["snapshotRenderedAt"] = ""
+				unsets["snapshotAttempts"] = ""
+				unsets["snapshotFailedReason"] = ""
+				unsets["snapshotLastCheck"] = ""
+			}
+
+			if len(unsets) > 0 {
+				updates = append(updates, bson.E{Key: "$unset", Value: unsets})
 			}
 
 			_, err := s.assetCollection.UpdateOne(
@@ -1183,6 +1240,209 @@ func (s *MongodbIndexerStore) GetTokensByTextSearch(ctx context.Context, searchT
 	return tokens, nil
 }
 
+// SearchTokens returns the tokens of the assets which match the text of a filter in the order of
+// the relevance, along with the facets of all the matched tokens
+func (s *MongodbIndexerStore) SearchTokens(ctx context.Context, filter TokenSearchFilter) (TokenSearchResult, error) {
+	log.Debug("SearchTokens", zap.Any("filter", filter))
+
+	result := TokenSearchResult{Tokens: []DetailedTokenV2{}}
+
+	if filter.Size <= 0 {
+		filter.Size = DefaultTokenSearchSize
+	} else if filter.Size > MaxTokenSearchSize {
+		filter.Size = MaxTokenSearchSize
+	}
+
+	if !filter.bounded() {
+		return result, ErrUnboundedTokenSearch
+	}
+
+	resultMatch := bson.M{}
+	if filter.Cursor != "" {
+		cursor, err := decodeTokenSearchCursor(filter.Cursor)
+		if err != nil {
+			return result, err
+		}
+		resultMatch = cursor.match()
+	}
+
+	assetMatch := bson.M{}
+	score := interface{}(0)
+	if filter.Text != "" {
+		assetMatch["$text"] = bson.M{"$search": filter.Text}
+		score = bson.M{"$meta": "textScore"}
+	}
+	if len(filter.Sources) > 0 {
+		assetMatch["source"] = bson.M{"$in": filter.Sources}
+	}
+	if len(filter.Mediums) > 0 {
+		assetMatch["projectMetadata.latest.medium"] = bson.M{"$in": filter.Mediums}
+	}
+	if len(filter.Artists) > 0 {
+		assetMatch["$or"] = bson.A{
+			bson.M{"projectMetadata.latest.artistID": bson.M{"$in": filter.Artists}},
+			bson.M{"projectMetadata.latest.artists.id": bson.M{"$in": filter.Artists}},
+		}
+	}
+
+	tokenMatch := bson.A{
+		bson.M{"$expr": bson.M{"$eq": bson.A{"$assetID", "$$assetID"}}},
+		bson.M{"burned": bson.M{"$ne": true}},
+	}
+	if len(filter.Blockchains) > 0 {
+		tokenMatch = append(tokenMatch, bson.M{"blockchain": bson.M{"$in": filter.Blockchains}})
+	}
+	if filter.MintedFrom != nil {
+		tokenMatch = append(tokenMatch, bson.M{"mintedAt": bson.M{"$gte": *filter.MintedFrom}})
+	}
+	if filter.MintedTo != nil {
+		tokenMatch = append(tokenMatch, bson.M{"mintedAt": bson.M{"$lte": *filter.MintedTo}})
+	}
+	if len(filter.CollectionIDs) > 0 {
+		indexIDs, err := s.collectionAssetsCollection.Distinct(ctx, "tokenIndexID", bson.M{"collectionID": bson.M{"$in": filter.CollectionIDs}})
+		if err != nil {
+			return result, err
+		}
+
+		if len(indexIDs) == 0 {
+			return result, nil
+		}
+		tokenMatch = append(tokenMatch, bson.M{"indexID": bson.M{"$in": indexIDs}})
+
+		// match only the assets of the collections instead of all the assets
+		assetIDs, err := s.tokenCollection.Distinct(ctx, "assetID", bson.M{"indexID": bson.M{"$in": indexIDs}})
+		if err != nil {
+			return result, err
+		}
+		assetMatch["id"] = bson.M{"$in": assetIDs}
+	}
+
+	pipeline := []bson.M{
+		{"$match": assetMatch},
+		{"$project": bson.M{
+			"_id":        0,
+			"id":         1,
+			"score":      score,
+			"medium":     "$projectMetadata.latest.medium",
+			"artistID":   "$projectMetadata.latest.artistID",
+			"artistName": "$projectMetadata.latest.artistName",
+		}},
+		{"$lookup": bson.M{
+			"from": tokenCollectionName,
+			"let":  bson.M{"assetID": "$id"},
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{"$and": tokenMatch}},
+				bson.M{"$project": bson.M{"_id": 0, "indexID": 1, "blockchain": 1}},
+			},
+			"as": "token",
+		}},
+		{"$unwind": "$token"},
+		{"$addFields": bson.M{
+			"indexID":    "$token.indexID",
+			"blockchain": "$token.blockchain",
+		}},
+		{"$facet": bson.M{
+			"tokens": bson.A{
+				bson.M{"$match": resultMatch},
+				bson.M{"$sort": bson.D{{Key: "score", Value: -1}, {Key: "indexID", Value: 1}}},
+				bson.M{"$limit": filter.Size + 1},
+				bson.M{"$project": bson.M{"score": 1, "indexID": 1}},
+			},
+			"total": bson.A{
+				bson.M{"$count": "count"},
+			},
+			"blockchains": bson.A{
+				bson.M{"$sortByCount": "$blockchain"},
+			},
+			"mediums": bson.A{
+				bson.M{"$sortByCount": "$medium"},
+			},
+			"artists": bson.A{
+				bson.M{"$match": bson.M{"artistID": bson.M{"$nin": bson.A{nil, ""}}}},
+				bson.M{"$group": bson.M{
+					"_id":   "$artistID",
+					"label": bson.M{"$first": "$artistName"},
+					"count": bson.M{"$sum": 1},
+				}},
+				bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}},
+				bson.M{"$limit": tokenSearchFacetSize},
+			},
+			"collections": bson.A{
+				bson.M{"$lookup": bson.M{
+					"from":         collectionAssetsCollectionName,
+					"localField":   "indexID",
+					"foreignField": "tokenIndexID",
+					"as":           "collectionAsset",
+				}},
+				bson.M{"$unwind": "$collectionAsset"},
+				bson.M{"$sortByCount": "$collectionAsset.collectionID"},
+				bson.M{"$limit": tokenSearchFacetSize},
+				bson.M{"$lookup": bson.M{
+					"from":         collectionsCollectionName,
+					"localField":   "_id",
+					"foreignField": "id",
+					"as":           "collection",
+				}},
+				bson.M{"$addFields": bson.M{"label": bson.M{"$ifNull": bson.A{bson.M{"$first": "$collection.name"}, ""}}}},
+				bson.M{"$project": bson.M{"collection": 0}},
+			},
+		}},
+	}
+
+	cursor, err := s.assetCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return result, err
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	var aggregation []struct {
+		TokenSearchFacets `bson:",inline"`
+		Tokens            []tokenSearchCursor `bson:"tokens"`
+		Total             []struct {
+			Count int64 `bson:"count"`
+		} `bson:"total"`
+	}
+	if err := cursor.All(ctx, &aggregation); err != nil {
+		return result, err
+	}
+
+	if len(aggregation) == 0 {
+		return result, nil
+	}
+
+	a := aggregation[0]
+	result.Facets = a.TokenSearchFacets
+	if len(a.Total) > 0 {
+		result.Total = a.Total[0].Count
+	}
+
+	if int64(len(a.Tokens)) > filter.Size {
+		a.Tokens = a.Tokens[:filter.Size]
+		result.NextCursor = encodeTokenSearchCursor(a.Tokens[len(a.Tokens)-1])
+	}
+
+	if len(a.Tokens) == 0 {
+		return result, nil
+	}
+
+	indexIDs := make([]string, 0, len(a.Tokens))
+	for _, t := range a.Tokens {
+		indexIDs = append(indexIDs, t.IndexID)
+	}
+
+	// the tokens are returned in the order of the ids
+	tokens, err := s.GetDetailedTokensV2(ctx, FilterParameter{IDs: indexIDs}, 0, int64(len(indexIDs)))
+	if err != nil {
+		return result, err
+	}
+	result.Tokens = tokens
+
+	return result, nil
+}
+
 // GetIdentity returns an identity of an account
 func (s *MongodbIndexerStore) GetIdentity(ctx context.Context, accountNumber string) (AccountIdentity, error) {
 	var identity AccountIdentity
@@ -1552,6 +1812,178 @@ func (s *MongodbIndexerStore) UpdateOwnerForFungibleToken(ctx context.Context, i
 	return nil
 }
 
+// FungibleBalanceEventID returns the deterministic id of the transfer of a fungible token by
+// the transaction, the log index and the position of the token in a batch transfer
+func FungibleBalanceEventID(indexID, txID string, eventIndex, batchIndex uint) string {
+	return fmt.Sprintf("%s-%s-%d-%d", indexID, txID, eventIndex, batchIndex)
+}
+
+// UpdateFungibleTokenBalances applies the balance deltas of an event to the owners of a fungible
+// token and its account tokens in a transaction. Debits are applied before credits. The event is
+// recorded in the same transaction, so the deltas of an event are applied once however many times
+// the event is processed. ErrBalanceDrifted is returned when a debit is larger than the known
+// balance of an owner and no delta is applied.
+func (s *MongodbIndexerStore) UpdateFungibleTokenBalances(ctx context.Context, indexID, eventID string, deltas map[string]int64, activityTime time.Time) error {
+	session, err := s.mongoClient.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		if _, err := s.fungibleBalanceEventsCollection.InsertOne(sessCtx, FungibleBalanceEvent{
+			ID:        eventID,
+			IndexID:   indexID,
+			Deltas:    deltas,
+			AppliedAt: time.Now(),
+		}); err != nil {
+			return nil, err
+		}
+
+		for _, owner := range fungibleBalanceOwners(deltas) {
+			if err := s.updateFungibleTokenBalance(sessCtx, indexID, owner, deltas[owner], activityTime); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		log.InfoWithContext(ctx, "balance deltas of the event are applied already",
+			zap.String("indexID", indexID), zap.String("eventID", eventID))
+		return nil
+	}
+
+	return err
+}
+
+// RevertFungibleTokenBalances reverses the balance deltas of an event which were applied to a
+// fungible token and removes the event in a transaction. Nothing is reversed if the deltas of
+// the event were never applied. ErrBalanceDrifted is returned when a reversed credit is larger
+// than the known balance of an owner.
+func (s *MongodbIndexerStore) RevertFungibleTokenBalances(ctx context.Context, indexID, eventID string, activityTime time.Time) error {
+	session, err := s.mongoClient.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		var event FungibleBalanceEvent
+		err := s.fungibleBalanceEventsCollection.FindOneAndDelete(sessCtx,
+			bson.M{"_id": eventID, "indexID": indexID}).Decode(&event)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				log.InfoWithContext(ctx, "balance deltas of the event are not applied, skip reverting",
+					zap.String("indexID", indexID), zap.String("eventID", eventID))
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		reversed := map[string]int64{}
+		for owner, delta := range event.Deltas {
+			reversed[owner] = -delta
+		}
+
+		for _, owner := range fungibleBalanceOwners(reversed) {
+			if err := s.updateFungibleTokenBalance(sessCtx, indexID, owner, reversed[owner], activityTime); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+
+	return err
+}
+
+// updateFungibleTokenBalance applies the balance delta of an owner to a fungible token and the account token
+func (s *MongodbIndexerStore) updateFungibleTokenBalance(ctx context.Context, indexID, owner string, delta int64, activityTime time.Time) error {
+	filter, update := fungibleBalanceUpdate(indexID, owner, delta, activityTime)
+
+	var token Token
+	err := s.tokenCollection.FindOneAndUpdate(ctx, filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&token)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			if delta < 0 {
+				return ErrBalanceDrifted
+			}
+			return ErrNoRecordUpdated
+		}
+		return err
+	}
+
+	ownerKey := "owners." + owner
+	balance := token.Owners[owner]
+	if balance == 0 {
+		if _, err := s.tokenCollection.UpdateOne(ctx,
+			bson.M{"indexID": indexID, ownerKey: 0},
+			bson.M{
+				"$unset": bson.M{ownerKey: ""},
+				"$pull":  bson.M{"ownersArray": owner},
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	if _, err := s.accountTokenCollection.UpdateOne(ctx,
+		bson.M{"indexID": indexID, "ownerAccount": owner},
+		bson.M{"$set": AccountToken{
+			BaseTokenInfo:     token.BaseTokenInfo,
+			IndexID:           indexID,
+			OwnerAccount:      owner,
+			Balance:           balance,
+			LastActivityTime:  activityTime,
+			LastRefreshedTime: time.Now(),
+		}},
+		options.Update().SetUpsert(true),
+	); err != nil {
+		log.ErrorWithContext(ctx, errors.New("cannot update account token balance"),
+			zap.String("indexID", indexID), zap.String("owner", owner), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// fungibleBalanceOwners returns the owners with non-zero deltas, debits first
+func fungibleBalanceOwners(deltas map[string]int64) []string {
+	owners := make([]string, 0, len(deltas))
+	for owner, delta := range deltas {
+		if delta != 0 {
+			owners = append(owners, owner)
+		}
+	}
+	sort.Slice(owners, func(i, j int) bool {
+		if deltas[owners[i]] != deltas[owners[j]] {
+			return deltas[owners[i]] < deltas[owners[j]]
+		}
+		return owners[i] < owners[j]
+	})
+
+	return owners
+}
+
+// fungibleBalanceUpdate returns the filter and the update of a balance delta. A debit only
+// matches when the owner holds enough balance.
+func fungibleBalanceUpdate(indexID, owner string, delta int64, activityTime time.Time) (bson.M, bson.M) {
+	ownerKey := "owners." + owner
+
+	filter := bson.M{"indexID": indexID}
+	update := bson.M{
+		"$inc": bson.M{ownerKey: delta},
+		"$max": bson.M{"lastActivityTime": activityTime},
+	}
+	if delta < 0 {
+		filter[ownerKey] = bson.M{"$gte": -delta}
+	} else {
+		update["$addToSet"] = bson.M{"ownersArray": owner}
+	}
+
+	return filter, update
+}
+
 func (s *MongodbIndexerStore) GetTokenByIndexID(ctx context.Context, indexID string) (*Token, error) {
 	tokens, err := s.GetTokensByIndexIDs(ctx, []string{indexID})
 	if err != nil {
@@ -2147,6 +2579,56 @@ func (s *MongodbIndexerStore) UpdateCollectionCreators(ctx context.Context, coll
 	return err
 }
 
+// AddCollectionCreator adds a creator to the collection identified by collectionID
+// if it is not already present, and updates lastUpdatedTime.
+func (s *MongodbIndexerStore) AddCollectionCreator(ctx context.Context, collectionID, creator string) error {
+	_, err := s.collectionsCollection.UpdateOne(ctx,
+		bson.M{"id": collectionID},
+		bson.M{
+			"$addToSet": bson.M{"creators": creator},
+			"$set":      bson.M{"lastUpdatedTime": time.Now()},
+		},
+	)
+	return err
+}
+
+// RemoveCollectionCreator removes a creator from the collection identified by collectionID
+// and updates lastUpdatedTime. The collection is kept even if it has no creator left.
+func (s *MongodbIndexerStore) RemoveCollectionCreator(ctx context.Context, collectionID, creator string) error {
+	_, err := s.collectionsCollection.UpdateOne(ctx,
+		bson.M{"id": collectionID},
+		bson.M{
+			"$pull": bson.M{"creators": creator},
+			"$set":  bson.M{"lastUpdatedTime": time.Now()},
+		},
+	)
+	return err
+}
+
+// AssignCollectionCreator replaces oldCreator with newCreator in the creators array of the
+// collection identified by collectionID. The position of the creator is kept. oldCreator is
+// only removed when newCreator is already one of the creators.
+func (s *MongodbIndexerStore) AssignCollectionCreator(ctx context.Context, collectionID, oldCreator, newCreator string) error {
+	r, err := s.collectionsCollection.UpdateOne(ctx,
+		bson.M{"id": collectionID, "creators": bson.M{"$eq": oldCreator, "$ne": newCreator}},
+		bson.M{
+			"$set": bson.M{
+				"creators.$":      newCreator,
+				"lastUpdatedTime": time.Now(),
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if r.MatchedCount > 0 {
+		return nil
+	}
+
+	return s.RemoveCollectionCreator(ctx, collectionID, oldCreator)
+}
+
 // GetCollectionLastUpdateTimeeForOwner returns collection last refreshed time for an owner
 func (s *MongodbIndexerStore) GetCollectionLastUpdatedTimeForCreator(ctx context.Context, creator string) (time.Time, error) {
 	findOptions := options.FindOne().SetSort(bson.D{{Key: "lastUpdatedTime", Value: -1}})
@@ -2314,14 +2796,79 @@ var reserved = map[string]struct{}{
 	"timestamp": {},
 	"values":    {},
 	"uniqueID":  {},
+	"saleID":    {},
+}
+
+// SaleID returns the deterministic id of a sale from the blockchain, the transaction ids and the
+// index of the sale in the transactions, e.g. the log index of the sale event or the bundle
+// number. The sold tokens are not a part of the id, so that a re-parse of the transactions which
+// changes the tokens keeps the id of the sale.
+func SaleID(blockchain string, transactionIDs []string, saleIndex string) string {
+	txIDs := append([]string{}, transactionIDs...)
+	sort.Strings(txIDs)
+
+	return HexSha1(fmt.Sprintf("%s|%s|%s", blockchain, strings.Join(txIDs, ","), saleIndex))
 }
 
-// WriteTimeSeriesData - validate and store a time series record
+// saleTransactions returns the blockchain and the sorted transaction ids of a time series record
+func saleTransactions(r GenericSalesTimeSeries) (string, []string, error) {
+	blockchain, ok := r.Metadata["blockchain"].(string)
+	if !ok {
+		return "", nil, fmt.Errorf("wrong format: metadata.blockchain is not a string")
+	}
+
+	txIDs, ok := r.Metadata["transactionIDs"].([]interface{})
+	if !ok {
+		return "", nil, fmt.Errorf("wrong format: metadata.transactionIDs is not a slice")
+	}
+
+	transactionIDs := make([]string, 0, len(txIDs))
+	for i, v := range txIDs {
+		txID, ok := v.(string)
+		if !ok {
+			return "", nil, fmt.Errorf("wrong format: metadata.transactionIDs[%d] is not a string", i)
+		}
+		transactionIDs = append(transactionIDs, txID)
+	}
+	sort.Strings(transactionIDs)
+
+	return blockchain, transactionIDs, nil
+}
+
+// saleRecordID returns the sale id of a validated time series record. The sale index is read from
+// metadata.saleIndex and falls back to the position of the record among the records of the same
+// transactions.
+func saleRecordID(r GenericSalesTimeSeries, position int) (string, error) {
+	blockchain, transactionIDs, err := saleTransactions(r)
+	if err != nil {
+		return "", err
+	}
+
+	saleIndex := fmt.Sprint(position)
+	if v, ok := r.Metadata["saleIndex"]; ok {
+		saleIndex = fmt.Sprint(v)
+	}
+
+	return SaleID(blockchain, transactionIDs, saleIndex), nil
+}
+
+// saleTransactionSales are the sales of the same transactions in a write of time series records
+type saleTransactionSales struct {
+	blockchain     string
+	transactionIDs []string
+	sales          map[string]bson.M
+}
+
+// WriteTimeSeriesData - validate and store a time series record. The records of the same
+// transactions replace all the stored sales of the transactions in a transaction, so that the
+// transactions can be re-indexed without duplicates or stale sales. The records must therefore
+// carry all the sales of their transactions.
 func (s *MongodbIndexerStore) WriteTimeSeriesData(
 	ctx context.Context,
 	records []GenericSalesTimeSeries,
 ) error {
-	insertsMap := make(map[string]interface{})
+	var transactions []*saleTransactionSales
+	transactionsByKey := make(map[string]*saleTransactionSales)
 	for _, r := range records {
 		timestamp, err := time.Parse(time.RFC3339Nano, r.Timestamp)
 		if nil != err {
@@ -2332,7 +2879,6 @@ func (s *MongodbIndexerStore) WriteTimeSeriesData(
 			return err
 		}
 
-		var saleTokenUniqueIDs []string
 		for k, v := range r.Metadata {
 			// ensure no reserved fields in metadata
 			if _, ok := reserved[k]; ok {
@@ -2353,50 +2899,40 @@ func (s *MongodbIndexerStore) WriteTimeSeriesData(
 					if !ok {
 						return fmt.Errorf("wrong format: metadata.%s[%d] is not a map[string]interface{}", k, i)
 					}
-					var ca string
 					if m["contractAddress"] != nil {
-						ca, ok = m["contractAddress"].(string)
-						if !ok {
+						if _, ok := m["contractAddress"].(string); !ok {
 							return fmt.Errorf("wrong format: metadata.%s[%d][%s] is not a string", k, i, `"contractAddress"`)
 						}
 					}
-					saleTokenUniqueIDs = append(saleTokenUniqueIDs,
-						fmt.Sprintf("%s-%s-%s",
-							r.Metadata["blockchain"].(string),
-							ca,
-							m["tokenID"].(string)))
+					if _, ok := m["tokenID"].(string); !ok {
+						return fmt.Errorf("wrong format: metadata.%s[%d][%s] is not a string", k, i, `"tokenID"`)
+					}
 				}
 			}
 		}
 
-		var transactionIDs []string
-		txIDs, ok := r.Metadata["transactionIDs"].([]interface{})
-		if !ok {
-			return fmt.Errorf("wrong format: metadata.transactionIDs is not a slice")
+		blockchain, transactionIDs, err := saleTransactions(r)
+		if err != nil {
+			return err
 		}
 
-		for i, v := range txIDs {
-			txID, ok := v.(string)
-			if !ok {
-				return fmt.Errorf("wrong format: metadata.transactionIDs[%d] is not a string", i)
+		key := blockchain + "|" + strings.Join(transactionIDs, ",")
+		transaction, ok := transactionsByKey[key]
+		if !ok {
+			transaction = &saleTransactionSales{
+				blockchain:     blockchain,
+				transactionIDs: transactionIDs,
+				sales:          make(map[string]bson.M),
 			}
-			transactionIDs = append(transactionIDs, txID)
+			transactionsByKey[key] = transaction
+			transactions = append(transactions, transaction)
 		}
 
-		sort.Strings(saleTokenUniqueIDs)
-		sort.Strings(transactionIDs)
-
-		uniqueID := HexSha1(fmt.Sprintf("%s|%s",
-			strings.Join(transactionIDs, ","),
-			strings.Join(saleTokenUniqueIDs, ","),
-		))
-
-		// skip duplicate
-		if _, existed := insertsMap[uniqueID]; existed {
-			continue
+		saleID, err := saleRecordID(r, len(transaction.sales))
+		if err != nil {
+			return err
 		}
-
-		r.Metadata["uniqueID"] = uniqueID
+		r.Metadata["saleID"] = saleID
 
 		// root of the BSON document
 		doc := bson.M{
@@ -2452,40 +2988,42 @@ func (s *MongodbIndexerStore) WriteTimeSeriesData(
 		}
 		doc["shares"] = sv
 
-		filter := bson.M{
-			"metadata.uniqueID": uniqueID,
-		}
-
-		log.Debug("deleting documents",
-			zap.String("uniqueID", uniqueID),
-			zap.Error(err))
+		// the last record of a sale wins
+		transaction.sales[saleID] = doc
+	}
 
-		result, err := s.salesTimeSeriesCollection.DeleteMany(ctx, filter)
-		if err != nil {
-			log.ErrorWithContext(ctx, errors.New("error deleting documents"),
-				zap.String("uniqueID", uniqueID),
-				zap.Error(err))
-			return err
-		}
-		if result.DeletedCount > 0 {
-			log.InfoWithContext(ctx, "deleted duplicated documents",
-				zap.Int64("deletedCount", result.DeletedCount),
-				zap.Any("record", r.Metadata))
-		}
-		insertsMap[uniqueID] = doc
+	if len(transactions) == 0 {
+		return nil
 	}
 
-	var inserts []interface{}
-	for _, doc := range insertsMap {
-		inserts = append(inserts, doc)
+	session, err := s.mongoClient.StartSession()
+	if err != nil {
+		return err
 	}
+	defer session.EndSession(ctx)
 
-	if len(inserts) > 0 {
-		_, err := s.salesTimeSeriesCollection.InsertMany(ctx, inserts)
-		if err != nil {
-			log.ErrorWithContext(ctx, errors.New("error inserting documents"), zap.Error(err))
-			return err
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		for _, t := range transactions {
+			if _, err := s.salesCollection.DeleteMany(sessCtx, bson.M{
+				"metadata.blockchain":     t.blockchain,
+				"metadata.transactionIDs": bson.M{"$all": t.transactionIDs, "$size": len(t.transactionIDs)},
+			}); err != nil {
+				return nil, err
+			}
+
+			docs := make([]interface{}, 0, len(t.sales))
+			for _, doc := range t.sales {
+				docs = append(docs, doc)
+			}
+			if _, err := s.salesCollection.InsertMany(sessCtx, docs); err != nil {
+				return nil, err
+			}
 		}
+		return nil, nil
+	})
+	if err != nil {
+		log.ErrorWithContext(ctx, errors.New("error replacing sales"), zap.Error(err))
+		return err
 	}
 
 	return nil
@@ -2496,11 +3034,18 @@ func (s *MongodbIndexerStore) WriteHistoricalExchangeRate(ctx context.Context, r
 
 	for _, r := range records {
 		filter := bson.M{"timestamp": r.Time, "currencyPair": r.CurrencyPair}
-		update := bson.M{"$set": bson.M{
+		set := bson.M{
 			"timestamp":    r.Time,
 			"price":        r.Open,
+			"high":         r.High,
+			"low":          r.Low,
+			"close":        r.Close,
 			"currencyPair": r.CurrencyPair,
-		}}
+		}
+		if r.Granularity > 0 {
+			set["granularity"] = r.Granularity
+		}
+		update := bson.M{"$set": set}
 		model := mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(update).SetUpsert(true)
 		operations = append(operations, model)
 	}
@@ -2575,9 +3120,161 @@ func (s *MongodbIndexerStore) GetHistoricalExchangeRate(ctx context.Context, fil
 	return closestExchangeRate, nil
 }
 
+// GetExchangeRateSeries returns the candles of a currency pair in a time range. The crawled
+// rates are grouped by the interval in UTC, and the weeks start on Monday. The rates which
+// are crawled with the open price only use it as the high, low and close prices.
+func (s *MongodbIndexerStore) GetExchangeRateSeries(ctx context.Context, filter ExchangeRateSeriesFilter) ([]ExchangeRateCandle, error) {
+	unit, binSize, err := exchangeRateSeriesBin(filter.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	pipelines := []bson.M{
+		{"$match": bson.M{
+			"currencyPair": filter.CurrencyPair,
+			"timestamp": bson.M{
+				"$gte": filter.From.UTC(),
+				"$lt":  filter.To.UTC(),
+			},
+		}},
+		{"$sort": bson.M{"timestamp": 1}},
+		{"$group": bson.M{
+			"_id": bson.M{"$dateTrunc": bson.M{
+				"date":        "$timestamp",
+				"unit":        unit,
+				"binSize":     binSize,
+				"startOfWeek": "monday",
+				"timezone":    "UTC",
+			}},
+			"open":  bson.M{"$first": "$price"},
+			"high":  bson.M{"$max": bson.M{"$ifNull": bson.A{"$high", "$price"}}},
+			"low":   bson.M{"$min": bson.M{"$ifNull": bson.A{"$low", "$price"}}},
+			"close": bson.M{"$last": bson.M{"$ifNull": bson.A{"$close", "$price"}}},
+		}},
+		{"$sort": bson.M{"_id": 1}},
+		{"$project": bson.M{
+			"_id":          0,
+			"timestamp":    "$_id",
+			"open":         1,
+			"high":         1,
+			"low":          1,
+			"close":        1,
+			"currencyPair": bson.M{"$literal": filter.CurrencyPair},
+		}},
+	}
+
+	cursor, err := s.historicalExchangeRatesCollection.Aggregate(ctx, pipelines)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	candles := []ExchangeRateCandle{}
+	if err := cursor.All(ctx, &candles); err != nil {
+		return nil, err
+	}
+
+	return candles, nil
+}
+
+// GetExchangeRateGaps returns the time ranges from and to in which a currency pair has no
+// exchange rates. A rate covers the window of its own granularity, or of the given granularity
+// if it is crawled without one, so the windows of the coarse rates are not crawled again.
+func (s *MongodbIndexerStore) GetExchangeRateGaps(ctx context.Context, currencyPair string, from, to time.Time, granularity time.Duration) ([]ExchangeRateGap, error) {
+	from = from.UTC()
+	to = to.UTC()
+
+	pipelines := []bson.M{
+		{"$match": bson.M{
+			"currencyPair": currencyPair,
+			"timestamp": bson.M{
+				"$gte": from,
+				"$lt":  to,
+			},
+		}},
+		{"$addFields": bson.M{
+			"end": bson.M{"$add": bson.A{"$timestamp", bson.M{"$multiply": bson.A{
+				bson.M{"$ifNull": bson.A{"$granularity", int64(granularity.Seconds())}}, 1000,
+			}}}},
+		}},
+		{"$facet": bson.M{
+			"gaps": bson.A{
+				bson.M{"$setWindowFields": bson.M{
+					"sortBy": bson.M{"timestamp": 1},
+					"output": bson.M{
+						// the end of the windows covered by the earlier rates
+						"covered": bson.M{"$max": "$end", "window": bson.M{"documents": bson.A{"unbounded", -1}}},
+					},
+				}},
+				// the first rate is compared with the start of the range
+				bson.M{"$addFields": bson.M{"covered": bson.M{"$ifNull": bson.A{"$covered", from}}}},
+				bson.M{"$match": bson.M{"$expr": bson.M{"$gt": bson.A{"$timestamp", "$covered"}}}},
+				bson.M{"$project": bson.M{"_id": 0, "covered": 1, "timestamp": 1}},
+			},
+			"end": bson.A{
+				bson.M{"$group": bson.M{"_id": nil, "end": bson.M{"$max": "$end"}}},
+			},
+		}},
+	}
+
+	cursor, err := s.historicalExchangeRatesCollection.Aggregate(ctx, pipelines)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	var result struct {
+		Gaps []struct {
+			Covered   time.Time `bson:"covered"`
+			Timestamp time.Time `bson:"timestamp"`
+		} `bson:"gaps"`
+		End []struct {
+			End time.Time `bson:"end"`
+		} `bson:"end"`
+	}
+	if cursor.Next(ctx) {
+		if err := cursor.Decode(&result); err != nil {
+			return nil, err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	gaps := make([]ExchangeRateGap, 0, len(result.Gaps)+1)
+	for _, r := range result.Gaps {
+		gaps = append(gaps, ExchangeRateGap{
+			CurrencyPair: currencyPair,
+			From:         r.Covered,
+			To:           r.Timestamp,
+		})
+	}
+
+	// the range after the windows of all the rates
+	lastFrom := from
+	if len(result.End) > 0 && result.End[0].End.After(from) {
+		lastFrom = result.End[0].End.UTC()
+	}
+	if lastFrom.Before(to) {
+		gaps = append(gaps, ExchangeRateGap{
+			CurrencyPair: currencyPair,
+			From:         lastFrom,
+			To:           to,
+		})
+	}
+
+	return gaps, nil
+}
+
 // SaleTimeSeriesDataExists - check if a sale time series data exists for a transaction hash and blockchain
 func (s *MongodbIndexerStore) SaleTimeSeriesDataExists(ctx context.Context, txID, blockchain string) (bool, error) {
-	count, err := s.salesTimeSeriesCollection.CountDocuments(ctx, bson.M{
+	count, err := s.salesCollection.CountDocuments(ctx, bson.M{
 		"metadata.transactionIDs": txID,
 		"metadata.blockchain":     blockchain,
 	})
@@ -2628,7 +3325,7 @@ func (s *MongodbIndexerStore) GetSaleTimeSeriesData(ctx context.Context, filter
 		bson.M{"$limit": filter.Limit},
 	)
 
-	cursor, err := s.salesTimeSeriesCollection.Aggregate(ctx, pipelines)
+	cursor, err := s.salesCollection.Aggregate(ctx, pipelines)
 
 	if err != nil {
 		return nil, err
@@ -2690,7 +3387,7 @@ func (s *MongodbIndexerStore) AggregateSaleRevenues(ctx context.Context, filter
 		}},
 	}
 
-	cursor, err := s.salesTimeSeriesCollection.Aggregate(ctx, pipelines)
+	cursor, err := s.salesCollection.Aggregate(ctx, pipelines)
 
 	if err != nil {
 		return nil, err
@@ -2712,9 +3409,169 @@ func (s *MongodbIndexerStore) AggregateSaleRevenues(ctx context.Context, filter
 	return resultMap, nil
 }
 
-func (s *MongodbIndexerStore) GetExchangeRateLastTime(ctx context.Context) (time.Time, error) {
+// salesAnalyticsTokenFilter returns the filter of the sales which contain the tokens of a
+// collection, the collections of an artist or a contract. It returns nil if there are no tokens.
+func (s *MongodbIndexerStore) salesAnalyticsTokenFilter(ctx context.Context, filter SalesAnalyticsFilter) (bson.M, error) {
+	var collectionIDs []interface{}
+	switch filter.Scope {
+	case SalesAnalyticsScopeContract:
+		contract := filter.ID
+		if strings.HasPrefix(contract, "0x") {
+			contract = EthereumChecksumAddress(contract)
+		}
+		return bson.M{"metadata.bundleTokenInfo.contractAddress": contract}, nil
+	case SalesAnalyticsScopeCollection:
+		collectionIDs = []interface{}{filter.ID}
+	case SalesAnalyticsScopeArtist:
+		ids, err := s.collectionsCollection.Distinct(ctx, "id", bson.M{"creators": filter.ID})
+		if err != nil {
+			return nil, err
+		}
+		collectionIDs = ids
+	default:
+		return nil, ErrUnsupportedSalesAnalyticsScope
+	}
+
+	if len(collectionIDs) == 0 {
+		return nil, nil
+	}
+
+	indexIDs, err := s.collectionAssetsCollection.Distinct(ctx, "tokenIndexID", bson.M{"collectionID": bson.M{"$in": collectionIDs}})
+	if err != nil {
+		return nil, err
+	}
+
+	// group the token ids by contracts to keep the filter small for large collections
+	contractTokens := make(map[string][]string)
+	for _, v := range indexIDs {
+		indexID, ok := v.(string)
+		if !ok {
+			continue
+		}
+
+		_, contract, tokenID, err := ParseTokenIndexID(indexID)
+		if err != nil {
+			log.WarnWithContext(ctx, "invalid collection asset index id", zap.String("indexID", indexID), zap.Error(err))
+			continue
+		}
+		contractTokens[contract] = append(contractTokens[contract], tokenID)
+	}
+
+	if len(contractTokens) == 0 {
+		return nil, nil
+	}
+
+	tokenFilter := bson.A{}
+	for contract, tokenIDs := range contractTokens {
+		tokenFilter = append(tokenFilter, bson.M{
+			"metadata.bundleTokenInfo": bson.M{"$elemMatch": bson.M{
+				"contractAddress": contract,
+				"tokenID":         bson.M{"$in": tokenIDs},
+			}},
+		})
+	}
+
+	return bson.M{"$or": tokenFilter}, nil
+}
+
+// GetSalesAnalytics returns the daily or weekly USD volumes and the top buyers and sellers of the sales
+// of a collection, an artist or a contract. The sales are grouped by a single aggregation (see
+// salesAnalyticsPipeline), and the number of the top buyers and sellers is capped by MaxSalesAnalyticsLimit.
+func (s *MongodbIndexerStore) GetSalesAnalytics(ctx context.Context, filter SalesAnalyticsFilter) (SalesAnalytics, error) {
+	if !IsSupportedSalesAnalyticsInterval(filter.Interval) {
+		return SalesAnalytics{}, ErrUnsupportedSalesAnalyticsInterval
+	}
+
+	match, err := s.salesAnalyticsTokenFilter(ctx, filter)
+	if err != nil {
+		return SalesAnalytics{}, err
+	}
+
+	if match == nil {
+		return emptySalesAnalytics(), nil
+	}
+
+	if filter.Marketplace != "" {
+		match["metadata.marketplace"] = filter.Marketplace
+	}
+
+	timestampFilter := bson.M{}
+	if filter.From != nil {
+		timestampFilter["$gte"] = filter.From
+	}
+	if filter.To != nil {
+		timestampFilter["$lte"] = filter.To
+	}
+	if len(timestampFilter) > 0 {
+		match["timestamp"] = timestampFilter
+	}
+
+	firstRates, err := s.firstExchangeRates(ctx)
+	if err != nil {
+		return SalesAnalytics{}, err
+	}
+
+	cursor, err := s.salesCollection.Aggregate(ctx, salesAnalyticsPipeline(match, filter.Interval, salesAnalyticsLimit(filter.Limit), firstRates))
+	if err != nil {
+		return SalesAnalytics{}, err
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	analytics := emptySalesAnalytics()
+	if cursor.Next(ctx) {
+		if err := cursor.Decode(&analytics); err != nil {
+			return SalesAnalytics{}, err
+		}
+	}
+
+	return analytics, cursor.Err()
+}
+
+// firstExchangeRates returns the prices of the earliest exchange rates of the currency pairs to USD
+func (s *MongodbIndexerStore) firstExchangeRates(ctx context.Context) (map[string]float64, error) {
+	pairs := []string{}
+	for currency := range SaleCurrencyDecimals {
+		if pair := ExchangeRateCurrencyPair(currency, "USD"); pair != "" {
+			pairs = append(pairs, pair)
+		}
+	}
+
+	cursor, err := s.historicalExchangeRatesCollection.Aggregate(ctx, []bson.M{
+		{"$match": bson.M{"currencyPair": bson.M{"$in": pairs}}},
+		{"$sort": bson.D{{Key: "currencyPair", Value: 1}, {Key: "timestamp", Value: 1}}},
+		{"$group": bson.M{"_id": "$currencyPair", "price": bson.M{"$first": "$price"}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx)
+	}()
+
+	var records []struct {
+		CurrencyPair string  `bson:"_id"`
+		Price        float64 `bson:"price"`
+	}
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+
+	rates := make(map[string]float64, len(records))
+	for _, r := range records {
+		rates[r.CurrencyPair] = r.Price
+	}
+	return rates, nil
+}
+
+// GetExchangeRateLastTime returns the time of the latest exchange rate of a currency pair. It
+// returns zero time if the currency pair has no exchange rates.
+func (s *MongodbIndexerStore) GetExchangeRateLastTime(ctx context.Context, currencyPair string) (time.Time, error) {
 	findOptions := options.FindOne().SetSort(bson.D{{Key: "timestamp", Value: -1}})
-	r := s.historicalExchangeRatesCollection.FindOne(ctx, bson.M{}, findOptions)
+	r := s.historicalExchangeRatesCollection.FindOne(ctx, bson.M{"currencyPair": currencyPair}, findOptions)
 
 	if err := r.Err(); err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
@@ -2784,3 +3641,107 @@ func (s *MongodbIndexerStore) CheckAssetCreator(
 	}
 	return count == int64(len(IDs)), nil
 }
+
+// AddOwnershipChange adds an ownership change for the subscribers. The addresses are normalized
+// and a change is added only once, so a change can be added again when its publishing is retried.
+func (s *MongodbIndexerStore) AddOwnershipChange(ctx context.Context, change OwnershipChange) error {
+	if change.CreatedAt.IsZero() {
+		change.CreatedAt = time.Now()
+	}
+	change.From = NormalizeAddress(change.From)
+	change.To = NormalizeAddress(change.To)
+
+	_, err := s.ownershipChangesCollection.UpdateOne(ctx,
+		bson.M{"_id": ownershipChangeID(change)},
+		bson.M{"$setOnInsert": change},
+		options.Update().SetUpsert(true),
+	)
+	return err
+}
+
+// ownershipChangeID returns the deterministic id of an ownership change
+func ownershipChangeID(change OwnershipChange) string {
+	return strings.Join([]string{change.IndexID, change.TxID, change.Type, change.From, change.To}, "-")
+}
+
+// WatchOwnershipChanges passes the ownership changes of the owners or the tokens to the handler
+// until the context is done or the handler returns an error. It starts after the change of the
+// resume token, or from now if the resume token is empty. It requires a replica set since the
+// changes are read from a change stream.
+func (s *MongodbIndexerStore) WatchOwnershipChanges(ctx context.Context, owners, indexIDs []string, resumeToken string, handle func(OwnershipChange) error) error {
+	conditions := bson.A{}
+	if len(owners) > 0 {
+		owners = NormalizeAddresses(owners)
+		conditions = append(conditions,
+			bson.M{"fullDocument.from": bson.M{"$in": owners}},
+			bson.M{"fullDocument.to": bson.M{"$in": owners}},
+		)
+	}
+	if len(indexIDs) > 0 {
+		conditions = append(conditions, bson.M{"fullDocument.indexID": bson.M{"$in": indexIDs}})
+	}
+	if len(conditions) == 0 {
+		return fmt.Errorf("either owners or indexIDs is required")
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"operationType": "insert",
+			"$or":           conditions,
+		}}},
+	}
+
+	opts := options.ChangeStream()
+	if resumeToken != "" {
+		token, err := decodeResumeToken(resumeToken)
+		if err != nil {
+			return err
+		}
+		opts.SetStartAfter(token)
+	}
+
+	stream, err := s.ownershipChangesCollection.Watch(ctx, pipeline, opts)
+	if err != nil {
+		return err
+	}
+	defer stream.Close(context.Background())
+
+	for stream.Next(ctx) {
+		var event struct {
+			FullDocument OwnershipChange `bson:"fullDocument"`
+		}
+		if err := stream.Decode(&event); err != nil {
+			return err
+		}
+
+		change := event.FullDocument
+		change.ResumeToken = encodeResumeToken(stream.ResumeToken())
+		if err := handle(change); err != nil {
+			return err
+		}
+	}
+
+	if ctx.Err() != nil {
+		return nil
+	}
+	return stream.Err()
+}
+
+// encodeResumeToken encodes a change stream resume token to an opaque string
+func encodeResumeToken(token bson.Raw) string {
+	return base64.RawURLEncoding.EncodeToString(token)
+}
+
+// decodeResumeToken decodes a resume token from encodeResumeToken
+func decodeResumeToken(token string) (bson.Raw, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidResumeToken
+	}
+
+	raw := bson.Raw(b)
+	if err := raw.Validate(); err != nil {
+		return nil, ErrInvalidResumeToken
+	}
+	return raw, nil
+}
@@ -0,0 +1,340 @@
+package indexer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+)
+
+// mockStore returns a store which collections are served by the mock deployment of a test
+func mockStore(mt *mtest.T) *MongodbIndexerStore {
+	return &MongodbIndexerStore{
+		mongoClient:                     mt.Client,
+		tokenCollection:                 mt.Coll,
+		accountTokenCollection:          mt.Coll,
+		fungibleBalanceEventsCollection: mt.Coll,
+
+		collectionsCollection:             mt.Coll,
+		collectionAssetsCollection:        mt.Coll,
+		salesCollection:                   mt.Coll,
+		historicalExchangeRatesCollection: mt.Coll,
+	}
+}
+
+// startedCommands returns the names of the commands sent to the mock deployment of a test
+func startedCommands(mt *mtest.T) []string {
+	commands := []string{}
+	for _, event := range mt.GetAllStartedEvents() {
+		commands = append(commands, event.CommandName)
+	}
+	return commands
+}
+
+func TestResumeToken(t *testing.T) {
+	raw, err := bson.Marshal(bson.M{"_data": "8265F2C5A1000000012B022C0100296E5A1004"})
+	assert.NoError(t, err)
+
+	token := encodeResumeToken(raw)
+	decoded, err := decodeResumeToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, bson.Raw(raw), decoded)
+
+	_, err = decodeResumeToken("not a token")
+	assert.ErrorIs(t, err, ErrInvalidResumeToken)
+
+	_, err = decodeResumeToken(encodeResumeToken([]byte{1, 2, 3}))
+	assert.ErrorIs(t, err, ErrInvalidResumeToken)
+}
+
+func TestSaleID(t *testing.T) {
+	id := SaleID("ethereum", []string{"0x2", "0x1"}, "0")
+	assert.Equal(t, id, SaleID("ethereum", []string{"0x1", "0x2"}, "0"))
+	assert.NotEqual(t, id, SaleID("ethereum", []string{"0x1", "0x2"}, "1"))
+	assert.NotEqual(t, id, SaleID("ethereum", []string{"0x1"}, "0"))
+	assert.NotEqual(t, id, SaleID("tezos", []string{"0x1", "0x2"}, "0"))
+	assert.Len(t, id, 40)
+}
+
+func TestSaleRecordIDOfSalesInOneTransaction(t *testing.T) {
+	sale := func(tokenID string) GenericSalesTimeSeries {
+		return GenericSalesTimeSeries{
+			Metadata: map[string]interface{}{
+				"blockchain":     "ethereum",
+				"transactionIDs": []interface{}{"0x1"},
+				"bundleTokenInfo": []interface{}{
+					map[string]interface{}{"contractAddress": "0xa", "tokenID": tokenID},
+				},
+			},
+		}
+	}
+
+	first, err := saleRecordID(sale("1"), 0)
+	assert.NoError(t, err)
+	second, err := saleRecordID(sale("2"), 1)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, second)
+
+	// a re-parse which changes the tokens keeps the id of the sale
+	reparsed, err := saleRecordID(sale("3"), 0)
+	assert.NoError(t, err)
+	assert.Equal(t, first, reparsed)
+
+	indexed := sale("1")
+	indexed.Metadata["saleIndex"] = 7
+	explicit, err := saleRecordID(indexed, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, SaleID("ethereum", []string{"0x1"}, "7"), explicit)
+
+	_, err = saleRecordID(GenericSalesTimeSeries{Metadata: map[string]interface{}{"blockchain": "ethereum"}}, 0)
+	assert.Error(t, err)
+}
+
+func TestWriteTimeSeriesDataOfReindexedTransaction(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("replace the sales of a re-parsed transaction", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 2}),
+			mtest.CreateSuccessResponse(bson.E{Key: "n", Value: 1}),
+			mtest.CreateSuccessResponse(),
+		)
+
+		// the transaction was indexed with the sales of two tokens and is re-parsed into one sale
+		// of another token
+		err := mockStore(mt).WriteTimeSeriesData(context.Background(), []GenericSalesTimeSeries{{
+			Timestamp: "2024-01-01T00:00:00Z",
+			Metadata: map[string]interface{}{
+				"blockchain":     "ethereum",
+				"transactionIDs": []interface{}{"0x2", "0x1"},
+				"bundleTokenInfo": []interface{}{
+					map[string]interface{}{"contractAddress": "0xa", "tokenID": "3"},
+				},
+			},
+			Values: map[string]string{"price": "1"},
+		}})
+		assert.NoError(mt, err)
+		assert.Equal(mt, []string{"delete", "insert", "commitTransaction"}, startedCommands(mt))
+
+		deletes := mt.GetStartedEvent()
+		assert.Equal(mt, "delete", deletes.CommandName)
+		filter := deletes.Command.Lookup("deletes", "0", "q")
+		assert.Equal(mt, "ethereum", filter.Document().Lookup("metadata.blockchain").StringValue())
+		txIDs := filter.Document().Lookup("metadata.transactionIDs").Document()
+		assert.Equal(mt, "0x1", txIDs.Lookup("$all", "0").StringValue())
+		assert.Equal(mt, "0x2", txIDs.Lookup("$all", "1").StringValue())
+		assert.Equal(mt, int32(2), txIDs.Lookup("$size").Int32())
+
+		inserts := mt.GetStartedEvent()
+		assert.Equal(mt, "insert", inserts.CommandName)
+		sale := inserts.Command.Lookup("documents", "0", "metadata").Document()
+		assert.Equal(mt, SaleID("ethereum", []string{"0x1", "0x2"}, "0"), sale.Lookup("saleID").StringValue())
+		assert.Equal(mt, "3", sale.Lookup("bundleTokenInfo", "0", "tokenID").StringValue())
+	})
+}
+
+func TestFungibleBalanceOwners(t *testing.T) {
+	owners := fungibleBalanceOwners(map[string]int64{
+		"0xc": 3,
+		"0xb": -2,
+		"0xa": -2,
+		"0xd": 0,
+		"0xe": 1,
+	})
+	assert.Equal(t, []string{"0xa", "0xb", "0xe", "0xc"}, owners)
+	assert.Empty(t, fungibleBalanceOwners(map[string]int64{"0xa": 0}))
+}
+
+func TestFungibleBalanceUpdate(t *testing.T) {
+	activityTime := time.Unix(1700000000, 0)
+
+	filter, update := fungibleBalanceUpdate("eth-0x1-1", "0xa", -2, activityTime)
+	assert.Equal(t, bson.M{"indexID": "eth-0x1-1", "owners.0xa": bson.M{"$gte": int64(2)}}, filter)
+	assert.Equal(t, bson.M{
+		"$inc": bson.M{"owners.0xa": int64(-2)},
+		"$max": bson.M{"lastActivityTime": activityTime},
+	}, update)
+
+	filter, update = fungibleBalanceUpdate("eth-0x1-1", "0xb", 3, activityTime)
+	assert.Equal(t, bson.M{"indexID": "eth-0x1-1"}, filter)
+	assert.Equal(t, bson.M{
+		"$inc":      bson.M{"owners.0xb": int64(3)},
+		"$max":      bson.M{"lastActivityTime": activityTime},
+		"$addToSet": bson.M{"ownersArray": "0xb"},
+	}, update)
+}
+
+func TestUpdateFungibleTokenBalances(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("apply the deltas of an event", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(),
+			mtest.CreateSuccessResponse(bson.E{Key: "value", Value: bson.D{
+				{Key: "indexID", Value: "eth-0x1-1"},
+				{Key: "owners", Value: bson.D{{Key: "0xa", Value: int64(3)}}},
+			}}),
+			mtest.CreateSuccessResponse(),
+			mtest.CreateSuccessResponse(),
+		)
+
+		err := mockStore(mt).UpdateFungibleTokenBalances(context.Background(), "eth-0x1-1", "eth-0x1-1-0x2-0-0",
+			map[string]int64{"0xa": 3}, time.Now())
+		assert.NoError(mt, err)
+		assert.Equal(mt, []string{"insert", "findAndModify", "update", "commitTransaction"}, startedCommands(mt))
+	})
+
+	mt.Run("skip an applied event", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateWriteErrorsResponse(mtest.WriteError{Code: 11000, Message: "duplicate key error"}),
+			mtest.CreateSuccessResponse(),
+		)
+
+		err := mockStore(mt).UpdateFungibleTokenBalances(context.Background(), "eth-0x1-1", "eth-0x1-1-0x2-0-0",
+			map[string]int64{"0xa": 3}, time.Now())
+		assert.NoError(mt, err)
+		assert.Equal(mt, []string{"insert", "abortTransaction"}, startedCommands(mt))
+	})
+}
+
+func TestRevertFungibleTokenBalances(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("reverse the applied deltas of an event", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "value", Value: bson.D{
+				{Key: "_id", Value: "eth-0x1-1-0x2-0-0"},
+				{Key: "indexID", Value: "eth-0x1-1"},
+				{Key: "deltas", Value: bson.D{{Key: "0xa", Value: int64(-3)}, {Key: "0xb", Value: int64(3)}}},
+			}}),
+			mtest.CreateSuccessResponse(bson.E{Key: "value", Value: bson.D{
+				{Key: "indexID", Value: "eth-0x1-1"},
+				{Key: "owners", Value: bson.D{{Key: "0xb", Value: int64(1)}}},
+			}}),
+			mtest.CreateSuccessResponse(),
+			mtest.CreateSuccessResponse(bson.E{Key: "value", Value: bson.D{
+				{Key: "indexID", Value: "eth-0x1-1"},
+				{Key: "owners", Value: bson.D{{Key: "0xa", Value: int64(3)}, {Key: "0xb", Value: int64(1)}}},
+			}}),
+			mtest.CreateSuccessResponse(),
+			mtest.CreateSuccessResponse(),
+		)
+
+		err := mockStore(mt).RevertFungibleTokenBalances(context.Background(), "eth-0x1-1", "eth-0x1-1-0x2-0-0", time.Now())
+		assert.NoError(mt, err)
+		assert.Equal(mt, []string{"findAndModify", "findAndModify", "update", "findAndModify", "update", "commitTransaction"},
+			startedCommands(mt))
+
+		// the receiver is debited before the sender is credited
+		events := mt.GetAllStartedEvents()
+		assert.Equal(mt, int64(-3), events[1].Command.Lookup("update", "$inc", "owners.0xb").Int64())
+		assert.Equal(mt, int64(3), events[3].Command.Lookup("update", "$inc", "owners.0xa").Int64())
+	})
+
+	mt.Run("skip an event which deltas are never applied", func(mt *mtest.T) {
+		mt.AddMockResponses(
+			mtest.CreateSuccessResponse(bson.E{Key: "value", Value: nil}),
+			mtest.CreateSuccessResponse(),
+		)
+
+		err := mockStore(mt).RevertFungibleTokenBalances(context.Background(), "eth-0x1-1", "eth-0x1-1-0x2-0-0", time.Now())
+		assert.NoError(mt, err)
+		// no balances are changed
+		assert.Equal(mt, []string{"findAndModify", "commitTransaction"}, startedCommands(mt))
+	})
+}
+
+func TestGetSalesAnalytics(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("decode the analytics of the sales of a contract", func(mt *mtest.T) {
+		week := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "indexer.historical_exchange_rates", mtest.FirstBatch,
+				bson.D{{Key: "_id", Value: "ETH-USD"}, {Key: "price", Value: 2000.0}}),
+			mtest.CreateCursorResponse(0, "indexer.sales_time_series", mtest.FirstBatch, bson.D{
+				{Key: "volumes", Value: bson.A{bson.D{
+					{Key: "timestamp", Value: week},
+					{Key: "sales", Value: int64(2)},
+					{Key: "volumeUSD", Value: 3000.0},
+					{Key: "medianPriceUSD", Value: 1000.0},
+					{Key: "floorPriceUSD", Value: 500.0},
+				}}},
+				{Key: "topBuyers", Value: bson.A{bson.D{
+					{Key: "address", Value: "0xb"}, {Key: "sales", Value: int64(2)}, {Key: "volumeUSD", Value: 3000.0},
+				}}},
+				{Key: "topSellers", Value: bson.A{}},
+			}),
+		)
+
+		analytics, err := mockStore(mt).GetSalesAnalytics(context.Background(), SalesAnalyticsFilter{
+			Scope:       SalesAnalyticsScopeContract,
+			ID:          "0x5000000000000000000000000000000000000005",
+			Interval:    SalesAnalyticsIntervalWeek,
+			Marketplace: "OpenSea",
+		})
+		assert.NoError(mt, err)
+		assert.Equal(mt, SalesAnalytics{
+			Volumes: []SalesVolume{{
+				Timestamp:      week,
+				Sales:          2,
+				VolumeUSD:      3000,
+				MedianPriceUSD: 1000,
+				FloorPriceUSD:  500,
+			}},
+			TopBuyers:  []SalesParticipant{{Address: "0xb", Sales: 2, VolumeUSD: 3000}},
+			TopSellers: []SalesParticipant{},
+		}, analytics)
+
+		// the sales are selected by the checksum address of the contract and the marketplace
+		events := mt.GetAllStartedEvents()
+		assert.Equal(mt, []string{"aggregate", "aggregate"}, startedCommands(mt))
+		filter := events[1].Command.Lookup("pipeline", "0", "$match")
+		assert.Equal(mt, "0x5000000000000000000000000000000000000005",
+			filter.Document().Lookup("metadata.bundleTokenInfo.contractAddress").StringValue())
+		assert.Equal(mt, "OpenSea", filter.Document().Lookup("metadata.marketplace").StringValue())
+	})
+
+	mt.Run("return empty analytics of an artist without collections", func(mt *mtest.T) {
+		mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "values", Value: bson.A{}}))
+
+		analytics, err := mockStore(mt).GetSalesAnalytics(context.Background(), SalesAnalyticsFilter{
+			Scope:    SalesAnalyticsScopeArtist,
+			ID:       "0xa",
+			Interval: SalesAnalyticsIntervalDay,
+		})
+		assert.NoError(mt, err)
+		assert.Equal(mt, emptySalesAnalytics(), analytics)
+		assert.Equal(mt, []string{"distinct"}, startedCommands(mt))
+	})
+
+	mt.Run("reject an unsupported interval", func(mt *mtest.T) {
+		_, err := mockStore(mt).GetSalesAnalytics(context.Background(), SalesAnalyticsFilter{
+			Scope:    SalesAnalyticsScopeContract,
+			ID:       "0xa",
+			Interval: "year",
+		})
+		assert.ErrorIs(mt, err, ErrUnsupportedSalesAnalyticsInterval)
+		assert.Empty(mt, startedCommands(mt))
+	})
+}
+
+func TestOwnershipChangeID(t *testing.T) {
+	change := OwnershipChange{
+		IndexID: "eth-0x1-1",
+		Type:    "transfer",
+		From:    "0xa",
+		To:      "0xb",
+		TxID:    "0xtx",
+	}
+	id := ownershipChangeID(change)
+
+	change.CreatedAt = time.Now()
+	assert.Equal(t, id, ownershipChangeID(change))
+
+	change.To = "0xc"
+	assert.NotEqual(t, id, ownershipChangeID(change))
+}
@@ -220,13 +220,26 @@ type DetailedTokenV2 struct {
 }
 
 type AssetV2 struct {
-	IndexID                   string           `json:"indexID" bson:"indexID"`
-	ThumbnailID               string           `json:"thumbnailID" bson:"thumbnailID"`
-	LastRefreshedTime         time.Time        `json:"lastRefreshedTime" bson:"lastRefreshedTime"`
-	Attributes                *AssetAttributes `json:"attributes" bson:"attributes,omitempty"`
-	Metadata                  AssetMetadata    `json:"metadata" bson:"metadata"`
-	StaticPreviewURLLandscape *string          `json:"staticPreviewURLLandscape" bson:"staticPreviewURLLandscape"`
-	StaticPreviewURLPortrait  *string          `json:"staticPreviewURLPortrait" bson:"staticPreviewURLPortrait"`
+	IndexID                   string            `json:"indexID" bson:"indexID"`
+	ThumbnailID               string            `json:"thumbnailID" bson:"thumbnailID"`
+	LastRefreshedTime         time.Time         `json:"lastRefreshedTime" bson:"lastRefreshedTime"`
+	Attributes                *AssetAttributes  `json:"attributes" bson:"attributes,omitempty"`
+	Metadata                  AssetMetadata     `json:"metadata" bson:"metadata"`
+	StaticPreviewURLLandscape *string           `json:"staticPreviewURLLandscape" bson:"staticPreviewURLLandscape"`
+	StaticPreviewURLPortrait  *string           `json:"staticPreviewURLPortrait" bson:"staticPreviewURLPortrait"`
+	Placeholder               *ImagePlaceholder `json:"placeholder,omitempty" bson:"placeholder,omitempty"`
+	PreviewClipID             string            `json:"previewClipID,omitempty" bson:"previewClipID,omitempty"`     // the preview clip of a video
+	DurationSeconds           float64           `json:"durationSeconds,omitempty" bson:"durationSeconds,omitempty"` // the duration of a video
+}
+
+// ImagePlaceholder is the dimensions and the placeholders of an asset thumbnail which
+// are shown while the thumbnail is loading
+type ImagePlaceholder struct {
+	Width          int      `json:"width" bson:"width"`
+	Height         int      `json:"height" bson:"height"`
+	AspectRatio    float64  `json:"aspectRatio" bson:"aspectRatio"`
+	Blurhash       string   `json:"blurhash" bson:"blurhash"`
+	DominantColors []string `json:"dominantColors" bson:"dominantColors"`
 }
 
 type AssetMetadata struct {
@@ -296,6 +309,29 @@ type Collection struct {
 	CreatedAt       time.Time `json:"createdAt" bson:"createdAt"`
 }
 
+// OwnershipChange is a change of the ownership or the provenance of a token
+type OwnershipChange struct {
+	ID        string    `json:"-" bson:"_id,omitempty"`
+	IndexID   string    `json:"indexID" bson:"indexID"`
+	Type      string    `json:"type" bson:"type"`
+	From      string    `json:"from" bson:"from"`
+	To        string    `json:"to" bson:"to"`
+	TxID      string    `json:"txID" bson:"txID"`
+	Timestamp time.Time `json:"timestamp" bson:"timestamp"`
+	CreatedAt time.Time `json:"createdAt" bson:"createdAt"`
+
+	// ResumeToken is the position of the change in the change stream
+	ResumeToken string `json:"resumeToken" bson:"-"`
+}
+
+// FungibleBalanceEvent is an event which balance deltas are applied to a fungible token
+type FungibleBalanceEvent struct {
+	ID        string           `bson:"_id"`
+	IndexID   string           `bson:"indexID"`
+	Deltas    map[string]int64 `bson:"deltas"`
+	AppliedAt time.Time        `bson:"appliedAt"`
+}
+
 type CollectionAsset struct {
 	CollectionID     string    `json:"collectionID" bson:"collectionID"`
 	TokenIndexID     string    `json:"tokenIndexID" bson:"tokenIndexID"`
@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto/sha1" // #nosec G505 -- FIXME: SHA1 used for non-cryptographic purposes (hashing, deduplication)
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"math/big"
@@ -15,16 +16,38 @@ import (
 	"strings"
 	"time"
 
+	log "github.com/bitmark-inc/autonomy-logger"
 	utils "github.com/bitmark-inc/autonomy-utils"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/fatih/structs"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.uber.org/zap"
 )
 
 func EthereumChecksumAddress(address string) string {
 	return common.HexToAddress(address).Hex()
 }
 
+// NormalizeAddress returns the checksum address of an ethereum address. Addresses of
+// other blockchains are returned as they are.
+func NormalizeAddress(address string) string {
+	if utils.GetBlockchainByAddress(address) == utils.EthereumBlockchain {
+		return EthereumChecksumAddress(address)
+	}
+
+	return address
+}
+
+// NormalizeAddresses returns the normalized addresses by NormalizeAddress
+func NormalizeAddresses(addresses []string) []string {
+	normalized := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		normalized = append(normalized, NormalizeAddress(address))
+	}
+
+	return normalized
+}
+
 func OpenseaTokenIDToHex(tokenID string) (string, error) {
 	tokenIDBig, ok := big.NewInt(0).SetString(tokenID, 10)
 	if !ok {
@@ -331,6 +354,38 @@ func ReadFromURL(ctx context.Context, url string, timeout time.Duration) ([]byte
 	return data, nil
 }
 
+// ReadDataURI reads the data of an HTTPS or IPFS URI. IPFS URIs are read from the gateways
+// one by one until one of them succeeds.
+func ReadDataURI(ctx context.Context, gateways []string, uri string) ([]byte, error) {
+	if !IsIPFSURI(uri) && !IsHTTPSURI(uri) {
+		return nil, errors.New("invalid data URI")
+	}
+
+	const timeout = 30 * time.Second
+	if IsHTTPSURI(uri) {
+		return ReadFromURL(ctx, uri, timeout)
+	}
+
+	if len(gateways) == 0 {
+		return nil, errors.New("no IPFS gateways configured")
+	}
+
+	var lastErr error
+	for _, gateway := range gateways {
+		gatewayURL := ResolveIPFSURI(gateway, uri)
+		data, err := ReadFromURL(ctx, gatewayURL, timeout)
+		if err == nil {
+			return data, nil
+		}
+
+		lastErr = err
+		log.WarnWithContext(ctx, "Failed to read data from IPFS gateway",
+			zap.Error(err), zap.String("uri", uri), zap.String("gateway", gateway))
+	}
+
+	return nil, fmt.Errorf("failed to read data from all %d IPFS gateways; last error: %w", len(gateways), lastErr)
+}
+
 // IsIPFSURI returns true if the URI is an IPFS URI
 func IsIPFSURI(uri string) bool {
 	return strings.HasPrefix(uri, "ipfs://")
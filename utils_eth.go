@@ -2,9 +2,12 @@ package indexer
 
 import (
 	"context"
+	"errors"
+	"math/big"
 	"time"
 
 	log "github.com/bitmark-inc/autonomy-logger"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -55,3 +58,54 @@ func ERC1155SingleTransfer(l types.Log) bool {
 	return l.Topics[0].Hex() == TransferSingleEventSignature &&
 		len(l.Topics) == 4
 }
+
+var erc1155BatchTransferData abi.Arguments
+
+func init() {
+	uint256Array, err := abi.NewType("uint256[]", "", nil)
+	if err != nil {
+		panic(err)
+	}
+
+	erc1155BatchTransferData = abi.Arguments{
+		{Name: "ids", Type: uint256Array},
+		{Name: "values", Type: uint256Array},
+	}
+}
+
+// ParseERC1155SingleTransferData returns the token id and the transferred value of a TransferSingle log
+func ParseERC1155SingleTransferData(l types.Log) (*big.Int, *big.Int, error) {
+	if len(l.Data) < 64 {
+		return nil, nil, errors.New("invalid transfer single data")
+	}
+
+	return new(big.Int).SetBytes(l.Data[0:32]), new(big.Int).SetBytes(l.Data[32:64]), nil
+}
+
+// ParseERC1155BatchTransferData returns the token ids and the transferred values of a TransferBatch log
+func ParseERC1155BatchTransferData(l types.Log) ([]*big.Int, []*big.Int, error) {
+	values, err := erc1155BatchTransferData.Unpack(l.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(values) != 2 {
+		return nil, nil, errors.New("invalid transfer batch data")
+	}
+
+	ids, ok := values[0].([]*big.Int)
+	if !ok {
+		return nil, nil, errors.New("invalid ids of transfer batch")
+	}
+
+	amounts, ok := values[1].([]*big.Int)
+	if !ok {
+		return nil, nil, errors.New("invalid values of transfer batch")
+	}
+
+	if len(ids) != len(amounts) {
+		return nil, nil, errors.New("ids and values length mismatch")
+	}
+
+	return ids, amounts, nil
+}
@@ -76,3 +76,9 @@ func TestGetCIDFromIPFSLinkFailed(t *testing.T) {
 	assert.Error(t, err, "unsupported ipfs link")
 	assert.Equal(t, cid, "")
 }
+
+func TestNormalizeAddress(t *testing.T) {
+	assert.Equal(t, "0x82E0b8cDD80Af5930c4452c684E71c861148Ec8A", NormalizeAddress("0x82e0b8cdd80af5930c4452c684e71c861148ec8a"))
+	assert.Equal(t, "tz1MTXXDg7uudxmEieyf2rmZyLBST7ykndWw", NormalizeAddress("tz1MTXXDg7uudxmEieyf2rmZyLBST7ykndWw"))
+	assert.Equal(t, "", NormalizeAddress(""))
+}